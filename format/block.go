@@ -0,0 +1,220 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package format
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+// row is a line of a block: the cells of an entry, a standalone comment or a blank line.
+type row struct {
+	cells    []string
+	comments []string // trailing comments
+	text     string   // the standalone comment if cells is nil
+	blank    bool
+}
+
+func (r *row) multiline() bool {
+	for _, c := range r.cells {
+		if strings.Contains(c, "\n") {
+			return true
+		}
+	}
+	return false
+}
+
+// block is a sequence of rows. The cells of consecutive rows are aligned in columns
+// and so are their trailing comments.
+type block struct {
+	p     *printer
+	rows  []*row
+	last  int      // the end of the last row or comment
+	open  []string // trailing comments of the opening line
+	right []bool   // whether the columns are right aligned
+	top   bool     // the document, which has no opening line
+}
+
+// comments adds comments to the block. A trailing comment is attached to the last row,
+// others start their own rows.
+func (b *block) comments(cs []comment) {
+	for _, c := range cs {
+		switch {
+		case c.trailing && len(b.rows) == 0 && !b.top:
+			b.open = append(b.open, c.text)
+		case c.trailing && len(b.rows) > 0:
+			r := b.rows[len(b.rows)-1]
+			if r.cells == nil {
+				r.text += " " + c.text
+			} else {
+				r.comments = append(r.comments, c.text)
+			}
+		default:
+			b.blank(c.begin)
+			b.rows = append(b.rows, &row{text: c.text})
+		}
+		b.last = c.end
+	}
+}
+
+// blank keeps the blank lines before the offset as one blank row.
+func (b *block) blank(begin int) {
+	if len(b.rows) > 0 && newlines(b.p.src[b.last:begin]) >= 2 {
+		b.rows = append(b.rows, &row{blank: true})
+	}
+}
+
+// add adds a row of cells for the text between begin and end.
+func (b *block) add(begin, end int, cells ...string) {
+	b.blank(begin)
+	b.rows = append(b.rows, &row{cells: cells})
+	b.last = end
+	b.comments(b.p.takeCarry())
+}
+
+// item adds an item with its comments. The separator is appended to the last non-empty cell.
+func (b *block) item(it *item, sep string) {
+	b.comments(it.lead)
+	cells := append([]string(nil), it.cells...)
+	for i := len(cells) - 1; i >= 0 && sep != ""; i-- {
+		if cells[i] != "" {
+			cells[i] += sep
+			break
+		}
+	}
+	b.add(it.begin, it.end, cells...)
+	b.comments(it.trail)
+}
+
+// lines renders the rows with the indentation.
+func (b *block) lines(indent int) []string {
+	prefix := strings.Repeat(indentUnit, indent)
+	var out []string
+	for i := 0; i < len(b.rows); {
+		r := b.rows[i]
+		switch {
+		case r.blank:
+			out = append(out, "")
+			i++
+		case r.cells == nil:
+			// the lines of a block comment are kept as is, since they are part of the AST
+			out = append(out, prefix+r.text)
+			i++
+		case r.multiline():
+			line := prefix + join(r.cells...)
+			if len(r.comments) > 0 {
+				line += " " + strings.Join(r.comments, " ")
+			}
+			out = append(out, line)
+			i++
+		default:
+			j := i
+			for j < len(b.rows) && b.rows[j].cells != nil && !b.rows[j].multiline() {
+				j++
+			}
+			out = append(out, b.align(b.rows[i:j], prefix)...)
+			i = j
+		}
+	}
+	return out
+}
+
+func width(s string) int {
+	return utf8.RuneCountInString(s)
+}
+
+// align renders a section of single-line rows. Like text/tabwriter, a cell is padded to the
+// width of its column among the adjacent rows that have cells after that column, and empty
+// columns are omitted.
+func (b *block) align(rows []*row, prefix string) []string {
+	// the number of cells to print of each row
+	counts := make([]int, len(rows))
+	for i, r := range rows {
+		for n := len(r.cells); n > 0; n-- {
+			if r.cells[n-1] != "" {
+				counts[i] = n
+				break
+			}
+		}
+	}
+	lines := make([]string, len(rows))
+	for i := range rows {
+		lines[i] = prefix
+	}
+	columns := 0
+	for _, n := range counts {
+		if n > columns {
+			columns = n
+		}
+	}
+	for c := 0; c < columns; c++ {
+		for i := 0; i < len(rows); {
+			if counts[i] <= c {
+				i++
+				continue
+			}
+			// the run of rows that have a cell after the column, or the last cell in it
+			j, w := i, 0
+			for j < len(rows) && counts[j] > c+1 {
+				w = max(w, width(rows[j].cells[c]))
+				j++
+			}
+			if j == i {
+				j = i + 1
+			}
+			for k := i; k < j; k++ {
+				cell := rows[k].cells[c]
+				if counts[k] == c+1 {
+					lines[k] += cell
+					continue
+				}
+				if w == 0 {
+					continue
+				}
+				pad := strings.Repeat(" ", w-width(cell))
+				if c < len(b.right) && b.right[c] {
+					lines[k] += pad + cell + " "
+				} else {
+					lines[k] += cell + pad + " "
+				}
+			}
+			i = j
+		}
+	}
+	// align the trailing comments of adjacent rows
+	for i := 0; i < len(rows); {
+		if len(rows[i].comments) == 0 {
+			i++
+			continue
+		}
+		j, w := i, 0
+		for j < len(rows) && len(rows[j].comments) > 0 {
+			w = max(w, width(lines[j]))
+			j++
+		}
+		for k := i; k < j; k++ {
+			lines[k] += strings.Repeat(" ", w-width(lines[k])) + " " + strings.Join(rows[k].comments, " ")
+		}
+		i = j
+	}
+	return lines
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
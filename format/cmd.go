@@ -0,0 +1,141 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package format
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// ErrReported is returned by Run when errors of some files have been reported to
+// the standard error. The other files are still processed.
+var ErrReported = errors.New("thriftgo fmt: errors reported")
+
+type options struct {
+	write bool
+	diff  bool
+	list  bool
+}
+
+// Run parses the arguments of 'thriftgo fmt' and formats the given files,
+// the .thrift files in the given directories, or the standard input.
+func Run(args []string) error {
+	var opts options
+	fs := flag.NewFlagSet("thriftgo fmt", flag.ContinueOnError)
+	fs.BoolVar(&opts.write, "w", false, "Write result to the source file instead of stdout.")
+	fs.BoolVar(&opts.diff, "d", false, "Display diffs instead of rewriting files.")
+	fs.BoolVar(&opts.list, "l", false, "List files whose formatting differs from thriftgo fmt's.")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: thriftgo fmt [-w] [-d] [-l] [path ...]")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() == 0 {
+		if opts.write {
+			return fmt.Errorf("cannot use -w with standard input")
+		}
+		src, err := ioutil.ReadAll(os.Stdin)
+		if err != nil {
+			return err
+		}
+		return process("<standard input>", src, opts, os.Stdout)
+	}
+	if !processPaths(fs.Args(), opts, os.Stdout, os.Stderr) {
+		return ErrReported
+	}
+	return nil
+}
+
+// processPaths formats the files and the .thrift files in the directories.
+// Like gofmt, errors are reported to errOut without stopping at the first one,
+// and processPaths returns false if there is any.
+func processPaths(paths []string, opts options, out, errOut io.Writer) (ok bool) {
+	ok = true
+	report := func(err error) {
+		fmt.Fprintln(errOut, err)
+		ok = false
+	}
+	for _, path := range paths {
+		info, err := os.Stat(path)
+		if err != nil {
+			report(err)
+			continue
+		}
+		if !info.IsDir() {
+			if err = processFile(path, opts, out); err != nil {
+				report(err)
+			}
+			continue
+		}
+		filepath.Walk(path, func(path string, info os.FileInfo, err error) error {
+			if err != nil {
+				report(err)
+				return nil
+			}
+			if info.IsDir() || !strings.HasSuffix(path, ".thrift") {
+				return nil
+			}
+			if err = processFile(path, opts, out); err != nil {
+				report(err)
+			}
+			return nil
+		})
+	}
+	return
+}
+
+func processFile(path string, opts options, out io.Writer) error {
+	src, err := ioutil.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	return process(path, src, opts, out)
+}
+
+func process(path string, src []byte, opts options, out io.Writer) error {
+	res, err := Source(path, src)
+	if err != nil {
+		return err
+	}
+	changed := !bytes.Equal(src, res)
+	if opts.list && changed {
+		fmt.Fprintln(out, path)
+	}
+	if opts.write && changed {
+		info, err := os.Stat(path)
+		if err != nil {
+			return err
+		}
+		if err = ioutil.WriteFile(path, res, info.Mode().Perm()); err != nil {
+			return err
+		}
+	}
+	if opts.diff && changed {
+		fmt.Fprint(out, unifiedDiff(path+".orig", path, string(src), string(res)))
+	}
+	if !opts.list && !opts.write && !opts.diff {
+		_, err = out.Write(res)
+	}
+	return err
+}
@@ -0,0 +1,137 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package format
+
+import (
+	"fmt"
+	"strings"
+)
+
+const diffContext = 3
+
+type edit struct {
+	op   byte // ' ', '-' or '+'
+	line string
+}
+
+func splitLines(s string) []string {
+	lines := strings.SplitAfter(s, "\n")
+	if lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+// diffLines computes the edits from a to b by the longest common subsequence of lines.
+func diffLines(a, b []string) []edit {
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+	var es []edit
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			es = append(es, edit{' ', a[i]})
+			i++
+			j++
+		case j == len(b) || i < len(a) && lcs[i+1][j] >= lcs[i][j+1]:
+			es = append(es, edit{'-', a[i]})
+			i++
+		default:
+			es = append(es, edit{'+', b[j]})
+			j++
+		}
+	}
+	return es
+}
+
+// unifiedDiff returns the differences between a and b in the unified format.
+func unifiedDiff(nameA, nameB, a, b string) string {
+	es := diffLines(splitLines(a), splitLines(b))
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "--- %s\n+++ %s\n", nameA, nameB)
+	// the line numbers in a and b before each edit
+	la, lb := make([]int, len(es)+1), make([]int, len(es)+1)
+	for k, e := range es {
+		la[k+1], lb[k+1] = la[k], lb[k]
+		if e.op != '+' {
+			la[k+1]++
+		}
+		if e.op != '-' {
+			lb[k+1]++
+		}
+	}
+	for k := 0; k < len(es); {
+		if es[k].op == ' ' {
+			k++
+			continue
+		}
+		// extend the hunk while the changes are close enough
+		start, end := max(k-diffContext, 0), k
+		for end < len(es) {
+			if es[end].op != ' ' {
+				end++
+				continue
+			}
+			next := end
+			for next < len(es) && es[next].op == ' ' {
+				next++
+			}
+			if next == len(es) || next-end > 2*diffContext {
+				end = min(end+diffContext, len(es))
+				break
+			}
+			end = next
+		}
+		fmt.Fprintf(&sb, "@@ -%s +%s @@\n", hunkRange(la[start], la[end]), hunkRange(lb[start], lb[end]))
+		for _, e := range es[start:end] {
+			sb.WriteByte(e.op)
+			sb.WriteString(e.line)
+			if !strings.HasSuffix(e.line, "\n") {
+				sb.WriteString("\n\\ No newline at end of file\n")
+			}
+		}
+		k = end
+	}
+	return sb.String()
+}
+
+func hunkRange(from, to int) string {
+	if to-from == 1 {
+		return fmt.Sprint(from + 1)
+	}
+	if to == from {
+		return fmt.Sprintf("%d,0", from)
+	}
+	return fmt.Sprintf("%d,%d", from+1, to-from)
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,37 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package format implements the canonical formatting of thrift IDL.
+//
+// The formatter works on the concrete syntax tree of the IDL, so all comments
+// are kept. It indents blocks with 4 spaces, aligns the IDs, types and names
+// of fields as well as trailing comments, removes the separators of fields,
+// enum values and functions, and prefers double quotes for literals. The order
+// of the definitions is never changed, and formatting is idempotent. Comments
+// are printed as they are written apart from trailing whitespace, so block
+// comments are not re-indented and the reserved comments in the AST stay the same.
+package format
+
+import (
+	"github.com/cloudwego/thriftgo/parser"
+)
+
+// Source formats the IDL source. The filename is only used in error messages.
+func Source(filename string, src []byte) ([]byte, error) {
+	cst, err := parser.ParseCST(filename, string(src))
+	if err != nil {
+		return nil, err
+	}
+	return []byte(newPrinter(cst).document(cst.Root)), nil
+}
@@ -0,0 +1,152 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package format
+
+import (
+	"bytes"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/cloudwego/thriftgo/parser"
+	"github.com/cloudwego/thriftgo/pkg/test"
+)
+
+const testInput = `# header
+include 'base.thrift' // base
+namespace go   example.a  // ns
+
+
+/* block
+     comment */
+const list<i32> NUMS = [1,2 , 3];   // nums
+const map<string,i32> M = {
+  "a": 1, // one
+  'b': 2;
+}
+
+struct Foo { // open
+  /**
+   * the id,
+   *   required
+   */
+  1: required i32 id, // the id
+  2: optional string name = "x" (go.tag = 'json:"name"', api.query='name');
+  10: list<map<i32,string>> items
+  // last
+} (anno = "s")
+
+struct Empty {
+}
+
+enum E { A, B = 2, C = 3; }
+
+service S extends base.Base {
+  // get
+  Foo Get(1: i64 id) throws (1: base.Err err) (api.get = "/foo"),
+  oneway void Ping(
+    1: i64 id, // id
+    2: Foo foo
+  );
+}
+`
+
+const testOutput = `# header
+include "base.thrift"  // base
+namespace go example.a // ns
+
+/* block
+     comment */
+const list<i32> NUMS = [1, 2, 3] // nums
+const map<string, i32> M = {
+    "a": 1, // one
+    "b": 2,
+}
+
+struct Foo { // open
+    /**
+   * the id,
+   *   required
+   */
+     1: required i32           id // the id
+     2: optional string        name = "x" (go.tag = 'json:"name"', api.query = "name")
+    10: list<map<i32, string>> items
+    // last
+} (anno = "s")
+
+struct Empty {}
+
+enum E {
+    A
+    B = 2
+    C = 3
+}
+
+service S extends base.Base {
+    // get
+    Foo Get(1: i64 id) throws (1: base.Err err) (api.get = "/foo")
+    oneway void Ping(
+        1: i64 id, // id
+        2: Foo foo,
+    )
+}
+`
+
+func TestSource(t *testing.T) {
+	res, err := Source("a.thrift", []byte(testInput))
+	test.Assert(t, err == nil, err)
+	test.Assert(t, string(res) == testOutput, string(res))
+
+	// formatting keeps the AST, including the reserved comments of the definitions
+	want, err := parser.ParseString("a.thrift", testInput)
+	test.Assert(t, err == nil, err)
+	got, err := parser.ParseString("a.thrift", string(res))
+	test.Assert(t, err == nil, err)
+	test.Assert(t, strings.Contains(want.Structs[0].Fields[0].ReservedComments, "\n   * the id,"), want.Structs[0].Fields[0])
+	test.Assert(t, reflect.DeepEqual(got, want))
+
+	again, err := Source("a.thrift", res)
+	test.Assert(t, err == nil, err)
+	test.Assert(t, string(again) == testOutput, string(again))
+
+	res, err = Source("empty.thrift", nil)
+	test.Assert(t, err == nil && len(res) == 0, err, string(res))
+
+	_, err = Source("bad.thrift", []byte("struct {\n"))
+	test.Assert(t, err != nil && strings.HasPrefix(err.Error(), "bad.thrift:1:8: "), err)
+}
+
+func TestUnifiedDiff(t *testing.T) {
+	d := unifiedDiff("a.orig", "a", "x\ny\nz\n", "x\nY\nz\n")
+	test.Assert(t, d == "--- a.orig\n+++ a\n@@ -1,3 +1,3 @@\n x\n-y\n+Y\n z\n", d)
+}
+
+func TestProcessPaths(t *testing.T) {
+	dir, err := ioutil.TempDir(".", "fmt")
+	test.Assert(t, err == nil, err)
+	defer os.RemoveAll(dir)
+	bad, good := filepath.Join(dir, "a_bad.thrift"), filepath.Join(dir, "g.thrift")
+	test.Assert(t, ioutil.WriteFile(bad, []byte("struct {\n"), 0o644) == nil)
+	test.Assert(t, ioutil.WriteFile(good, []byte("struct G {\n  1: i32 x,\n}\n"), 0o644) == nil)
+
+	var out, errOut bytes.Buffer
+	ok := processPaths([]string{dir}, options{list: true}, &out, &errOut)
+	test.Assert(t, !ok)
+	test.Assert(t, out.String() == good+"\n", out.String())
+	test.Assert(t, strings.HasPrefix(errOut.String(), bad+":1:8: "), errOut.String())
+}
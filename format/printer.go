@@ -0,0 +1,680 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package format
+
+import (
+	"strings"
+
+	"github.com/cloudwego/thriftgo/parser"
+)
+
+const indentUnit = "    "
+
+// comment is a comment of the source.
+type comment struct {
+	text     string
+	begin    int
+	end      int
+	trailing bool // on the same line as the previous token
+}
+
+func (c comment) isLine() bool {
+	return !strings.HasPrefix(c.text, "/*")
+}
+
+// printer formats a concrete syntax tree.
+type printer struct {
+	src      []rune
+	prev     int // the end of the last consumed token or comment
+	consumed map[*parser.CSTNode]bool
+	carry    []comment // comments of the dropped tokens, printed with the next token
+	indent   int       // the indentation of the current line
+}
+
+func newPrinter(cst *parser.CST) *printer {
+	return &printer{src: cst.Source, consumed: make(map[*parser.CSTNode]bool)}
+}
+
+func isTrivia(rule string) bool {
+	switch rule {
+	case "Skip", "SkipLine", "ReservedComments", "ReservedEndLineComments",
+		"Space", "Indent", "CarriageReturnLineFeed", "Comment":
+		return true
+	}
+	return false
+}
+
+func isToken(rule string) bool {
+	switch rule {
+	case "Identifier", "Literal", "IntConstant", "DoubleConstant", "FieldReq", "ListSeparator", "NamespaceScope":
+		return true
+	}
+	return rule == strings.ToUpper(rule)
+}
+
+func isBlank(r rune) bool {
+	return r == ' ' || r == '\t' || r == '\v' || r == '\r'
+}
+
+func newlines(rs []rune) (n int) {
+	for _, r := range rs {
+		if r == '\n' {
+			n++
+		}
+	}
+	return
+}
+
+// begin returns the offset of the first token of the node.
+func (p *printer) begin(n *parser.CSTNode) int {
+	if isToken(n.Rule) {
+		if len(n.Children) > 0 && n.Children[0].Rule == "Skip" {
+			return n.Children[0].End
+		}
+		return n.Begin
+	}
+	for _, c := range n.Children {
+		if !isTrivia(c.Rule) {
+			return p.begin(c)
+		}
+	}
+	return n.End
+}
+
+// multiline reports whether the tokens from first to last span multiple lines.
+func (p *printer) multiline(first, last *parser.CSTNode) bool {
+	return newlines(p.src[p.begin(first):last.End]) > 0
+}
+
+// trivia consumes the comments of a trivia node.
+func (p *printer) trivia(n *parser.CSTNode) (cs []comment) {
+	if n == nil || p.consumed[n] {
+		return nil
+	}
+	p.consumed[n] = true
+	var walk func(n *parser.CSTNode)
+	walk = func(n *parser.CSTNode) {
+		if n.Rule == "Comment" {
+			text := strings.TrimRight(string(p.src[n.Begin:n.End]), " \t\v\r")
+			cs = append(cs, comment{
+				text:     text,
+				begin:    n.Begin,
+				end:      n.End,
+				trailing: newlines(p.src[p.prev:n.Begin]) == 0,
+			})
+			p.prev = n.End
+			return
+		}
+		for _, c := range n.Children {
+			walk(c)
+		}
+	}
+	walk(n)
+	return
+}
+
+// leading consumes the comments before the first token of the node.
+func (p *printer) leading(n *parser.CSTNode) []comment {
+	for !isToken(n.Rule) {
+		var next *parser.CSTNode
+		for _, c := range n.Children {
+			if isTrivia(c.Rule) {
+				return p.trivia(c)
+			}
+			next = c
+			break
+		}
+		if next == nil {
+			return nil
+		}
+		n = next
+	}
+	if len(n.Children) > 0 && n.Children[0].Rule == "Skip" {
+		return p.trivia(n.Children[0])
+	}
+	return nil
+}
+
+// token consumes a token node and returns its text and the comments before it.
+func (p *printer) token(n *parser.CSTNode) (string, []comment) {
+	cs := p.takeCarry()
+	begin := n.Begin
+	if len(n.Children) > 0 && n.Children[0].Rule == "Skip" {
+		cs = append(cs, p.trivia(n.Children[0])...)
+		begin = n.Children[0].End
+	}
+	end := n.End
+	for end > begin && isBlank(p.src[end-1]) {
+		end--
+	}
+	p.prev = end
+	return string(p.src[begin:end]), cs
+}
+
+// tok consumes a token node and returns its text preceded by its comments.
+func (p *printer) tok(n *parser.CSTNode) string {
+	text, cs := p.token(n)
+	return p.withComments(cs, text)
+}
+
+// drop consumes a token that is not printed, such as a separator.
+// Its comments are printed with the next token.
+func (p *printer) drop(n *parser.CSTNode) {
+	_, cs := p.token(n)
+	p.carry = cs
+}
+
+func (p *printer) takeCarry() []comment {
+	cs := p.carry
+	p.carry = nil
+	return cs
+}
+
+// withComments prefixes the text with the comments.
+// A line comment ends the line, so the text continues on the next line.
+func (p *printer) withComments(cs []comment, text string) string {
+	var sb strings.Builder
+	for _, c := range cs {
+		sb.WriteString(c.text)
+		if c.isLine() {
+			sb.WriteString("\n" + strings.Repeat(indentUnit, p.indent+1))
+		} else {
+			sb.WriteString(" ")
+		}
+	}
+	sb.WriteString(text)
+	return sb.String()
+}
+
+// literal consumes a literal. Single quotes are replaced with double quotes
+// unless it changes the value of the literal.
+func (p *printer) literal(n *parser.CSTNode) string {
+	text, cs := p.token(n)
+	if len(text) >= 2 && text[0] == '\'' {
+		inner := text[1 : len(text)-1]
+		if !strings.ContainsAny(inner, `"\`) {
+			text = `"` + inner + `"`
+		}
+	}
+	return p.withComments(cs, text)
+}
+
+func join(ss ...string) string {
+	var parts []string
+	for _, s := range ss {
+		if s != "" {
+			parts = append(parts, s)
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+// item is an entry of a list or a block, printed as a row of cells.
+type item struct {
+	begin int
+	end   int
+	cells []string
+	lead  []comment
+	trail []comment
+}
+
+func (it *item) inline() string {
+	s := join(it.cells...)
+	for i := len(it.lead) - 1; i >= 0; i-- {
+		s = it.lead[i].text + " " + s
+	}
+	for _, c := range it.trail {
+		s += " " + c.text
+	}
+	return s
+}
+
+// finish records the end of the item and takes the comments of the dropped tokens.
+func (p *printer) finish(it *item) *item {
+	it.end = p.prev
+	it.trail = append(p.takeCarry(), it.trail...)
+	return it
+}
+
+// list formats the entries between the open and the close token. When multi is false,
+// the entries are joined in one line, otherwise each entry is printed in its own line
+// and followed by sep.
+func (p *printer) list(head string, open, close *parser.CSTNode, entries []*parser.CSTNode, indent int,
+	multi bool, sep string, right []bool, entry func(n *parser.CSTNode) *item,
+) string {
+	openText := head + p.tok(open)
+	b := &block{p: p, last: p.prev, right: right}
+	saved := p.indent
+	p.indent = indent + 1
+	var inline []string
+	for _, n := range entries {
+		switch {
+		case isTrivia(n.Rule):
+			if multi {
+				b.comments(p.trivia(n))
+			} else {
+				p.carry = append(p.carry, p.trivia(n)...)
+			}
+		case n.Rule == "ListSeparator":
+			p.drop(n)
+		default:
+			it := entry(n)
+			if multi {
+				b.item(it, sep)
+			} else {
+				inline = append(inline, it.inline())
+			}
+		}
+	}
+	closeText, cs := p.token(close)
+	p.indent = saved
+	if !multi {
+		s := openText + strings.Join(inline, sep+" ")
+		for _, c := range cs {
+			s += " " + c.text
+		}
+		return s + closeText
+	}
+	b.comments(cs)
+	if len(b.rows) == 0 && len(b.open) == 0 {
+		return openText + closeText
+	}
+	lines := []string{openText}
+	if len(b.open) > 0 {
+		lines[0] += " " + strings.Join(b.open, " ")
+	}
+	lines = append(lines, b.lines(indent+1)...)
+	lines = append(lines, strings.Repeat(indentUnit, indent)+closeText)
+	return strings.Join(lines, "\n")
+}
+
+// document formats the whole IDL.
+func (p *printer) document(root *parser.CSTNode) string {
+	b := &block{p: p, top: true}
+	for _, n := range root.Children {
+		switch n.Rule {
+		case "Header":
+			p.header(b, n)
+		case "Definition":
+			p.definition(b, n)
+		default:
+			b.comments(p.trivia(n))
+		}
+	}
+	lines := b.lines(0)
+	if len(lines) == 0 {
+		return ""
+	}
+	return strings.Join(lines, "\n") + "\n"
+}
+
+func (p *printer) header(b *block, n *parser.CSTNode) {
+	for _, c := range n.Children {
+		switch c.Rule {
+		case "Include", "CppInclude":
+			begin := p.begin(c)
+			text := p.tok(c.Children[0]) + " " + p.literal(c.Children[1])
+			b.add(begin, p.prev, text)
+		case "Namespace":
+			begin := p.begin(c)
+			var parts []string
+			for _, x := range c.Children {
+				switch x.Rule {
+				case "NAMESPACE", "Identifier":
+					parts = append(parts, p.tok(x))
+				case "NamespaceScope":
+					if len(x.Children) > 0 && x.Children[0].Rule == "Identifier" {
+						parts = append(parts, p.tok(x.Children[0]))
+					} else {
+						parts = append(parts, p.tok(x))
+					}
+				case "Annotations":
+					parts = append(parts, p.annotations(x, 0))
+				}
+			}
+			b.add(begin, p.prev, strings.Join(parts, " "))
+		default:
+			b.comments(p.trivia(c))
+		}
+	}
+}
+
+func (p *printer) definition(b *block, n *parser.CSTNode) {
+	var text string
+	begin, added := -1, false
+	add := func() {
+		if begin >= 0 && !added {
+			b.add(begin, p.prev, text)
+			added = true
+		}
+	}
+	for _, c := range n.Children {
+		switch c.Rule {
+		case "Const":
+			begin = p.begin(c)
+			text = p.constant(c)
+		case "Typedef":
+			begin = p.begin(c)
+			text = p.tok(c.Children[0]) + " " + p.fieldType(c.Children[1], 0) + " " + p.tok(c.Children[2])
+		case "Enum":
+			begin = p.begin(c)
+			text = p.enum(c)
+		case "Struct", "Union", "Exception":
+			begin = p.begin(c)
+			text = p.structLike(c)
+		case "Service":
+			begin = p.begin(c)
+			text = p.service(c)
+		case "Annotations":
+			text += " " + p.annotations(c, 0)
+		default:
+			if isTrivia(c.Rule) && begin >= 0 {
+				add()
+			}
+			b.comments(p.trivia(c))
+		}
+	}
+	add()
+}
+
+func (p *printer) constant(n *parser.CSTNode) string {
+	var parts []string
+	for _, c := range n.Children {
+		switch c.Rule {
+		case "CONST", "Identifier", "EQUAL":
+			parts = append(parts, p.tok(c))
+		case "FieldType":
+			parts = append(parts, p.fieldType(c, 0))
+		case "ConstValue":
+			parts = append(parts, p.constValue(c, 0))
+		case "ListSeparator":
+			p.drop(c)
+		}
+	}
+	return strings.Join(parts, " ")
+}
+
+// body formats the block of a definition between the braces, one entry per line.
+func (p *printer) body(head string, children []*parser.CSTNode, right []bool, entry func(n *parser.CSTNode) *item) (string, []*parser.CSTNode) {
+	open, close := -1, -1
+	for i, c := range children {
+		switch c.Rule {
+		case "LWING":
+			open = i
+		case "RWING":
+			close = i
+		}
+	}
+	s := p.list(head, children[open], children[close], children[open+1:close], 0, true, "", right, entry)
+	return s, children[close+1:]
+}
+
+func (p *printer) structLike(n *parser.CSTNode) string {
+	head := p.tok(n.Children[0]) + " " + p.tok(n.Children[1]) + " "
+	s, rest := p.body(head, n.Children, []bool{true}, func(c *parser.CSTNode) *item {
+		return p.field(c, 1)
+	})
+	for _, c := range rest {
+		if c.Rule == "Annotations" {
+			s += " " + p.annotations(c, 0)
+		}
+	}
+	return s
+}
+
+func (p *printer) enum(n *parser.CSTNode) string {
+	head := p.tok(n.Children[0]) + " " + p.tok(n.Children[1]) + " "
+	// group the parts of each value
+	var entries []*parser.CSTNode
+	var value *parser.CSTNode
+	for _, c := range n.Children[2:] {
+		switch c.Rule {
+		case "Identifier":
+			value = &parser.CSTNode{Rule: "EnumValue", Begin: c.Begin, End: c.End, Children: []*parser.CSTNode{c}}
+			entries = append(entries, value)
+		case "EQUAL", "IntConstant", "Annotations", "ListSeparator":
+			value.Children = append(value.Children, c)
+		default:
+			entries = append(entries, c)
+		}
+	}
+	s, _ := p.body(head, entries, nil, func(c *parser.CSTNode) *item {
+		it := &item{begin: p.begin(c)}
+		var name, rest string
+		for _, x := range c.Children {
+			switch x.Rule {
+			case "Identifier":
+				name = p.tok(x)
+			case "EQUAL", "IntConstant":
+				rest = join(rest, p.tok(x))
+			case "Annotations":
+				rest = join(rest, p.annotations(x, 1))
+			case "ListSeparator":
+				p.drop(x)
+			}
+		}
+		it.cells = []string{name, rest}
+		return p.finish(it)
+	})
+	return s
+}
+
+func (p *printer) service(n *parser.CSTNode) string {
+	var parts []string
+	for _, c := range n.Children {
+		if c.Rule == "LWING" {
+			break
+		}
+		parts = append(parts, p.tok(c))
+	}
+	s, _ := p.body(strings.Join(parts, " ")+" ", n.Children, nil, func(c *parser.CSTNode) *item {
+		return p.function(c, 1)
+	})
+	return s
+}
+
+// field formats a field of a struct-like or the arguments or the exceptions of a function.
+func (p *printer) field(n *parser.CSTNode, indent int) *item {
+	it := &item{begin: p.begin(n)}
+	var id, typ, name, rest string
+	for _, c := range n.Children {
+		switch c.Rule {
+		case "ReservedComments", "Skip":
+			it.lead = append(it.lead, p.trivia(c)...)
+		case "FieldId":
+			for _, x := range c.Children {
+				switch x.Rule {
+				case "Skip":
+					it.lead = append(it.lead, p.trivia(x)...)
+				case "IntConstant", "COLON":
+					id += p.tok(x)
+				}
+			}
+		case "FieldReq":
+			typ = p.tok(c)
+		case "FieldType":
+			typ = join(typ, p.fieldType(c, indent))
+		case "Identifier":
+			name = p.tok(c)
+		case "EQUAL":
+			rest = p.tok(c)
+		case "ConstValue":
+			rest = join(rest, p.constValue(c, indent))
+		case "Annotations":
+			rest = join(rest, p.annotations(c, indent))
+		case "ListSeparator":
+			p.drop(c)
+		case "ReservedEndLineComments", "SkipLine":
+			if it.end == 0 {
+				p.finish(it)
+			}
+			it.trail = append(it.trail, p.trivia(c)...)
+		}
+	}
+	if it.end == 0 {
+		p.finish(it)
+	}
+	it.cells = []string{id, typ, name, rest}
+	return it
+}
+
+func (p *printer) function(n *parser.CSTNode, indent int) *item {
+	it := &item{begin: p.begin(n)}
+	var parts []string
+	cs := n.Children
+	for i := 0; i < len(cs); i++ {
+		c := cs[i]
+		switch c.Rule {
+		case "ReservedComments", "Skip":
+			it.lead = append(it.lead, p.trivia(c)...)
+		case "ONEWAY":
+			parts = append(parts, p.tok(c))
+		case "FunctionType":
+			if c.Children[0].Rule == "VOID" {
+				parts = append(parts, p.tok(c.Children[0]))
+			} else {
+				parts = append(parts, p.fieldType(c.Children[0], indent))
+			}
+		case "Identifier":
+			name := p.tok(c)
+			j := i + 1
+			for cs[j].Rule != "RPAR" {
+				j++
+			}
+			parts = append(parts, p.fields(name, cs[i+1], cs[j], cs[i+2:j], indent))
+			i = j
+		case "Throws":
+			ts := c.Children
+			parts = append(parts, p.fields(p.tok(ts[0])+" ", ts[1], ts[len(ts)-1], ts[2:len(ts)-1], indent))
+		case "Annotations":
+			parts = append(parts, p.annotations(c, indent))
+		case "ListSeparator":
+			p.drop(c)
+		case "SkipLine":
+			p.finish(it)
+			it.trail = append(it.trail, p.trivia(c)...)
+		}
+	}
+	if it.end == 0 {
+		p.finish(it)
+	}
+	it.cells = []string{strings.Join(parts, " ")}
+	return it
+}
+
+// fields formats the arguments or the exceptions of a function.
+func (p *printer) fields(head string, open, close *parser.CSTNode, fields []*parser.CSTNode, indent int) string {
+	return p.list(head, open, close, fields, indent, p.multiline(open, close), ",", []bool{true}, func(c *parser.CSTNode) *item {
+		return p.field(c, indent+1)
+	})
+}
+
+func (p *printer) fieldType(n *parser.CSTNode, indent int) string {
+	var s string
+	for _, c := range n.Children {
+		switch c.Rule {
+		case "BaseType":
+			s = p.tok(c.Children[0])
+		case "Identifier":
+			s = p.tok(c)
+		case "ContainerType":
+			s = p.containerType(c.Children[0], indent)
+		case "Annotations":
+			s += " " + p.annotations(c, indent)
+		}
+	}
+	return s
+}
+
+func (p *printer) containerType(n *parser.CSTNode, indent int) string {
+	var s string
+	for _, c := range n.Children {
+		switch c.Rule {
+		case "MAP", "SET", "LIST", "LPOINT", "RPOINT":
+			s += p.tok(c)
+		case "COMMA":
+			s += p.tok(c) + " "
+		case "FieldType":
+			s += p.fieldType(c, indent)
+		case "CppType":
+			cpp := p.tok(c.Children[0]) + " " + p.literal(c.Children[1])
+			if n.Rule == "ListType" {
+				s += " " + cpp
+			} else {
+				s += " " + cpp + " "
+			}
+		}
+	}
+	return s
+}
+
+func (p *printer) annotations(n *parser.CSTNode, indent int) string {
+	cs := n.Children
+	open, close := cs[0], cs[len(cs)-1]
+	return p.list("", open, close, cs[1:len(cs)-1], indent, p.multiline(open, close), ",", nil, func(c *parser.CSTNode) *item {
+		it := &item{begin: p.begin(c), lead: p.leading(c)}
+		var key, value string
+		for _, x := range c.Children {
+			switch x.Rule {
+			case "Identifier":
+				key = p.tok(x)
+			case "EQUAL":
+				value = p.tok(x)
+			case "Literal":
+				value += " " + p.literal(x)
+			case "ListSeparator":
+				p.drop(x)
+			}
+		}
+		it.cells = []string{key, value}
+		return p.finish(it)
+	})
+}
+
+func (p *printer) constValue(n *parser.CSTNode, indent int) string {
+	c := n.Children[0]
+	switch c.Rule {
+	case "Literal":
+		return p.literal(c)
+	case "ConstList":
+		cs := c.Children
+		open, close := cs[0], cs[len(cs)-1]
+		return p.list("", open, close, cs[1:len(cs)-1], indent, p.multiline(open, close), ",", nil, func(x *parser.CSTNode) *item {
+			it := &item{begin: p.begin(x), lead: p.leading(x)}
+			it.cells = []string{p.constValue(x, indent+1)}
+			return p.finish(it)
+		})
+	case "ConstMap":
+		cs := c.Children
+		open, close := cs[0], cs[len(cs)-1]
+		var entries []*parser.CSTNode
+		for i := 1; i < len(cs)-1; i++ {
+			if cs[i].Rule == "ConstValue" {
+				entries = append(entries, &parser.CSTNode{Rule: "MapEntry", Children: cs[i : i+3]})
+				i += 2
+			} else {
+				entries = append(entries, cs[i])
+			}
+		}
+		return p.list("", open, close, entries, indent, p.multiline(open, close), ",", nil, func(x *parser.CSTNode) *item {
+			kv := x.Children
+			it := &item{begin: p.begin(kv[0]), lead: p.leading(kv[0])}
+			key := p.constValue(kv[0], indent+1) + p.tok(kv[1])
+			it.cells = []string{key + " " + p.constValue(kv[2], indent+1)}
+			return p.finish(it)
+		})
+	}
+	return p.tok(c)
+}
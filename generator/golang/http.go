@@ -0,0 +1,194 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package golang
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cloudwego/thriftgo/internal/apiutil"
+	"github.com/cloudwego/thriftgo/semantic"
+)
+
+// HTTP frameworks supported by the http_router option.
+const (
+	HTTPRouterHertz = "hertz"
+	HTTPRouterGin   = "gin"
+)
+
+// HTTP framework libraries used by the generated routers.
+const (
+	HertzAppLib   = "github.com/cloudwego/hertz/pkg/app"
+	HertzRouteLib = "github.com/cloudwego/hertz/pkg/route"
+	GinLib        = "github.com/gin-gonic/gin"
+	GinBindingLib = "github.com/gin-gonic/gin/binding"
+)
+
+// httpMethodAnnotations lists the function annotations that declare HTTP routes.
+var httpMethodAnnotations = []struct {
+	key    string
+	method string
+}{
+	{"api.get", "GET"},
+	{"api.post", "POST"},
+	{"api.put", "PUT"},
+	{"api.delete", "DELETE"},
+	{"api.patch", "PATCH"},
+	{"api.head", "HEAD"},
+	{"api.options", "OPTIONS"},
+}
+
+// HTTPRoute is a route declared by an api.* annotation on a service function.
+type HTTPRoute struct {
+	Method string // the registration method of the router, e.g. GET
+	Path   string
+}
+
+// HasParams reports whether the path of the route contains parameters.
+func (r *HTTPRoute) HasParams() bool {
+	return strings.ContainsAny(r.Path, ":*")
+}
+
+// HTTPException is an exception declared in the throws list of a function
+// and the status code it is written with.
+type HTTPException struct {
+	TypeName TypeName
+	Code     int
+}
+
+// HTTPRouter returns the HTTP framework to generate routers for. Empty if disabled.
+func (cu *CodeUtils) HTTPRouter() string {
+	return cu.httpRouter
+}
+
+// SetHTTPRouter sets the HTTP framework to generate routers for.
+func (cu *CodeUtils) SetHTTPRouter(value string) error {
+	switch value {
+	case HTTPRouterHertz, HTTPRouterGin:
+		cu.httpRouter = value
+		return nil
+	}
+	return fmt.Errorf("unsupported http router: %q (available: %s, %s)", value, HTTPRouterHertz, HTTPRouterGin)
+}
+
+// HTTPRoutes returns the routes declared by the api.* annotations of the function.
+func (f *Function) HTTPRoutes() (routes []*HTTPRoute) {
+	for _, a := range httpMethodAnnotations {
+		for _, path := range f.Annotations.Get(a.key) {
+			if path = strings.TrimSpace(path); path != "" {
+				routes = append(routes, &HTTPRoute{Method: a.method, Path: path})
+			}
+		}
+	}
+	return
+}
+
+// HTTPHasParams reports whether any route of the function has path parameters.
+func (f *Function) HTTPHasParams() bool {
+	for _, r := range f.HTTPRoutes() {
+		if r.HasParams() {
+			return true
+		}
+	}
+	return false
+}
+
+// HTTPHasHeaders reports whether the request of the function has fields bound
+// from headers by api.header annotations, including the expanded fields.
+func (cu *CodeUtils) HTTPHasHeaders(f *Function) bool {
+	if len(f.Arguments()) == 0 {
+		return false
+	}
+	g, t, err := semantic.Deref(f.Service().From().AST(), f.Arguments()[0].Type)
+	if err != nil || cu.scopeCache[g] == nil {
+		return false
+	}
+	st := cu.scopeCache[g].StructLike(t.Name)
+	return st != nil && hasHeaderField(st.Fields())
+}
+
+func hasHeaderField(fields []*Field) bool {
+	for _, f := range fields {
+		if f.IsExpandable() {
+			if hasHeaderField(f.ExpandedFields()) {
+				return true
+			}
+		} else if len(f.Annotations.Get("api.header")) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// warnHTTP reports a problem found while generating the HTTP routers. The
+// templates of a file are executed more than once, so each message is only
+// reported the first time.
+func (cu *CodeUtils) warnHTTP(msg string) {
+	if cu.httpWarned == nil {
+		cu.httpWarned = make(map[string]bool)
+	}
+	if !cu.httpWarned[msg] {
+		cu.httpWarned[msg] = true
+		cu.Warn(msg)
+	}
+}
+
+// HTTPFunctions returns the functions of the service that can be served over HTTP.
+// A function is served when it has api.* route annotations and takes at most one
+// argument of a struct-like type, which is bound from the request.
+func (cu *CodeUtils) HTTPFunctions(svc *Service) (fs []*Function) {
+	ast := svc.From().AST()
+	for _, f := range svc.Functions() {
+		if len(f.HTTPRoutes()) == 0 {
+			continue
+		}
+		if f.Streaming() != nil && f.Streaming().IsStreaming {
+			cu.warnHTTP(fmt.Sprintf("%s.%s: streaming functions can not be served over HTTP, skipped", svc.Name, f.Name))
+			continue
+		}
+		if len(f.Arguments()) > 1 {
+			cu.warnHTTP(fmt.Sprintf("%s.%s: functions with more than one argument can not be bound from HTTP requests, skipped", svc.Name, f.Name))
+			continue
+		}
+		if len(f.Arguments()) == 1 {
+			_, t, err := semantic.Deref(ast, f.Arguments()[0].Type)
+			if err != nil || !t.Category.IsStructLike() {
+				cu.warnHTTP(fmt.Sprintf("%s.%s: the argument must be a struct to be bound from HTTP requests, skipped", svc.Name, f.Name))
+				continue
+			}
+		}
+		fs = append(fs, f)
+	}
+	return
+}
+
+// HTTPExceptions returns the distinct exceptions thrown by the function with
+// the status codes of their api.http_code annotations.
+func (cu *CodeUtils) HTTPExceptions(f *Function) (es []*HTTPException) {
+	ast := f.Service().From().AST()
+	seen := make(map[TypeName]bool)
+	for _, e := range f.Throws() {
+		if seen[e.GoTypeName()] {
+			continue
+		}
+		seen[e.GoTypeName()] = true
+		code, err := apiutil.ExceptionCode(ast, e.Field)
+		if err != nil {
+			cu.warnHTTP(err.Error())
+		}
+		es = append(es, &HTTPException{TypeName: e.GoTypeName(), Code: code})
+	}
+	return
+}
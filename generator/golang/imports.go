@@ -94,6 +94,16 @@ func (im *importManager) init(cu *CodeUtils, ast *parser.Thrift) {
 		"apache_warning":    ApacheWarningLib,
 		"apache_adaptor":    ApacheAdaptor,
 	}
+	switch cu.HTTPRouter() {
+	case HTTPRouterHertz:
+		std["http"] = "net/http"
+		std["app"] = HertzAppLib
+		std["route"] = HertzRouteLib
+	case HTTPRouterGin:
+		std["http"] = "net/http"
+		std["gin"] = GinLib
+		std["binding"] = GinBindingLib
+	}
 	for pkg, path := range std {
 		ns.Add(pkg, path)
 		im.libNotUsed[pkg] = true
@@ -183,6 +183,13 @@ var codeUtilsParams = []*param{
 			return nil
 		},
 	},
+	{
+		name: "http_router",
+		desc: "Generate HTTP routers and handlers from api.* annotations of service functions for the given framework: 'hertz', 'gin'.",
+		action: func(value string, cu *CodeUtils) error {
+			return cu.SetHTTPRouter(value)
+		},
+	},
 	{
 		name: "template",
 		desc: "Specify a different template to generate codes. (current available templates: 'slim', 'raw_struct')",
@@ -60,8 +60,13 @@ func (r *Resolver) GetFieldInit(f *parser.Field) (Code, error) {
 }
 
 // GetConstInit returns the initialization code for a constant.
+// Errors are prefixed with the source position of the value when it is known.
 func (r *Resolver) GetConstInit(name string, t *parser.Type, v *parser.ConstValue) (Code, error) {
-	return r.ResolveConst(r.root, name, t, v)
+	code, err := r.ResolveConst(r.root, name, t, v)
+	if span := parser.SpanOf(r.root.ast, v); err != nil && span != nil {
+		return "", fmt.Errorf("%s: %w", span, err)
+	}
+	return code, err
 }
 
 // ResolveFieldTypeName returns a legal type name in go for the given field.
@@ -55,6 +55,12 @@ import (
 {{template "ThriftProcessor" .}}
 {{- end}}
 
+{{- if HTTPRouter}}
+{{- range .Services}}
+{{template "HTTPRouter" .}}
+{{- end}}
+{{- end}}
+
 {{- if Features.UseOption}}
 {{- $Options := .GetOption .AST.Filename }}
 {{- if $Options}}
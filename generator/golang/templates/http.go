@@ -0,0 +1,122 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package templates
+
+// HTTPRouter .
+var HTTPRouter = `
+{{define "HTTPRouter"}}
+{{- $BasePrefix := ServicePrefix .Base}}
+{{- $BaseService := ServiceName .Base}}
+{{- $ServiceName := .GoName}}
+{{- $Functions := HTTPFunctions .}}
+{{InsertionPoint "http_router" .Name}}
+// Register{{$ServiceName}}HTTPRoutes registers the routes declared by the api.* annotations
+// of {{$ServiceName}}, including the routes of the services it extends.
+{{- if eq HTTPRouter "gin"}}
+{{- UseStdLibrary "gin"}}
+func Register{{$ServiceName}}HTTPRoutes(r gin.IRoutes, handler {{$ServiceName}}) {
+{{- else}}
+{{- UseStdLibrary "route"}}
+func Register{{$ServiceName}}HTTPRoutes(r route.IRoutes, handler {{$ServiceName}}) {
+{{- end}}
+	{{- if .Extends}}
+	{{$BasePrefix}}Register{{$BaseService}}HTTPRoutes(r, handler)
+	{{- end}}
+	{{- range $Functions}}
+	{{- $HandlerName := printf "%s%sHTTPHandler" $ServiceName .GoName}}
+	{{- range .HTTPRoutes}}
+	r.{{.Method}}({{printf "%q" .Path}}, {{$HandlerName}}(handler))
+	{{- end}}
+	{{- end}}
+}
+{{- range $Functions}}
+{{template "HTTPHandler" .}}
+{{- end}}
+{{- end}}{{/* define "HTTPRouter" */}}
+`
+
+// HTTPHandler .
+var HTTPHandler = `
+{{define "HTTPHandler"}}
+{{- $ServiceName := .Service.GoName}}
+{{- $HandlerName := printf "%s%sHTTPHandler" $ServiceName .GoName}}
+{{- $Gin := eq HTTPRouter "gin"}}
+{{- UseStdLibrary "http"}}
+// {{$HandlerName}} binds the request of {{$ServiceName}}.{{.GoName}} and writes the response as JSON.
+{{- if $Gin}}
+func {{$HandlerName}}(handler {{$ServiceName}}) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := c.Request.Context()
+{{- else}}
+{{- UseStdLibrary "app" "context"}}
+func {{$HandlerName}}(handler {{$ServiceName}}) app.HandlerFunc {
+	return func(ctx context.Context, c *app.RequestContext) {
+{{- end}}
+		{{- if .Arguments}}
+		{{- $arg := index .Arguments 0}}
+		req := new({{NotPtr $arg.GoTypeName}})
+		{{- if and $Gin .HTTPHasParams}}
+		{{- UseStdLibrary "binding"}}
+		params := make(map[string][]string, len(c.Params))
+		for _, p := range c.Params {
+			params[p.Key] = []string{p.Value}
+		}
+		if err := binding.MapFormWithTag(req, params, "uri"); err != nil {
+			c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+			return
+		}
+		{{- end}}
+		{{- if and $Gin (HTTPHasHeaders .)}}
+		{{- UseStdLibrary "binding"}}
+		if err := binding.MapFormWithTag(req, c.Request.Header, "header"); err != nil {
+			c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+			return
+		}
+		{{- end}}
+		if err := {{if $Gin}}c.ShouldBind(req){{else}}c.BindAndValidate(req){{end}}; err != nil {
+			c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+			return
+		}
+		{{- end}}
+		{{if .Void}}err{{else}}resp, err{{end}} := handler.{{.GoName}}(ctx{{if .Arguments}}, req{{end}})
+		if err != nil {
+			{{- $Exceptions := HTTPExceptions .}}
+			{{- if $Exceptions}}
+			switch e := err.(type) {
+			{{- range $Exceptions}}
+			case {{.TypeName}}:
+				c.JSON({{.Code}}, e)
+			{{- end}}
+			default:
+				c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+			}
+			{{- else}}
+			c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+			{{- end}}
+			return
+		}
+		{{- if .Void}}
+		{{- if $Gin}}
+		c.Status(http.StatusOK)
+		{{- else}}
+		c.SetStatusCode(http.StatusOK)
+		{{- end}}
+		{{- else}}
+		c.JSON(http.StatusOK, resp)
+		{{- end}}
+	}
+}
+{{- end}}{{/* define "HTTPHandler" */}}
+`
@@ -61,5 +61,6 @@ func Templates() []string {
 		FieldDeepEqualContainer,
 		FieldDeepEqualStructLike,
 		FunctionSignature, Service, Client, Processor,
+		HTTPRouter, HTTPHandler,
 	}
 }
@@ -16,6 +16,7 @@ package golang
 
 import (
 	"fmt"
+	"net/textproto"
 	"path/filepath"
 	"regexp"
 	"runtime"
@@ -68,6 +69,8 @@ type CodeUtils struct {
 	scopeCache  map[*parser.Thrift]*Scope
 	useTemplate string
 	alternative map[string][]string
+	httpRouter  string          // HTTP framework to generate routers for, empty if disabled.
+	httpWarned  map[string]bool // Warnings already reported by the HTTP routers.
 }
 
 // NewCodeUtils creates a new CodeUtils.
@@ -476,8 +479,14 @@ func (cu *CodeUtils) addDefaultHTTPTags(tags *[]string, f *Field, gotags []strin
 		*tags = append(*tags, fmt.Sprintf(`form:"%s"`, formName))
 	}
 
-	// 如果没有 api.path 注解，则添加 query 标签
-	if !hasApiPath && !hasQueryTag {
+	// 检查是否有 api.header 注解，请求头名称使用规范形式，以便 gin 从 http.Header 中按标签查找
+	headerName := ""
+	if vals := f.Field.Annotations.Get("api.header"); len(vals) > 0 && vals[0] != "" {
+		headerName = textproto.CanonicalMIMEHeaderKey(vals[0])
+	}
+
+	// 如果没有 api.path 和 api.header 注解，则添加 query 标签
+	if !hasApiPath && headerName == "" && !hasQueryTag {
 		*tags = append(*tags, fmt.Sprintf(`query:"%s"`, queryName))
 	}
 
@@ -485,6 +494,16 @@ func (cu *CodeUtils) addDefaultHTTPTags(tags *[]string, f *Field, gotags []strin
 	if hasApiPath && !hasPathTag {
 		*tags = append(*tags, fmt.Sprintf(`path:"%s"`, pathName))
 	}
+
+	// 如果有 api.header 注解，则生成 header 标签
+	if headerName != "" && (len(gotags) == 0 || !strings.Contains(gotags[0], `header:"`)) {
+		*tags = append(*tags, fmt.Sprintf(`header:"%s"`, headerName))
+	}
+
+	// gin 通过 uri 标签绑定路径参数
+	if hasApiPath && cu.HTTPRouter() == HTTPRouterGin && (len(gotags) == 0 || !strings.Contains(gotags[0], `uri:"`)) {
+		*tags = append(*tags, fmt.Sprintf(`uri:"%s"`, pathName))
+	}
 }
 
 // generateTagName 生成统一的标签名称，支持多种命名风格
@@ -635,6 +654,10 @@ func (cu *CodeUtils) BuildFuncMap() template.FuncMap {
 		"NotPtr": func(s TypeName) string {
 			return strings.ReplaceAll(string(s), "*", "")
 		},
+		"HTTPRouter":     cu.HTTPRouter,
+		"HTTPFunctions":  cu.HTTPFunctions,
+		"HTTPExceptions": cu.HTTPExceptions,
+		"HTTPHasHeaders": cu.HTTPHasHeaders,
 	}
 	return m
 }
@@ -15,11 +15,14 @@
 package golang
 
 import (
+	"fmt"
 	"strings"
 	"testing"
 
 	"github.com/cloudwego/thriftgo/generator/backend"
 	"github.com/cloudwego/thriftgo/parser"
+	"github.com/cloudwego/thriftgo/plugin"
+	"github.com/cloudwego/thriftgo/semantic"
 )
 
 func TestSnakify(t *testing.T) {
@@ -288,3 +291,193 @@ func TestGenBindingTag(t *testing.T) {
 		t.Errorf("Expected no binding tag when GenBindingTag is disabled, got: %s", tags)
 	}
 }
+
+func TestGenGinURITag(t *testing.T) {
+	cu := NewCodeUtils(backend.DummyLogFunc())
+	pathField := &Field{
+		Field: &parser.Field{
+			Name:        "id",
+			ID:          1,
+			Annotations: parser.Annotations{{Key: "api.path", Values: []string{"user_id"}}},
+		},
+	}
+
+	// 未启用 gin 路由时不生成 uri 标签
+	tags, err := cu.GenFieldTags(pathField, "")
+	if err != nil {
+		t.Fatalf("GenFieldTags failed: %v", err)
+	}
+	if strings.Contains(tags, `uri:"`) {
+		t.Errorf("Expected no uri tag without http_router=gin, got: %s", tags)
+	}
+
+	// 启用 gin 路由时为 api.path 字段生成 uri 标签
+	if err := cu.SetHTTPRouter(HTTPRouterGin); err != nil {
+		t.Fatalf("SetHTTPRouter failed: %v", err)
+	}
+	tags, err = cu.GenFieldTags(pathField, "")
+	if err != nil {
+		t.Fatalf("GenFieldTags failed: %v", err)
+	}
+	if !strings.Contains(tags, `uri:"user_id"`) {
+		t.Errorf("Expected uri:\"user_id\" tag for api.path field, got: %s", tags)
+	}
+
+	if err := cu.SetHTTPRouter("echo"); err == nil {
+		t.Errorf("Expected an error for unsupported http router")
+	}
+}
+
+func TestHTTPRoutes(t *testing.T) {
+	f := &Function{
+		Function: &parser.Function{
+			Name: "GetUser",
+			Annotations: parser.Annotations{
+				{Key: "api.post", Values: []string{"/v1/users"}},
+				{Key: "api.get", Values: []string{"/v1/users/:id"}},
+			},
+		},
+	}
+	routes := f.HTTPRoutes()
+	if len(routes) != 2 {
+		t.Fatalf("Expected 2 routes, got: %d", len(routes))
+	}
+	if routes[0].Method != "GET" || routes[0].Path != "/v1/users/:id" || !routes[0].HasParams() {
+		t.Errorf("Unexpected route: %+v", routes[0])
+	}
+	if routes[1].Method != "POST" || routes[1].Path != "/v1/users" || routes[1].HasParams() {
+		t.Errorf("Unexpected route: %+v", routes[1])
+	}
+	if !f.HTTPHasParams() {
+		t.Errorf("Expected path parameters in routes of %s", f.Name)
+	}
+}
+
+const httpRouterIDL = `
+namespace go example
+
+exception NotFound {
+  1: string message
+} (api.http_code = "404")
+
+exception Invalid {
+  1: string message
+}
+
+exception Conflict {
+  1: string message
+} (api.http_code = "conflict")
+
+struct GetUserReq {
+  1: i64 id (api.path = "id")
+  2: string token (api.header = "x-token")
+}
+
+struct User {
+  1: i64 id
+}
+
+service BaseService {
+  string Ping() (api.get = "/ping")
+}
+
+service UserService extends BaseService {
+  User GetUser(1: GetUserReq req) throws (1: NotFound notFound, 2: Invalid invalid, 3: Conflict conflict) (api.get = "/users/:id")
+  void DeleteUser(1: GetUserReq req) throws (1: Conflict conflict) (api.delete = "/users/:id")
+  User Merge(1: GetUserReq a, 2: GetUserReq b) (api.post = "/users/merge")
+}
+`
+
+// generateHTTPRouter 使用给定的 http_router 生成 httpRouterIDL，返回生成的代码和警告
+func generateHTTPRouter(t *testing.T, router string) (string, []string) {
+	ast, err := parser.ParseString("example.thrift", httpRouterIDL)
+	if err != nil {
+		t.Fatalf("parse failed: %v", err)
+	}
+	if err = semantic.ResolveSymbols(ast); err != nil {
+		t.Fatalf("resolve failed: %v", err)
+	}
+	var warnings []string
+	log := backend.LogFunc{
+		Info: func(v ...interface{}) {},
+		Warn: func(v ...interface{}) { warnings = append(warnings, fmt.Sprint(v...)) },
+		MultiWarn: func(ws []string) {
+			warnings = append(warnings, ws...)
+		},
+	}
+	res := new(GoBackend).Generate(&plugin.Request{
+		Language:            "go",
+		Version:             "?",
+		OutputPath:          "gen-go",
+		AST:                 ast,
+		GeneratorParameters: []string{"http_router=" + router},
+	}, log)
+	if res.Error != nil {
+		t.Fatalf("generate failed: %s", *res.Error)
+	}
+	var code strings.Builder
+	for _, c := range res.Contents {
+		if c.InsertionPoint == nil {
+			code.WriteString(c.Content)
+		}
+	}
+	return code.String(), warnings
+}
+
+func TestGenHTTPRouter(t *testing.T) {
+	for _, router := range []string{HTTPRouterGin, HTTPRouterHertz} {
+		t.Run(router, func(t *testing.T) {
+			code, warnings := generateHTTPRouter(t, router)
+
+			// 注册函数先注册被继承服务的路由
+			routes := "r gin.IRoutes"
+			if router == HTTPRouterHertz {
+				routes = "r route.IRoutes"
+			}
+			for _, want := range []string{
+				"func RegisterUserServiceHTTPRoutes(" + routes + ", handler UserService) {\n\tRegisterBaseServiceHTTPRoutes(r, handler)\n",
+				`r.GET("/ping", BaseServicePingHTTPHandler(handler))`,
+				`r.GET("/users/:id", UserServiceGetUserHTTPHandler(handler))`,
+				`r.DELETE("/users/:id", UserServiceDeleteUserHTTPHandler(handler))`,
+				// 抛出的异常按 api.http_code 写出，未声明的使用 500
+				"case *NotFound:\n\t\t\t\tc.JSON(404, e)",
+				"case *Invalid:\n\t\t\t\tc.JSON(500, e)",
+				"case *Conflict:\n\t\t\t\tc.JSON(500, e)",
+				"err := handler.DeleteUser(ctx, req)",
+				`header:"X-Token"`,
+			} {
+				if !strings.Contains(code, want) {
+					t.Errorf("Expected %q in the generated code", want)
+				}
+			}
+			if strings.Contains(code, "UserServiceMergeHTTPHandler") {
+				t.Errorf("Expected no handler for functions with more than one argument")
+			}
+
+			// 无返回值的方法只写出状态码，gin 需要单独绑定请求头
+			if router == HTTPRouterGin {
+				for _, want := range []string{
+					"c.Status(http.StatusOK)",
+					`binding.MapFormWithTag(req, c.Request.Header, "header")`,
+					`binding.MapFormWithTag(req, params, "uri")`,
+				} {
+					if !strings.Contains(code, want) {
+						t.Errorf("Expected %q in the generated code", want)
+					}
+				}
+			} else {
+				if !strings.Contains(code, "c.SetStatusCode(http.StatusOK)") {
+					t.Errorf("Expected c.SetStatusCode for void functions")
+				}
+				if strings.Contains(code, "MapFormWithTag") {
+					t.Errorf("Expected hertz to bind the request with BindAndValidate only")
+				}
+			}
+
+			// 被跳过的方法和无效的 api.http_code 只警告一次
+			if len(warnings) != 2 || !strings.Contains(warnings[0], "UserService.Merge") || !strings.Contains(warnings[1], `"conflict"`) {
+				t.Errorf("Expected one warning for UserService.Merge and one for Conflict, got: %q", warnings)
+			}
+		})
+	}
+}
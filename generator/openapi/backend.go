@@ -130,6 +130,12 @@ func (o *OpenAPIBackend) executeTemplates() {
 		return
 	}
 
+	if o.utils.Features().MergeIncludes {
+		o.log.Info("Processing", o.req.AST.Filename, "and its includes")
+		o.err = o.renderMergedFile(o.req.AST)
+		return
+	}
+
 	processed := make(map[*parser.Thrift]bool)
 
 	var trees chan *parser.Thrift
@@ -165,6 +171,18 @@ func (o *OpenAPIBackend) renderOneFile(ast *parser.Thrift) error {
 	return o.renderByTemplateWithAST(scope, o.tpl, filename, ast)
 }
 
+// renderMergedFile 把 root 及其 include 的所有 IDL 生成到以 root 命名的一个文档中
+func (o *OpenAPIBackend) renderMergedFile(root *parser.Thrift) error {
+	scope, err := BuildMergedScope(o.utils, root)
+	if err != nil {
+		return err
+	}
+
+	path := o.utils.CombineOutputPath(o.req.OutputPath, root)
+	filename := filepath.Join(path, o.utils.GetFilename(root))
+	return o.renderByTemplateWithAST(scope, o.tpl, filename, root)
+}
+
 var poolBuffer = sync.Pool{
 	New: func() any {
 		p := &bytes.Buffer{}
@@ -225,8 +243,12 @@ func (o *OpenAPIBackend) renderByTemplateWithAST(scope *Scope, executeTpl *templ
 	if err != nil {
 		return fmt.Errorf("%s: %w", filename, err)
 	}
+	content, err := formatDocument(w.Bytes(), o.utils.Features().OutputFormat)
+	if err != nil {
+		return fmt.Errorf("%s: %w", filename, err)
+	}
 	o.res.Contents = append(o.res.Contents, &plugin.Generated{
-		Content: w.String(),
+		Content: string(content),
 		Name:    &filename,
 	})
 	return nil
@@ -27,7 +27,7 @@ var allParams = []Parameter{
 	},
 	{
 		name: "version",
-		desc: "OpenAPI 规范版本 (默认: 3.0.0)",
+		desc: "OpenAPI 规范版本 (默认: 3.0.0)，3.1.x 按 JSON Schema 2020-12 生成 schema",
 	},
 	{
 		name: "title",
@@ -37,6 +37,14 @@ var allParams = []Parameter{
 		name: "base_path",
 		desc: "API 基础路径 (默认: /)",
 	},
+	{
+		name: "output_format",
+		desc: "输出格式: yaml 或 json (默认: yaml)",
+	},
+	{
+		name: "merge_includes",
+		desc: "把 include 树中的所有 IDL 合并生成到一个文档中，schema 名称带上 namespace",
+	},
 	{
 		name: "description",
 		desc: "API 描述",
@@ -0,0 +1,216 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openapi
+
+import (
+	"github.com/cloudwego/thriftgo/parser"
+)
+
+// 参数位置，与 OpenAPI 的 in 取值一致；body 和 form 表示请求体中的属性
+const (
+	locationPath   = "path"
+	locationQuery  = "query"
+	locationHeader = "header"
+	locationCookie = "cookie"
+	locationBody   = "body"
+	locationForm   = "form"
+)
+
+// locationAnnotations 列出声明字段位置的注解，与 Go 生成器的 HTTP 标签保持一致
+var locationAnnotations = []struct {
+	key      string
+	location string
+}{
+	{"api.path", locationPath},
+	{"api.query", locationQuery},
+	{"api.header", locationHeader},
+	{"api.cookie", locationCookie},
+	{"api.form", locationForm},
+	{"api.body", locationBody},
+}
+
+// OperationRequest 表示一个操作的请求部分
+type OperationRequest struct {
+	Parameters  []*OpenAPIParameter
+	RequestBody *OpenAPIRequestBody
+}
+
+// requestField 表示请求中的一个字段及其所在的 IDL
+type requestField struct {
+	ast   *parser.Thrift
+	field *parser.Field
+}
+
+// fieldLocation 返回字段的位置和参数名，没有位置注解时 location 为空
+func (u *CodeUtils) fieldLocation(field *parser.Field) (location, name string) {
+	name = u.GetPropertyNameWithStyle(field.Name)
+	for _, a := range locationAnnotations {
+		if vals := field.Annotations.Get(a.key); len(vals) > 0 {
+			if vals[0] != "" {
+				name = vals[0]
+			}
+			return a.location, name
+		}
+	}
+	return "", name
+}
+
+// flattenFields 返回结构体的字段，thrift.expand 字段和引用可展开结构体的字段
+// 会被替换为被引用结构体的字段；expanded 表示是否发生了展开
+func (u *CodeUtils) flattenFields(ast *parser.Thrift, st *parser.StructLike) (fields []requestField, expanded bool) {
+	for _, field := range st.Fields {
+		refAST, ref := u.resolveStructLike(ast, field.Type)
+		if ref != nil && (isExpandField(field) || isExpandableStruct(ref)) {
+			expanded = true
+			for _, refField := range ref.Fields {
+				fields = append(fields, requestField{ast: refAST, field: refField})
+			}
+			continue
+		}
+		fields = append(fields, requestField{ast: ast, field: field})
+	}
+	return fields, expanded
+}
+
+// hasRequestBody 判断 HTTP 方法是否携带请求体
+func hasRequestBody(method string) bool {
+	return method != "get" && method != "delete"
+}
+
+// GetOperationRequest 根据字段注解把函数参数拆分为 parameters 和 requestBody。
+// api.path/api.query/api.header/api.cookie 字段成为对应位置的参数，
+// api.body 字段放入 JSON 请求体，api.form 字段放入表单请求体；
+// 没有注解的字段在 GET/DELETE 中作为 query 参数，其余方法中放入请求体
+func (u *CodeUtils) GetOperationRequest(op *Operation) *OperationRequest {
+	req := &OperationRequest{}
+	withBody := hasRequestBody(op.Method)
+	pathParams := make(map[string]bool)
+	for _, name := range GetPathParams(op.Path) {
+		pathParams[name] = true
+	}
+
+	body := &OpenAPISchema{Type: "object", Properties: &SchemaProperties{}}
+	var bodyRef *OpenAPISchema
+	form, multipart := false, false
+	declared := make(map[string]bool)
+
+	add := func(f requestField, location, name string) {
+		switch location {
+		case locationForm, locationBody:
+			if location == locationForm {
+				form = true
+				if f.field.Type.Category == parser.Category_Binary {
+					multipart = true
+				}
+			}
+			body.Properties.Set(name, u.FieldSchema(f.ast, f.field))
+			if f.field.Requiredness.IsRequired() {
+				body.Required = append(body.Required, name)
+			}
+		default:
+			if location == locationPath {
+				declared[name] = true
+			}
+			req.Parameters = append(req.Parameters, &OpenAPIParameter{
+				Name:        name,
+				In:          location,
+				Required:    location == locationPath || f.field.Requiredness.IsRequired(),
+				Description: u.GetDescription(f.field),
+				Schema:      u.valueSchema(f.ast, f.field),
+			})
+		}
+	}
+
+	for _, arg := range op.Function.Arguments {
+		refAST, st := u.resolveStructLike(op.AST, arg.Type)
+		if st == nil {
+			// 基础类型参数：按注解放置，否则与路径变量同名时作为路径参数，其余与结构体字段一样
+			// 在 GET/DELETE 中作为 query 参数，其他方法中放入请求体
+			location, name := u.fieldLocation(arg)
+			if location == "" {
+				switch {
+				case pathParams[name]:
+					location = locationPath
+				case withBody:
+					location = locationBody
+				default:
+					location = locationQuery
+				}
+			}
+			add(requestField{ast: op.AST, field: arg}, location, name)
+			continue
+		}
+
+		fields, expanded := u.flattenFields(refAST, st)
+		annotated := false
+		for _, f := range fields {
+			if location, _ := u.fieldLocation(f.field); location != "" {
+				annotated = true
+				break
+			}
+		}
+		// 整个结构体作为 JSON 请求体时直接引用组件 schema
+		if withBody && !annotated && !expanded && len(op.Function.Arguments) == 1 {
+			bodyRef = u.TypeSchema(op.AST, arg.Type)
+			continue
+		}
+		for _, f := range fields {
+			location, name := u.fieldLocation(f.field)
+			if location == "" {
+				location = locationQuery
+				if withBody {
+					location = locationBody
+				}
+			}
+			add(f, location, name)
+		}
+	}
+
+	// OpenAPI 要求路径模板中的每个变量都有对应的 path 参数
+	for _, name := range GetPathParams(op.Path) {
+		if !declared[name] {
+			req.Parameters = append(req.Parameters, &OpenAPIParameter{
+				Name:     name,
+				In:       locationPath,
+				Required: true,
+				Schema:   &OpenAPISchema{Type: "string"},
+			})
+		}
+	}
+
+	switch {
+	case bodyRef != nil:
+		req.RequestBody = &OpenAPIRequestBody{
+			Required: true,
+			Content: map[string]*OpenAPIMediaType{
+				"application/json": {Schema: bodyRef},
+			},
+		}
+	case body.Properties.Len() > 0:
+		contentType := "application/json"
+		if multipart {
+			contentType = "multipart/form-data"
+		} else if form {
+			contentType = "application/x-www-form-urlencoded"
+		}
+		req.RequestBody = &OpenAPIRequestBody{
+			Required: len(body.Required) > 0,
+			Content: map[string]*OpenAPIMediaType{
+				contentType: {Schema: body},
+			},
+		}
+	}
+	return req
+}
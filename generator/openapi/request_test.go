@@ -0,0 +1,56 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openapi
+
+import (
+	"testing"
+
+	"github.com/cloudwego/thriftgo/generator/backend"
+	"github.com/cloudwego/thriftgo/parser"
+	"github.com/cloudwego/thriftgo/pkg/test"
+)
+
+func operation(t *testing.T, idl, method, path string) (*CodeUtils, *Operation) {
+	ast, err := parser.ParseString("main.thrift", idl)
+	test.Assert(t, err == nil, err)
+	u := NewCodeUtils(backend.DummyLogFunc())
+	svc := ast.Services[0]
+	return u, &Operation{Method: method, Path: path, Service: svc, Function: svc.Functions[0], AST: ast}
+}
+
+func TestOperationRequestScalarArguments(t *testing.T) {
+	idl := `
+service S {
+	string Update(1: i64 id, 2: string name, 3: i32 page (api.query = "p"))
+}`
+	// 有请求体的方法中，未注解的基础类型参数放入请求体，与路径变量同名的作为路径参数
+	u, op := operation(t, idl, "post", "/items/{id}")
+	req := u.GetOperationRequest(op)
+	test.Assert(t, len(req.Parameters) == 2, req.Parameters)
+	test.Assert(t, req.Parameters[0].Name == "id" && req.Parameters[0].In == locationPath, req.Parameters[0])
+	test.Assert(t, req.Parameters[1].Name == "p" && req.Parameters[1].In == locationQuery, req.Parameters[1])
+	test.Assert(t, req.RequestBody != nil)
+	body := req.RequestBody.Content["application/json"]
+	test.Assert(t, body != nil && body.Schema.Properties.Len() == 1, req.RequestBody.Content)
+	_, ok := body.Schema.Properties.Get("name")
+	test.Assert(t, ok)
+
+	// GET 中作为 query 参数
+	u, op = operation(t, idl, "get", "/items/{id}")
+	req = u.GetOperationRequest(op)
+	test.Assert(t, req.RequestBody == nil, req.RequestBody)
+	test.Assert(t, len(req.Parameters) == 3, req.Parameters)
+	test.Assert(t, req.Parameters[1].Name == "name" && req.Parameters[1].In == locationQuery, req.Parameters[1])
+}
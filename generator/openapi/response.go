@@ -0,0 +1,93 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openapi
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/cloudwego/thriftgo/internal/apiutil"
+	"github.com/cloudwego/thriftgo/parser"
+)
+
+// exceptionCode 返回异常对应的 HTTP 状态码，api.http_code 无效时打印警告并使用默认的 500
+func (u *CodeUtils) exceptionCode(ast *parser.Thrift, field *parser.Field) int {
+	code, err := apiutil.ExceptionCode(ast, field)
+	if err != nil {
+		u.log.Warnf("%s", err.Error())
+	}
+	return code
+}
+
+// errorResponse 返回没有声明异常时使用的通用错误响应
+func errorResponse(description string) *OpenAPIResponse {
+	props := &SchemaProperties{}
+	props.Set("error", &OpenAPISchema{Type: "string", Description: "错误信息"})
+	return &OpenAPIResponse{
+		Description: description,
+		Content: map[string]*OpenAPIMediaType{
+			"application/json": {Schema: &OpenAPISchema{Type: "object", Properties: props}},
+		},
+	}
+}
+
+// GetOperationResponses 返回操作的响应定义。
+// throws 中声明的每个异常按 api.http_code（默认 500）生成对应的错误响应，
+// 状态码相同的多个异常使用 oneOf 组合；未被异常覆盖的 400/500 保留通用错误响应
+func (u *CodeUtils) GetOperationResponses(op *Operation) map[string]*OpenAPIResponse {
+	function := op.Function
+	success := &OpenAPIResponse{Description: "成功响应"}
+	if function.FunctionType != nil && !function.Void {
+		success.Content = map[string]*OpenAPIMediaType{
+			"application/json": {Schema: u.TypeSchema(op.AST, function.FunctionType)},
+		}
+	}
+	responses := map[string]*OpenAPIResponse{
+		"200": success,
+		"400": errorResponse("请求错误"),
+		"500": errorResponse("服务器错误"),
+	}
+
+	var codes []string
+	names := make(map[string][]string)
+	schemas := make(map[string][]*OpenAPISchema)
+	for _, field := range function.Throws {
+		_, exception := u.resolveStructLike(op.AST, field.Type)
+		code := strconv.Itoa(u.exceptionCode(op.AST, field))
+		if _, ok := schemas[code]; !ok {
+			codes = append(codes, code)
+		}
+		name := getSimpleTypeName(field.Type.Name)
+		if exception != nil {
+			name = exception.Name
+		}
+		names[code] = append(names[code], name)
+		schemas[code] = append(schemas[code], u.TypeSchema(op.AST, field.Type))
+	}
+
+	for _, code := range codes {
+		schema := schemas[code][0]
+		if len(schemas[code]) > 1 {
+			schema = &OpenAPISchema{OneOf: schemas[code]}
+		}
+		responses[code] = &OpenAPIResponse{
+			Description: strings.Join(names[code], " | "),
+			Content: map[string]*OpenAPIMediaType{
+				"application/json": {Schema: schema},
+			},
+		}
+	}
+	return responses
+}
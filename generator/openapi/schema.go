@@ -0,0 +1,289 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openapi
+
+import (
+	"strings"
+
+	"github.com/cloudwego/thriftgo/parser"
+	"github.com/cloudwego/thriftgo/semantic"
+)
+
+// schemaRefPrefix 是组件 schema 引用的前缀
+const schemaRefPrefix = "#/components/schemas/"
+
+// TypeSchema 返回 Thrift 类型对应的 OpenAPI schema，ast 为类型所在的 IDL
+func (u *CodeUtils) TypeSchema(ast *parser.Thrift, typ *parser.Type) *OpenAPISchema {
+	if typ == nil {
+		return &OpenAPISchema{Type: "string"}
+	}
+
+	// 解开 typedef 和跨文件引用
+	if ast != nil {
+		if refAST, refType, err := semantic.Deref(ast, typ); err == nil {
+			ast, typ = refAST, refType
+		}
+	}
+
+	switch typ.Category {
+	case parser.Category_List:
+		return &OpenAPISchema{
+			Type:  "array",
+			Items: u.TypeSchema(ast, typ.ValueType),
+		}
+	case parser.Category_Set:
+		return &OpenAPISchema{
+			Type:        "array",
+			Items:       u.TypeSchema(ast, typ.ValueType),
+			UniqueItems: true,
+		}
+	case parser.Category_Map:
+		return &OpenAPISchema{
+			Type:                 "object",
+			AdditionalProperties: u.TypeSchema(ast, typ.ValueType),
+		}
+	case parser.Category_Enum, parser.Category_Struct, parser.Category_Union, parser.Category_Exception:
+		return &OpenAPISchema{Ref: u.schemaRef(ast, typ)}
+	default:
+		schema := &OpenAPISchema{
+			Type:   u.ToOpenAPIType(typ),
+			Format: u.ToOpenAPIFormat(typ),
+		}
+		u.setExample(schema, u.GetExample(typ))
+		return schema
+	}
+}
+
+// FieldSchema 返回字段对应的 OpenAPI schema，并附带字段描述；optional 字段允许为 null
+func (u *CodeUtils) FieldSchema(ast *parser.Thrift, field *parser.Field) *OpenAPISchema {
+	schema := u.propertySchema(ast, field)
+	if field.Requiredness.IsOptional() {
+		schema = u.nullable(schema)
+	}
+	return schema
+}
+
+// propertySchema 返回字段类型的 schema，非引用类型附带字段描述和校验约束
+func (u *CodeUtils) propertySchema(ast *parser.Thrift, field *parser.Field) *OpenAPISchema {
+	schema := u.valueSchema(ast, field)
+	if schema.Ref == "" {
+		schema.Description = u.GetDescription(field)
+	}
+	return schema
+}
+
+// IsOpenAPI31 判断是否按 OpenAPI 3.1（JSON Schema 2020-12）生成
+func (u *CodeUtils) IsOpenAPI31() bool {
+	return strings.HasPrefix(u.features.Version, "3.1")
+}
+
+// nullable 把 schema 标记为可为 null。
+// 3.0 使用 nullable 关键字，引用需要包一层 allOf；3.1 使用 "null" 类型，引用和 allOf 使用 oneOf
+func (u *CodeUtils) nullable(schema *OpenAPISchema) *OpenAPISchema {
+	// 枚举约束需要显式允许 null
+	if schema.Const != nil {
+		schema.Enum, schema.Const = []interface{}{schema.Const}, nil
+	}
+	if len(schema.Enum) > 0 {
+		schema.Enum = append(schema.Enum, nil)
+	}
+	if u.IsOpenAPI31() {
+		if schema.Ref != "" || len(schema.AllOf) > 0 {
+			return &OpenAPISchema{OneOf: []*OpenAPISchema{schema, {Type: "null"}}}
+		}
+		if t, ok := schema.Type.(string); ok {
+			schema.Type = []string{t, "null"}
+		}
+		return schema
+	}
+	if schema.Ref != "" {
+		return &OpenAPISchema{AllOf: []*OpenAPISchema{schema}, Nullable: true}
+	}
+	schema.Nullable = true
+	return schema
+}
+
+// setExample 设置示例值，3.0 使用 example，3.1 使用 examples 数组
+func (u *CodeUtils) setExample(schema *OpenAPISchema, example interface{}) {
+	if example == nil {
+		return
+	}
+	if u.IsOpenAPI31() {
+		schema.Examples = []interface{}{example}
+	} else {
+		schema.Example = example
+	}
+}
+
+// setEnum 设置枚举取值，3.1 中只有一个取值时使用 const
+func (u *CodeUtils) setEnum(schema *OpenAPISchema, values []interface{}) {
+	if u.IsOpenAPI31() && len(values) == 1 {
+		schema.Const = values[0]
+	} else {
+		schema.Enum = values
+	}
+}
+
+// EnumSchema 返回枚举的组件 schema
+func (u *CodeUtils) EnumSchema(enum *parser.Enum) *OpenAPISchema {
+	schema := &OpenAPISchema{Type: "string", Description: u.GetDescription(enum)}
+	var values []interface{}
+	for _, v := range u.GetEnumValues(enum) {
+		values = append(values, v)
+	}
+	u.setEnum(schema, values)
+	return schema
+}
+
+// StructSchema 返回结构体、联合体或异常的组件 schema，可展开的字段会被内联
+func (u *CodeUtils) StructSchema(ast *parser.Thrift, st *parser.StructLike) *OpenAPISchema {
+	if st.Category == "union" {
+		return u.unionSchema(ast, st)
+	}
+	schema := &OpenAPISchema{
+		Type:        "object",
+		Description: u.GetDescription(st),
+		Properties:  &SchemaProperties{},
+	}
+	fields, _ := u.flattenFields(ast, st)
+	for _, f := range fields {
+		name := u.GetPropertyNameWithStyle(f.field.Name)
+		schema.Properties.Set(name, u.FieldSchema(f.ast, f.field))
+		if f.field.Requiredness.IsRequired() {
+			schema.Required = append(schema.Required, name)
+		}
+	}
+	return schema
+}
+
+// getDiscriminator 返回联合体上 openapi.discriminator 注解声明的判别属性名
+func getDiscriminator(st *parser.StructLike) string {
+	if vals := st.Annotations.Get("openapi.discriminator"); len(vals) > 0 {
+		return vals[0]
+	}
+	return ""
+}
+
+// unionBranchName 返回带判别属性的联合体中某个字段对应的分支 schema 名
+func (u *CodeUtils) unionBranchName(ast *parser.Thrift, st *parser.StructLike, field *parser.Field) string {
+	return u.SchemaName(ast, st.Name+"_"+field.Name)
+}
+
+// unionSchema 返回联合体的 schema。
+// 没有判别属性时，每个字段是 oneOf 中一个只含该属性的对象，并限制只能出现一个属性；
+// 声明了 openapi.discriminator 时，oneOf 引用 UnionBranchSchemas 生成的分支 schema，
+// 判别属性的取值为字段的属性名
+func (u *CodeUtils) unionSchema(ast *parser.Thrift, st *parser.StructLike) *OpenAPISchema {
+	schema := &OpenAPISchema{
+		Type:        "object",
+		Description: u.GetDescription(st),
+	}
+	if u.IsOpenAPI31() {
+		closed := false
+		schema.UnevaluatedProperties = &closed
+	}
+
+	if disc := getDiscriminator(st); disc != "" {
+		schema.Discriminator = &OpenAPIDiscriminator{PropertyName: disc, Mapping: make(map[string]string)}
+		for _, field := range st.Fields {
+			ref := schemaRefPrefix + u.unionBranchName(ast, st, field)
+			schema.OneOf = append(schema.OneOf, &OpenAPISchema{Ref: ref})
+			schema.Discriminator.Mapping[u.GetPropertyNameWithStyle(field.Name)] = ref
+		}
+		return schema
+	}
+
+	schema.MinProperties, schema.MaxProperties = 1, 1
+	for _, field := range st.Fields {
+		schema.OneOf = append(schema.OneOf, u.unionBranch(ast, field, ""))
+	}
+	return schema
+}
+
+// unionBranch 返回联合体中一个字段的分支 schema，disc 非空时分支额外包含判别属性
+func (u *CodeUtils) unionBranch(ast *parser.Thrift, field *parser.Field, disc string) *OpenAPISchema {
+	name := u.GetPropertyNameWithStyle(field.Name)
+	branch := &OpenAPISchema{
+		Type:                 "object",
+		Properties:           &SchemaProperties{},
+		AdditionalProperties: false,
+	}
+	if disc != "" {
+		tag := &OpenAPISchema{Type: "string"}
+		u.setEnum(tag, []interface{}{name})
+		branch.Properties.Set(disc, tag)
+		branch.Required = append(branch.Required, disc)
+	}
+	branch.Properties.Set(name, u.propertySchema(ast, field))
+	branch.Required = append(branch.Required, name)
+	return branch
+}
+
+// UnionBranchSchemas 返回带判别属性的联合体的分支组件 schema，没有判别属性时返回 nil
+func (u *CodeUtils) UnionBranchSchemas(ast *parser.Thrift, st *parser.StructLike) []*ComponentSchema {
+	disc := getDiscriminator(st)
+	if disc == "" {
+		return nil
+	}
+	var schemas []*ComponentSchema
+	for _, field := range st.Fields {
+		branch := u.unionBranch(ast, field, disc)
+		branch.Description = u.GetDescription(field)
+		schemas = append(schemas, &ComponentSchema{Name: u.unionBranchName(ast, st, field), Schema: branch})
+	}
+	return schemas
+}
+
+// schemaRef 返回命名类型在 components/schemas 中的引用，ast 为类型定义所在的 IDL
+func (u *CodeUtils) schemaRef(ast *parser.Thrift, typ *parser.Type) string {
+	return schemaRefPrefix + u.SchemaName(ast, getSimpleTypeName(typ.Name))
+}
+
+// SchemaName 返回定义在 ast 中的类型在 components/schemas 中的名称。
+// 合并模式下名称带上 IDL 的 namespace（openapi 或 *，没有时使用文件名），避免不同文件中的同名类型冲突
+func (u *CodeUtils) SchemaName(ast *parser.Thrift, name string) string {
+	if !u.features.MergeIncludes || ast == nil {
+		return name
+	}
+	return ast.GetNamespaceOrReferenceName("openapi") + "." + name
+}
+
+// resolveStructLike 查找类型引用的结构体、联合体或异常，同时返回其所在的 IDL
+func (u *CodeUtils) resolveStructLike(ast *parser.Thrift, typ *parser.Type) (*parser.Thrift, *parser.StructLike) {
+	if ast == nil || typ == nil {
+		return nil, nil
+	}
+	refAST, refType, err := semantic.Deref(ast, typ)
+	if err != nil || !refType.Category.IsStructLike() {
+		return nil, nil
+	}
+	name := getSimpleTypeName(refType.Name)
+	for _, st := range refAST.GetStructLikes() {
+		if st.Name == name {
+			return refAST, st
+		}
+	}
+	return nil, nil
+}
+
+// getSimpleTypeName 去掉类型名中的 IDL 前缀
+func getSimpleTypeName(name string) string {
+	for i := len(name) - 1; i >= 0; i-- {
+		if name[i] == '.' {
+			return name[i+1:]
+		}
+	}
+	return name
+}
@@ -35,6 +35,8 @@ type Scope struct {
 	Typedefs        []*parser.Typedef
 	Constants       []*parser.Constant
 	ExpandedStructs map[string]*ExpandedStruct
+	ast             *parser.Thrift
+	trees           []*parser.Thrift // 生成到同一文档中的 IDL，合并模式下包含所有被 include 的文件
 	utils           *CodeUtils
 }
 
@@ -45,6 +47,21 @@ type ExpandedStruct struct {
 	ExpandedFieldNames  map[string]bool
 }
 
+// PathItem 表示同一路径下的所有操作
+type PathItem struct {
+	Path       string
+	Operations []*Operation
+}
+
+// Operation 表示一个服务函数对应的 HTTP 操作
+type Operation struct {
+	Method   string
+	Path     string
+	Service  *parser.Service
+	Function *parser.Function
+	AST      *parser.Thrift // 函数所在的 IDL
+}
+
 // ImportInfo represents import information.
 type ImportInfo struct {
 	Module string
@@ -65,6 +82,8 @@ func BuildScope(utils *CodeUtils, ast *parser.Thrift) (*Scope, error) {
 		Typedefs:        ast.Typedefs,
 		Constants:       ast.Constants,
 		ExpandedStructs: make(map[string]*ExpandedStruct),
+		ast:             ast,
+		trees:           []*parser.Thrift{ast},
 		utils:           utils,
 	}
 
@@ -74,6 +93,31 @@ func BuildScope(utils *CodeUtils, ast *parser.Thrift) (*Scope, error) {
 	return scope, nil
 }
 
+// BuildMergedScope builds a scope containing root and every IDL it includes,
+// visited in depth-first order.
+func BuildMergedScope(utils *CodeUtils, root *parser.Thrift) (*Scope, error) {
+	scope := &Scope{
+		Filename:        root.Filename,
+		Package:         getPackageName(root),
+		Imports:         buildImports(root),
+		ExpandedStructs: make(map[string]*ExpandedStruct),
+		ast:             root,
+		utils:           utils,
+	}
+	for ast := range root.DepthFirstSearch() {
+		scope.Enums = append(scope.Enums, ast.Enums...)
+		scope.Structs = append(scope.Structs, ast.Structs...)
+		scope.Unions = append(scope.Unions, ast.Unions...)
+		scope.Exceptions = append(scope.Exceptions, ast.Exceptions...)
+		scope.Services = append(scope.Services, ast.Services...)
+		scope.Typedefs = append(scope.Typedefs, ast.Typedefs...)
+		scope.Constants = append(scope.Constants, ast.Constants...)
+		scope.trees = append(scope.trees, ast)
+		processExpandedStructs(scope, ast)
+	}
+	return scope, nil
+}
+
 // processExpandedStructs 处理结构体展开
 func processExpandedStructs(scope *Scope, ast *parser.Thrift) {
 	// 处理结构体
@@ -204,30 +248,33 @@ func (s *Scope) GetServerInfo() map[string]string {
 	return server
 }
 
-// GetAllSchemas returns all schemas defined in the scope.
-func (s *Scope) GetAllSchemas() []interface{} {
-	var schemas []interface{}
-	
-	// 添加枚举
-	for _, enum := range s.Enums {
-		schemas = append(schemas, enum)
-	}
-	
-	// 添加结构体
-	for _, structLike := range s.Structs {
-		schemas = append(schemas, structLike)
-	}
-	
-	// 添加联合体
-	for _, union := range s.Unions {
-		schemas = append(schemas, union)
-	}
-	
-	// 添加异常
-	for _, exception := range s.Exceptions {
-		schemas = append(schemas, exception)
+// ComponentSchema 表示 components/schemas 中的一个命名 schema
+type ComponentSchema struct {
+	Name   string
+	Schema *OpenAPISchema
+}
+
+// GetComponentSchemas returns the component schemas in definition order:
+// enums, structs, unions and exceptions.
+func (s *Scope) GetComponentSchemas() []*ComponentSchema {
+	var schemas []*ComponentSchema
+	for _, ast := range s.trees {
+		for _, enum := range ast.Enums {
+			schemas = append(schemas, &ComponentSchema{
+				Name:   s.utils.SchemaName(ast, enum.Name),
+				Schema: s.utils.EnumSchema(enum),
+			})
+		}
+		for _, group := range [][]*parser.StructLike{ast.Structs, ast.Unions, ast.Exceptions} {
+			for _, st := range group {
+				schemas = append(schemas, &ComponentSchema{
+					Name:   s.utils.SchemaName(ast, st.Name),
+					Schema: s.utils.StructSchema(ast, st),
+				})
+				schemas = append(schemas, s.utils.UnionBranchSchemas(ast, st)...)
+			}
+		}
 	}
-	
 	return schemas
 }
 
@@ -236,6 +283,46 @@ func (s *Scope) GetAllServices() []*parser.Service {
 	return s.Services
 }
 
+// GetPaths returns all operations grouped by path.
+// 多个函数可能共享同一路径（如 GET 与 DELETE /v1/users/{id}），
+// OpenAPI 要求它们出现在同一个 path item 下，顺序与 IDL 中的定义顺序一致
+func (s *Scope) GetPaths() []*PathItem {
+	var items []*PathItem
+	index := make(map[string]*PathItem)
+	for _, ast := range s.trees {
+		for _, service := range ast.Services {
+			for _, function := range service.Functions {
+				path := s.utils.GetHTTPPath(service.Name, function)
+				item, ok := index[path]
+				if !ok {
+					item = &PathItem{Path: path}
+					index[path] = item
+					items = append(items, item)
+				}
+				method := s.utils.GetHTTPMethod(function)
+				for _, op := range item.Operations {
+					if op.Method == method {
+						s.utils.log.Warnf("duplicated operation %s %s: %s.%s is ignored", method, path, service.Name, function.Name)
+						method = ""
+						break
+					}
+				}
+				if method == "" {
+					continue
+				}
+				item.Operations = append(item.Operations, &Operation{
+					Method:   method,
+					Path:     path,
+					Service:  service,
+					Function: function,
+					AST:      ast,
+				})
+			}
+		}
+	}
+	return items
+}
+
 // GetSchemaByName returns a schema by name.
 func (s *Scope) GetSchemaByName(name string) interface{} {
 	// 查找枚举
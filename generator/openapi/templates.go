@@ -22,140 +22,41 @@ func Templates() []string {
 }
 
 const openapiTemplate = `
-openapi: {{.GetOpenAPIVersion}}
+openapi: "{{.GetOpenAPIVersion}}"
 info:
   title: {{.GetAPITitle}}
   description: {{.GetAPIDescription}}
   version: 1.0.0
 paths:
-{{range .GetAllServices}}
-  {{$service := .}}
-  {{range .Functions}}
-  {{ToOpenAPIPath $service.Name .Name}}:
-    {{ToOpenAPIMethod .Name}}:
+{{range .GetPaths}}
+  {{.Path}}:
+  {{range .Operations}}
+    {{$service := .Service}}
+    {{$request := GetOperationRequest .}}
+    {{$responses := GetOperationResponses .}}
+    {{.Method}}:
+    {{with .Function}}
       tags:
         - {{$service.Name}}
       summary: {{GetDescription .}}
       operationId: {{GetOperationId $service .}}
-      {{if .Arguments}}
+      {{if $request.Parameters}}
       parameters:
-        {{range .Arguments}}
-        - name: {{GetPropertyNameWithStyle .Name}}
-          in: query
-          required: {{IsRequired .}}
-          schema:
-            type: {{ToOpenAPIType .Type}}
-            {{if ToOpenAPIFormat .Type}}
-            format: {{ToOpenAPIFormat .Type}}
-            {{end}}
-            {{if GetExample .Type}}
-            example: {{GetExample .Type}}
-            {{end}}
-          description: {{GetDescription .}}
-        {{end}}
+{{ToYAML $request.Parameters 8}}
       {{end}}
-      {{if .FunctionType}}
+      {{if $request.RequestBody}}
       requestBody:
-        required: true
-        content:
-          application/json:
-            schema:
-              $ref: '#/components/schemas/{{GetSchemaName .FunctionType}}'
+{{ToYAML $request.RequestBody 8}}
       {{end}}
       responses:
-        '200':
-          description: 成功响应
-          {{if .FunctionType}}
-          content:
-            application/json:
-              schema:
-                $ref: '#/components/schemas/{{GetSchemaName .FunctionType}}'
-          {{end}}
-        '400':
-          description: 请求错误
-          content:
-            application/json:
-              schema:
-                type: object
-                properties:
-                  error:
-                    type: string
-                    description: 错误信息
-        '500':
-          description: 服务器错误
-          content:
-            application/json:
-              schema:
-                type: object
-                properties:
-                  error:
-                    type: string
-                    description: 错误信息
+{{ToYAML $responses 8}}
+    {{end}}
   {{end}}
 {{end}}
 components:
   schemas:
-{{range .GetAllSchemas}}
-    {{if eq (printf "%T" .) "*parser.Enum"}}
-    {{.Name}}:
-      type: string
-      enum:
-        {{range .Values}}
-        - {{.Name}}
-        {{end}}
-      description: {{GetDescription .}}
-    {{else if eq (printf "%T" .) "*parser.StructLike"}}
+{{range .GetComponentSchemas}}
     {{.Name}}:
-      type: object
-      description: {{GetDescription .}}
-      {{if .Fields}}
-      properties:
-        {{range .Fields}}
-        {{if IsFieldExpanded .}}
-        {{/* 展开字段：显示展开后的字段 */}}
-        {{else}}
-        {{GetPropertyNameWithStyle .Name}}:
-          type: {{ToOpenAPIType .Type}}
-          {{if ToOpenAPIFormat .Type}}
-          format: {{ToOpenAPIFormat .Type}}
-          {{end}}
-          {{if GetExample .Type}}
-          example: {{GetExample .Type}}
-          {{end}}
-          description: {{GetDescription .}}
-        {{end}}
-        {{end}}
-        {{/* 添加展开的字段 */}}
-        {{if GetExpandedFields .}}
-        {{range GetExpandedFields .}}
-        {{GetPropertyNameWithStyle .Name}}:
-          type: {{ToOpenAPIType .Type}}
-          {{if ToOpenAPIFormat .Type}}
-          format: {{ToOpenAPIFormat .Type}}
-          {{end}}
-          {{if GetExample .Type}}
-          example: {{GetExample .Type}}
-          {{end}}
-          description: {{GetDescription .}}
-        {{end}}
-        {{end}}
-      required:
-        {{range .Fields}}
-        {{if not (IsFieldExpanded .)}}
-        {{if IsRequired .}}
-        - {{GetPropertyNameWithStyle .Name}}
-        {{end}}
-        {{end}}
-        {{end}}
-        {{/* 添加展开字段的必需字段 */}}
-        {{if GetExpandedFields .}}
-        {{range GetExpandedFields .}}
-        {{if IsRequired .}}
-        - {{GetPropertyNameWithStyle .Name}}
-        {{end}}
-        {{end}}
-        {{end}}
-      {{end}}
-    {{end}}
+{{ToYAML .Schema 6}}
 {{end}}
 `
@@ -14,77 +14,175 @@
 
 package openapi
 
-import "github.com/cloudwego/thriftgo/parser"
+import (
+	"gopkg.in/yaml.v3"
+
+	"github.com/cloudwego/thriftgo/parser"
+)
 
 // OpenAPISchema represents an OpenAPI schema.
+// Type 在 3.0 中为字符串，在 3.1 中可以是包含 "null" 的类型数组；
+// AdditionalProperties 可以是 schema 或布尔值
 type OpenAPISchema struct {
-	Type        string                 `json:"type,omitempty"`
-	Format      string                 `json:"format,omitempty"`
-	Description string                 `json:"description,omitempty"`
-	Example     interface{}            `json:"example,omitempty"`
-	Properties  map[string]interface{} `json:"properties,omitempty"`
-	Required    []string               `json:"required,omitempty"`
-	Enum        []string               `json:"enum,omitempty"`
-	Items       interface{}            `json:"items,omitempty"`
-	Ref         string                 `json:"$ref,omitempty"`
+	Ref                   string                `json:"$ref,omitempty" yaml:"$ref,omitempty"`
+	Type                  interface{}           `json:"type,omitempty" yaml:"type,omitempty"`
+	Format                string                `json:"format,omitempty" yaml:"format,omitempty"`
+	Description           string                `json:"description,omitempty" yaml:"description,omitempty"`
+	Nullable              bool                  `json:"nullable,omitempty" yaml:"nullable,omitempty"`
+	Const                 interface{}           `json:"const,omitempty" yaml:"const,omitempty"`
+	Enum                  []interface{}         `json:"enum,omitempty" yaml:"enum,omitempty"`
+	Minimum               *float64              `json:"minimum,omitempty" yaml:"minimum,omitempty"`
+	ExclusiveMinimum      interface{}           `json:"exclusiveMinimum,omitempty" yaml:"exclusiveMinimum,omitempty"`
+	Maximum               *float64              `json:"maximum,omitempty" yaml:"maximum,omitempty"`
+	ExclusiveMaximum      interface{}           `json:"exclusiveMaximum,omitempty" yaml:"exclusiveMaximum,omitempty"`
+	MinLength             *int                  `json:"minLength,omitempty" yaml:"minLength,omitempty"`
+	MaxLength             *int                  `json:"maxLength,omitempty" yaml:"maxLength,omitempty"`
+	Pattern               string                `json:"pattern,omitempty" yaml:"pattern,omitempty"`
+	Items                 *OpenAPISchema        `json:"items,omitempty" yaml:"items,omitempty"`
+	MinItems              *int                  `json:"minItems,omitempty" yaml:"minItems,omitempty"`
+	MaxItems              *int                  `json:"maxItems,omitempty" yaml:"maxItems,omitempty"`
+	UniqueItems           bool                  `json:"uniqueItems,omitempty" yaml:"uniqueItems,omitempty"`
+	AdditionalProperties  interface{}           `json:"additionalProperties,omitempty" yaml:"additionalProperties,omitempty"`
+	Properties            *SchemaProperties     `json:"properties,omitempty" yaml:"properties,omitempty"`
+	Required              []string              `json:"required,omitempty" yaml:"required,omitempty"`
+	MinProperties         int                   `json:"minProperties,omitempty" yaml:"minProperties,omitempty"`
+	MaxProperties         int                   `json:"maxProperties,omitempty" yaml:"maxProperties,omitempty"`
+	AllOf                 []*OpenAPISchema      `json:"allOf,omitempty" yaml:"allOf,omitempty"`
+	OneOf                 []*OpenAPISchema      `json:"oneOf,omitempty" yaml:"oneOf,omitempty"`
+	Discriminator         *OpenAPIDiscriminator `json:"discriminator,omitempty" yaml:"discriminator,omitempty"`
+	UnevaluatedProperties *bool                 `json:"unevaluatedProperties,omitempty" yaml:"unevaluatedProperties,omitempty"`
+	Example               interface{}           `json:"example,omitempty" yaml:"example,omitempty"`
+	Examples              []interface{}         `json:"examples,omitempty" yaml:"examples,omitempty"`
+	XValidation           string                `json:"x-api-vd,omitempty" yaml:"x-api-vd,omitempty"` // 无法转换的 api.vd 原始表达式
+}
+
+// OpenAPIDiscriminator 表示 oneOf 分支的判别属性
+type OpenAPIDiscriminator struct {
+	PropertyName string            `json:"propertyName" yaml:"propertyName"`
+	Mapping      map[string]string `json:"mapping,omitempty" yaml:"mapping,omitempty"`
+}
+
+// SchemaProperties 是按定义顺序输出的 schema 属性集合
+type SchemaProperties struct {
+	names   []string
+	schemas map[string]*OpenAPISchema
+}
+
+// Set 添加或替换一个属性，新属性追加在末尾
+func (p *SchemaProperties) Set(name string, schema *OpenAPISchema) {
+	if p.schemas == nil {
+		p.schemas = make(map[string]*OpenAPISchema)
+	}
+	if _, ok := p.schemas[name]; !ok {
+		p.names = append(p.names, name)
+	}
+	p.schemas[name] = schema
+}
+
+// Get returns the schema of a property.
+func (p *SchemaProperties) Get(name string) (*OpenAPISchema, bool) {
+	if p == nil {
+		return nil, false
+	}
+	schema, ok := p.schemas[name]
+	return schema, ok
+}
+
+// Len returns the number of properties.
+func (p *SchemaProperties) Len() int {
+	if p == nil {
+		return 0
+	}
+	return len(p.names)
+}
+
+// IsZero 使空属性集合在 omitempty 时被省略
+func (p *SchemaProperties) IsZero() bool {
+	return p.Len() == 0
+}
+
+// MarshalYAML implements the yaml.Marshaler interface.
+func (p *SchemaProperties) MarshalYAML() (interface{}, error) {
+	node := &yaml.Node{Kind: yaml.MappingNode}
+	for _, name := range p.names {
+		value := &yaml.Node{}
+		if err := value.Encode(p.schemas[name]); err != nil {
+			return nil, err
+		}
+		node.Content = append(node.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: name}, value)
+	}
+	return node, nil
 }
 
 // OpenAPIParameter represents an OpenAPI parameter.
 type OpenAPIParameter struct {
-	Name        string      `json:"name"`
-	In          string      `json:"in"`
-	Required    bool        `json:"required,omitempty"`
-	Description string      `json:"description,omitempty"`
-	Schema      interface{} `json:"schema,omitempty"`
+	Name        string         `json:"name" yaml:"name"`
+	In          string         `json:"in" yaml:"in"`
+	Required    bool           `json:"required,omitempty" yaml:"required,omitempty"`
+	Description string         `json:"description,omitempty" yaml:"description,omitempty"`
+	Schema      *OpenAPISchema `json:"schema,omitempty" yaml:"schema,omitempty"`
+}
+
+// OpenAPIMediaType represents an OpenAPI media type object.
+type OpenAPIMediaType struct {
+	Schema *OpenAPISchema `json:"schema,omitempty" yaml:"schema,omitempty"`
+}
+
+// OpenAPIRequestBody represents an OpenAPI request body.
+type OpenAPIRequestBody struct {
+	Description string                       `json:"description,omitempty" yaml:"description,omitempty"`
+	Required    bool                         `json:"required,omitempty" yaml:"required,omitempty"`
+	Content     map[string]*OpenAPIMediaType `json:"content" yaml:"content"`
 }
 
 // OpenAPIResponse represents an OpenAPI response.
 type OpenAPIResponse struct {
-	Description string                 `json:"description"`
-	Content     map[string]interface{} `json:"content,omitempty"`
+	Description string                       `json:"description" yaml:"description"`
+	Content     map[string]*OpenAPIMediaType `json:"content,omitempty" yaml:"content,omitempty"`
 }
 
 // OpenAPIOperation represents an OpenAPI operation.
 type OpenAPIOperation struct {
-	Tags        []string                 `json:"tags,omitempty"`
-	Summary     string                   `json:"summary,omitempty"`
-	Description string                   `json:"description,omitempty"`
-	OperationId string                   `json:"operationId,omitempty"`
-	Parameters  []OpenAPIParameter       `json:"parameters,omitempty"`
-	RequestBody map[string]interface{}   `json:"requestBody,omitempty"`
-	Responses   map[string]OpenAPIResponse `json:"responses"`
+	Tags        []string                    `json:"tags,omitempty" yaml:"tags,omitempty"`
+	Summary     string                      `json:"summary,omitempty" yaml:"summary,omitempty"`
+	Description string                      `json:"description,omitempty" yaml:"description,omitempty"`
+	OperationId string                      `json:"operationId,omitempty" yaml:"operationId,omitempty"`
+	Parameters  []*OpenAPIParameter         `json:"parameters,omitempty" yaml:"parameters,omitempty"`
+	RequestBody *OpenAPIRequestBody         `json:"requestBody,omitempty" yaml:"requestBody,omitempty"`
+	Responses   map[string]*OpenAPIResponse `json:"responses" yaml:"responses"`
 }
 
 // OpenAPIPathItem represents an OpenAPI path item.
 type OpenAPIPathItem struct {
-	Get    *OpenAPIOperation `json:"get,omitempty"`
-	Post   *OpenAPIOperation `json:"post,omitempty"`
-	Put    *OpenAPIOperation `json:"put,omitempty"`
-	Delete *OpenAPIOperation `json:"delete,omitempty"`
+	Get    *OpenAPIOperation `json:"get,omitempty" yaml:"get,omitempty"`
+	Post   *OpenAPIOperation `json:"post,omitempty" yaml:"post,omitempty"`
+	Put    *OpenAPIOperation `json:"put,omitempty" yaml:"put,omitempty"`
+	Delete *OpenAPIOperation `json:"delete,omitempty" yaml:"delete,omitempty"`
+	Patch  *OpenAPIOperation `json:"patch,omitempty" yaml:"patch,omitempty"`
 }
 
 // OpenAPIInfo represents OpenAPI info section.
 type OpenAPIInfo struct {
-	Title       string                 `json:"title"`
-	Description string                 `json:"description,omitempty"`
-	Version     string                 `json:"version"`
-	Contact     map[string]string      `json:"contact,omitempty"`
-	License     map[string]string      `json:"license,omitempty"`
+	Title       string            `json:"title" yaml:"title"`
+	Description string            `json:"description,omitempty" yaml:"description,omitempty"`
+	Version     string            `json:"version" yaml:"version"`
+	Contact     map[string]string `json:"contact,omitempty" yaml:"contact,omitempty"`
+	License     map[string]string `json:"license,omitempty" yaml:"license,omitempty"`
 }
 
 // OpenAPIServer represents an OpenAPI server.
 type OpenAPIServer struct {
-	URL         string `json:"url"`
-	Description string `json:"description,omitempty"`
+	URL         string `json:"url" yaml:"url"`
+	Description string `json:"description,omitempty" yaml:"description,omitempty"`
 }
 
 // OpenAPIDocument represents the complete OpenAPI document.
 type OpenAPIDocument struct {
-	OpenAPI    string                        `json:"openapi"`
-	Info       OpenAPIInfo                   `json:"info"`
-	Servers    []OpenAPIServer               `json:"servers,omitempty"`
-	Paths      map[string]OpenAPIPathItem    `json:"paths"`
-	Components map[string]map[string]interface{} `json:"components,omitempty"`
+	OpenAPI    string                            `json:"openapi" yaml:"openapi"`
+	Info       OpenAPIInfo                       `json:"info" yaml:"info"`
+	Servers    []OpenAPIServer                   `json:"servers,omitempty" yaml:"servers,omitempty"`
+	Paths      map[string]OpenAPIPathItem        `json:"paths" yaml:"paths"`
+	Components map[string]map[string]interface{} `json:"components,omitempty" yaml:"components,omitempty"`
 }
 
 // ConvertToOpenAPISchema converts a Thrift type to OpenAPI schema.
@@ -114,25 +212,22 @@ func ConvertToOpenAPISchema(typ *parser.Type) OpenAPISchema {
 		schema.Format = "binary"
 	case parser.Category_List:
 		schema.Type = "array"
-		schema.Items = ConvertToOpenAPISchema(typ.ValueType)
+		items := ConvertToOpenAPISchema(typ.ValueType)
+		schema.Items = &items
 	case parser.Category_Map:
 		schema.Type = "object"
-		// Map 的键值对类型
-		schema.Properties = map[string]interface{}{
-			"key":   ConvertToOpenAPISchema(typ.KeyType),
-			"value": ConvertToOpenAPISchema(typ.ValueType),
-		}
+		// Map 的值类型
+		value := ConvertToOpenAPISchema(typ.ValueType)
+		schema.AdditionalProperties = &value
 	case parser.Category_Set:
 		schema.Type = "array"
-		schema.Items = ConvertToOpenAPISchema(typ.ValueType)
-		schema.Properties = map[string]interface{}{
-			"uniqueItems": true,
-		}
+		items := ConvertToOpenAPISchema(typ.ValueType)
+		schema.Items = &items
+		schema.UniqueItems = true
 	case parser.Category_Enum:
 		schema.Type = "string"
 		// 枚举值需要从 AST 中获取
 	case parser.Category_Struct, parser.Category_Union, parser.Category_Exception:
-		schema.Type = "object"
 		schema.Ref = "#/components/schemas/" + typ.Name
 	default:
 		schema.Type = "string"
@@ -144,14 +239,14 @@ func ConvertToOpenAPISchema(typ *parser.Type) OpenAPISchema {
 // ConvertStructToOpenAPISchema converts a Thrift struct to OpenAPI schema.
 func ConvertStructToOpenAPISchema(structLike *parser.StructLike) OpenAPISchema {
 	schema := OpenAPISchema{
-		Type:        "object",
-		Properties:  make(map[string]interface{}),
-		Required:    []string{},
+		Type:       "object",
+		Properties: &SchemaProperties{},
+		Required:   []string{},
 	}
 
 	for _, field := range structLike.Fields {
 		fieldSchema := ConvertToOpenAPISchema(field.Type)
-		schema.Properties[field.Name] = fieldSchema
+		schema.Properties.Set(field.Name, &fieldSchema)
 
 		if field.Requiredness == parser.FieldType_Required {
 			schema.Required = append(schema.Required, field.Name)
@@ -165,7 +260,7 @@ func ConvertStructToOpenAPISchema(structLike *parser.StructLike) OpenAPISchema {
 func ConvertEnumToOpenAPISchema(enum *parser.Enum) OpenAPISchema {
 	schema := OpenAPISchema{
 		Type: "string",
-		Enum: []string{},
+		Enum: []interface{}{},
 	}
 
 	for _, value := range enum.Values {
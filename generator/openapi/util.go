@@ -15,11 +15,14 @@
 package openapi
 
 import (
+	"bytes"
 	"fmt"
 	"path/filepath"
 	"strings"
 	"text/template"
 
+	"gopkg.in/yaml.v3"
+
 	"github.com/cloudwego/thriftgo/generator/backend"
 	"github.com/cloudwego/thriftgo/parser"
 )
@@ -39,6 +42,10 @@ type Features struct {
 	Version   string
 	Title     string
 	BasePath  string
+	// MergeIncludes 把 include 树中的所有 IDL 合并生成到一个文档中
+	MergeIncludes bool
+	// OutputFormat 输出格式，yaml（默认）或 json
+	OutputFormat string
 	// 命名风格选项
 	SnakeStylePropertyName     bool // 使用 snake_case 命名属性
 	LowerCamelCasePropertyName bool // 使用 lowerCamelCase 命名属性（默认）
@@ -50,6 +57,7 @@ func NewCodeUtils(log backend.LogFunc) *CodeUtils {
 		features: &Features{
 			SkipEmpty:                  false,
 			Version:                    "3.0.0",
+			OutputFormat:               formatYAML,
 			Title:                      "Thrift API",
 			BasePath:                   "/",
 			SnakeStylePropertyName:     false,
@@ -83,6 +91,15 @@ func (u *CodeUtils) HandleOptions(args []string) error {
 			u.features.Title = value
 		case "base_path":
 			u.features.BasePath = value
+		case "output_format":
+			switch value {
+			case formatYAML, formatJSON:
+				u.features.OutputFormat = value
+			default:
+				return fmt.Errorf("unsupported output_format %q, expect yaml or json", value)
+			}
+		case "merge_includes":
+			u.features.MergeIncludes = value == "" || value == "true"
 		case "description":
 			u.options["description"] = value
 		case "contact_name":
@@ -123,7 +140,7 @@ func (u *CodeUtils) Features() *Features {
 // GetFilename generates the output filename for a Thrift file.
 func (u *CodeUtils) GetFilename(ast *parser.Thrift) string {
 	base := strings.TrimSuffix(filepath.Base(ast.Filename), ".thrift")
-	return base + ".yaml"
+	return base + "." + u.features.OutputFormat
 }
 
 // CombineOutputPath combines the output path with the Thrift file path.
@@ -159,6 +176,11 @@ func (u *CodeUtils) BuildFuncMap() template.FuncMap {
 		"ToOpenAPIFormat":          u.ToOpenAPIFormat,
 		"ToOpenAPIMethod":          u.ToOpenAPIMethod,
 		"ToOpenAPIPath":            u.ToOpenAPIPath,
+		"GetHTTPMethod":            u.GetHTTPMethod,
+		"GetHTTPPath":              u.GetHTTPPath,
+		"GetOperationRequest":      u.GetOperationRequest,
+		"GetOperationResponses":    u.GetOperationResponses,
+		"ToYAML":                   ToYAML,
 		"GetSchemaName":            u.GetSchemaName,
 		"GetServiceName":           u.GetServiceName,
 		"GetOperationId":           u.GetOperationId,
@@ -178,6 +200,25 @@ func (u *CodeUtils) BuildFuncMap() template.FuncMap {
 	}
 }
 
+// ToYAML 将值序列化为 YAML，并为每一行添加 indent 个空格的缩进，用于嵌入模板
+func ToYAML(v interface{}, indent int) (string, error) {
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(v); err != nil {
+		return "", err
+	}
+	if err := enc.Close(); err != nil {
+		return "", err
+	}
+	pad := strings.Repeat(" ", indent)
+	lines := strings.Split(strings.TrimRight(buf.String(), "\n"), "\n")
+	for i := range lines {
+		lines[i] = pad + lines[i]
+	}
+	return strings.Join(lines, "\n"), nil
+}
+
 // ToOpenAPIType converts Thrift types to OpenAPI types.
 func (u *CodeUtils) ToOpenAPIType(typ *parser.Type) string {
 	if typ == nil {
@@ -275,6 +316,75 @@ func (u *CodeUtils) ToOpenAPIPath(serviceName, funcName string) string {
 	return fmt.Sprintf("%s%s/%s", basePath, servicePath, funcPath)
 }
 
+// httpMethodAnnotations 列出可以声明 HTTP 方法和路径的函数注解，按优先级排列
+var httpMethodAnnotations = []struct {
+	key    string
+	method string
+}{
+	{"api.get", "get"},
+	{"api.post", "post"},
+	{"api.put", "put"},
+	{"api.delete", "delete"},
+	{"api.patch", "patch"},
+}
+
+// getHTTPAnnotation 返回函数上第一个 api.get/api.post/... 注解对应的方法和路径
+func getHTTPAnnotation(function *parser.Function) (method, path string, ok bool) {
+	if function == nil {
+		return "", "", false
+	}
+	for _, a := range httpMethodAnnotations {
+		if vals := function.Annotations.Get(a.key); len(vals) > 0 && vals[0] != "" {
+			return a.method, vals[0], true
+		}
+	}
+	return "", "", false
+}
+
+// GetHTTPMethod returns the HTTP method of a function.
+// api.get/api.post/api.put/api.delete/api.patch 注解优先，没有注解时退回到按函数名推断
+func (u *CodeUtils) GetHTTPMethod(function *parser.Function) string {
+	if method, _, ok := getHTTPAnnotation(function); ok {
+		return method
+	}
+	return u.ToOpenAPIMethod(function.Name)
+}
+
+// GetHTTPPath returns the OpenAPI path of a function.
+// 注解中的路由（如 /v1/users/:id）会被转换为 OpenAPI 路径模板（/v1/users/{id}），
+// 没有注解时退回到 base_path + 服务名 + 函数名的拼接规则
+func (u *CodeUtils) GetHTTPPath(serviceName string, function *parser.Function) string {
+	if _, path, ok := getHTTPAnnotation(function); ok {
+		return toPathTemplate(path)
+	}
+	return u.ToOpenAPIPath(serviceName, function.Name)
+}
+
+// toPathTemplate 将 Hertz/Gin 风格的路由参数（:id、*filepath）转换为 OpenAPI 的 {id} 形式
+func toPathTemplate(route string) string {
+	if !strings.HasPrefix(route, "/") {
+		route = "/" + route
+	}
+	segments := strings.Split(route, "/")
+	for i, seg := range segments {
+		if len(seg) > 1 && (seg[0] == ':' || seg[0] == '*') {
+			segments[i] = "{" + seg[1:] + "}"
+		}
+	}
+	return strings.Join(segments, "/")
+}
+
+// GetPathParams returns the names of the template variables in an OpenAPI path.
+func GetPathParams(path string) []string {
+	var params []string
+	for _, seg := range strings.Split(path, "/") {
+		if strings.HasPrefix(seg, "{") && strings.HasSuffix(seg, "}") {
+			params = append(params, seg[1:len(seg)-1])
+		}
+	}
+	return params
+}
+
 // toKebabCase converts camelCase to kebab-case.
 func (u *CodeUtils) toKebabCase(s string) string {
 	var result []rune
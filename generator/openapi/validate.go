@@ -0,0 +1,209 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openapi
+
+import (
+	"strconv"
+
+	"github.com/cloudwego/thriftgo/internal/apiutil"
+	"github.com/cloudwego/thriftgo/parser"
+)
+
+// valueSchema 返回字段类型的 schema，并附加 api.vd 中声明的校验约束
+func (u *CodeUtils) valueSchema(ast *parser.Thrift, field *parser.Field) *OpenAPISchema {
+	schema := u.TypeSchema(ast, field.Type)
+	if schema.Ref == "" {
+		u.applyValidation(schema, field)
+		return schema
+	}
+	// 与 $ref 并列的关键字会被忽略，约束需要放在 allOf 中。枚举等组件的 JSON 取值与 api.vd
+	// 校验的值不同（枚举为名称，api.vd 比较的是整数），因此不做转换，只保存原始表达式
+	if expr := apiutil.VdExpr(field); expr != "" {
+		return &OpenAPISchema{AllOf: []*OpenAPISchema{schema, {XValidation: expr}}}
+	}
+	return schema
+}
+
+// applyValidation 把字段上的 api.vd 表达式转换为 JSON Schema 关键字。
+// 表达式按顶层的 && 拆分，无法转换的部分不会丢失：此时完整的原始表达式保存在 x-api-vd 扩展中
+func (u *CodeUtils) applyValidation(schema *OpenAPISchema, field *parser.Field) {
+	expr := apiutil.VdExpr(field)
+	if expr == "" {
+		return
+	}
+	clauses := apiutil.ParseVd(expr)
+	if len(clauses) == 0 {
+		return
+	}
+	translated := true
+	for _, clause := range clauses {
+		if !u.applyVdClause(schema, clause) {
+			translated = false
+		}
+	}
+	if !translated {
+		schema.XValidation = expr
+	}
+	u.adjustExample(schema)
+}
+
+// adjustExample 让示例值满足转换后的约束，无法满足时去掉示例
+func (u *CodeUtils) adjustExample(schema *OpenAPISchema) {
+	example := schema.Example
+	if len(schema.Examples) > 0 {
+		example = schema.Examples[0]
+	}
+	if example == nil {
+		return
+	}
+	switch {
+	case schema.Const != nil:
+		example = schema.Const
+	case len(schema.Enum) > 0:
+		example = schema.Enum[0]
+	case schema.Pattern != "":
+		example = nil
+	case schema.MinLength != nil || schema.MaxLength != nil:
+		if s, ok := example.(string); ok {
+			if schema.MinLength != nil && len(s) < *schema.MinLength ||
+				schema.MaxLength != nil && len(s) > *schema.MaxLength {
+				example = nil
+			}
+		}
+	case schema.Minimum != nil || schema.Maximum != nil:
+		if v, ok := toFloat(example); ok {
+			if schema.Minimum != nil && v < *schema.Minimum {
+				example = *schema.Minimum
+			}
+			if schema.Maximum != nil && v > *schema.Maximum {
+				example = *schema.Maximum
+			}
+		}
+	}
+	schema.Example, schema.Examples = nil, nil
+	u.setExample(schema, example)
+}
+
+// toFloat 把数字示例值转换为 float64
+func toFloat(v interface{}) (float64, bool) {
+	switch n := v.(type) {
+	case int:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case float64:
+		return n, true
+	}
+	return 0, false
+}
+
+// applyVdClause 转换单个校验条件，返回是否转换成功
+func (u *CodeUtils) applyVdClause(schema *OpenAPISchema, clause *apiutil.VdClause) bool {
+	switch clause.Kind {
+	case apiutil.VdLen:
+		n, _ := strconv.Atoi(clause.Bound)
+		min, max := -1, -1
+		switch clause.Op {
+		case ">":
+			min = n + 1
+		case ">=":
+			min = n
+		case "<":
+			max = n - 1
+		case "<=":
+			max = n
+		case "==":
+			min, max = n, n
+		default:
+			return false
+		}
+		return setLengthBounds(schema, min, max)
+
+	case apiutil.VdRange:
+		if schema.Type != "integer" && schema.Type != "number" {
+			return false
+		}
+		v, _ := strconv.ParseFloat(clause.Bound, 64)
+		switch clause.Op {
+		case ">=":
+			schema.Minimum = &v
+		case "<=":
+			schema.Maximum = &v
+		case ">":
+			if u.IsOpenAPI31() {
+				schema.ExclusiveMinimum = v
+			} else {
+				schema.Minimum, schema.ExclusiveMinimum = &v, true
+			}
+		case "<":
+			if u.IsOpenAPI31() {
+				schema.ExclusiveMaximum = v
+			} else {
+				schema.Maximum, schema.ExclusiveMaximum = &v, true
+			}
+		default:
+			return false
+		}
+		return true
+
+	case apiutil.VdRegexp:
+		if schema.Type != "string" {
+			return false
+		}
+		schema.Pattern = clause.Pattern
+		return true
+
+	case apiutil.VdIn:
+		var values []interface{}
+		for _, v := range clause.Values {
+			values = append(values, v.Value)
+		}
+		u.setEnum(schema, values)
+		return true
+	}
+	return false
+}
+
+// setLengthBounds 根据 schema 类型设置长度约束：字符串为 minLength/maxLength，
+// list/set 为 minItems/maxItems，map 为 minProperties/maxProperties；负数表示不设置
+func setLengthBounds(schema *OpenAPISchema, min, max int) bool {
+	ptr := func(v int) *int { return &v }
+	switch schema.Type {
+	case "string":
+		if min >= 0 {
+			schema.MinLength = ptr(min)
+		}
+		if max >= 0 {
+			schema.MaxLength = ptr(max)
+		}
+	case "array":
+		if min >= 0 {
+			schema.MinItems = ptr(min)
+		}
+		if max >= 0 {
+			schema.MaxItems = ptr(max)
+		}
+	case "object":
+		if min > 0 {
+			schema.MinProperties = min
+		}
+		if max > 0 {
+			schema.MaxProperties = max
+		}
+	default:
+		return false
+	}
+	return true
+}
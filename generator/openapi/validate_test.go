@@ -0,0 +1,67 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package openapi
+
+import (
+	"testing"
+
+	"github.com/cloudwego/thriftgo/generator/backend"
+	"github.com/cloudwego/thriftgo/parser"
+	"github.com/cloudwego/thriftgo/pkg/test"
+	"github.com/cloudwego/thriftgo/semantic"
+)
+
+func TestValidationOnReference(t *testing.T) {
+	ast, err := parser.ParseString("main.thrift", `
+enum Color { RED = 1, BLUE = 2 }
+struct Req {
+	1: required Color color (api.vd = "$>0")
+	2: optional Color tint (api.vd = "in($, 1, 2)")
+	3: required Color plain
+	4: required string name (api.vd = "len($)>0")
+}`)
+	test.Assert(t, err == nil, err)
+	_, err = semantic.NewChecker(semantic.Options{}).CheckAll(ast)
+	test.Assert(t, err == nil, err)
+	test.Assert(t, semantic.ResolveSymbols(ast) == nil)
+	fields := ast.Structs[0].Fields
+
+	for _, version := range []string{"3.0.3", "3.1.0"} {
+		u := NewCodeUtils(backend.DummyLogFunc())
+		test.Assert(t, u.HandleOptions([]string{"version=" + version}) == nil)
+
+		// 引用不能与约束并列，原始表达式放在 allOf 中
+		s := u.FieldSchema(ast, fields[0])
+		test.Assert(t, s.Ref == "" && len(s.AllOf) == 2, version, s)
+		test.Assert(t, s.AllOf[0].Ref == schemaRefPrefix+"Color", s.AllOf[0])
+		test.Assert(t, s.AllOf[1].XValidation == "$>0" && s.AllOf[1].Minimum == nil, s.AllOf[1])
+
+		// optional 字段仍然允许为 null
+		s = u.FieldSchema(ast, fields[1])
+		if u.IsOpenAPI31() {
+			test.Assert(t, len(s.OneOf) == 2 && len(s.OneOf[0].AllOf) == 2, s)
+			test.Assert(t, s.OneOf[0].AllOf[1].XValidation == "in($, 1, 2)", s.OneOf[0].AllOf[1])
+		} else {
+			test.Assert(t, s.Nullable && len(s.AllOf) == 2 && len(s.AllOf[1].Enum) == 0, s)
+			test.Assert(t, s.AllOf[1].XValidation == "in($, 1, 2)", s.AllOf[1])
+		}
+
+		s = u.FieldSchema(ast, fields[2])
+		test.Assert(t, s.Ref == schemaRefPrefix+"Color" && len(s.AllOf) == 0, s)
+
+		s = u.FieldSchema(ast, fields[3])
+		test.Assert(t, s.MinLength != nil && *s.MinLength == 1 && s.XValidation == "", s)
+	}
+}
@@ -38,6 +38,9 @@ type TypeScriptBackend struct {
 
 	utils *CodeUtils
 	funcs template.FuncMap
+
+	hasClients bool // 是否生成了 HTTP 客户端
+	hasServers bool // 是否生成了服务端路由
 }
 
 // Name implements the Backend interface.
@@ -76,6 +79,8 @@ func (t *TypeScriptBackend) Generate(req *plugin.Request, log backend.LogFunc) *
 	t.req = req
 	t.res = plugin.NewResponse()
 	t.log = log
+	t.hasClients = false
+	t.hasServers = false
 	t.prepareUtilities()
 	if t.err != nil {
 		return t.buildResponse()
@@ -87,6 +92,10 @@ func (t *TypeScriptBackend) Generate(req *plugin.Request, log backend.LogFunc) *
 	t.prepareTemplates()
 	t.fillRequisitions()
 	t.executeTemplates()
+	t.renderRuntimeFile()
+	t.renderMockRuntimeFile()
+	t.renderTransportFile()
+	t.renderServerFile()
 	return t.buildResponse()
 }
 
@@ -135,6 +144,11 @@ func (t *TypeScriptBackend) executeTemplates() {
 		return
 	}
 
+	if t.utils.Features().Bundle == BundleTree {
+		t.err = t.renderTreeBundle()
+		return
+	}
+
 	processed := make(map[*parser.Thrift]bool)
 
 	var trees chan *parser.Thrift
@@ -165,9 +179,19 @@ func (t *TypeScriptBackend) renderOneFile(ast *parser.Thrift) error {
 		return err
 	}
 
+	t.utils.SetCurrentAST(ast)
+	t.utils.SetRootImportPath(t.outputRoot(), t.utils.CombineOutputPath(t.req.OutputPath, ast))
+
 	// 检查是否有 TypeScript namespace
 	tsNamespace := t.utils.getTypeScriptNamespace(ast)
-	if tsNamespace != "" {
+	if tsNamespace != "" && t.utils.Features().Bundle == BundleFile {
+		// 合并为 namespace 目录下的 index 模块，其他文件按目录导入时路径不变
+		path := t.utils.CombineOutputPath(t.req.OutputPath, ast)
+		if err := t.renderByTemplate(scope, t.tpl, filepath.Join(path, "index"+t.utils.FileExt())); err != nil {
+			return err
+		}
+		return t.renderBundleServiceFiles(scope, path, "./index")
+	} else if tsNamespace != "" {
 		// 有 namespace，生成到对应文件夹
 		path := t.utils.CombineOutputPath(t.req.OutputPath, ast)
 		return t.renderSeparateFiles(scope, t.tpl, path, ast)
@@ -192,13 +216,20 @@ func (t *TypeScriptBackend) renderSeparateFiles(scope *Scope, executeTpl *templa
 		}
 	}
 
+	// 常量统一生成到 constants.ts
+	if len(scope.Constants) > 0 {
+		if err := t.renderConstantsFile(scope, executeTpl, basePath, ast); err != nil {
+			return err
+		}
+	}
+
 	// 为每个结构体生成单独文件
 	for _, structLike := range scope.Structs {
 		if err := t.renderStructFile(scope, executeTpl, basePath, structLike, ast); err != nil {
 			return err
 		}
-		// 检查是否需要生成 fields.ts 文件
-		if ShouldGenerateFieldsFile(structLike) {
+		// 检查是否需要生成 fields.ts 文件，声明文件中不生成
+		if ShouldGenerateFieldsFile(structLike) && !t.utils.Features().Declarations {
 			if err := t.renderFieldsFile(scope, executeTpl, basePath, structLike); err != nil {
 				return err
 			}
@@ -226,16 +257,117 @@ func (t *TypeScriptBackend) renderSeparateFiles(scope *Scope, executeTpl *templa
 		}
 	}
 
-	// 生成简化版服务实现类文件（如果有服务的话）
-	if len(scope.Services) > 0 {
+	return t.renderServiceRuntimeFiles(scope, executeTpl, basePath)
+}
+
+// renderServiceRuntimeFiles 生成服务的客户端、hooks 和路由文件
+func (t *TypeScriptBackend) renderServiceRuntimeFiles(scope *Scope, executeTpl *template.Template, basePath string) error {
+	// 生成简化版服务实现类文件（如果有服务的话），声明文件中只包含服务接口
+	if len(scope.Services) > 0 && !t.utils.Features().Declarations {
 		if err := t.renderSimpleServiceImplementationFiles(scope, executeTpl, basePath); err != nil {
 			return err
 		}
 	}
 
+	// 生成数据请求 hooks 文件
+	if len(scope.Services) > 0 && t.utils.Features().Hooks != "" {
+		if err := t.renderServiceHooksFiles(scope, executeTpl, basePath); err != nil {
+			return err
+		}
+	}
+
+	// 生成服务端路由文件
+	if len(scope.Services) > 0 && t.utils.Features().ServerRouter != "" {
+		if err := t.renderServerRouterFiles(scope, executeTpl, basePath); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
+// outputRoot 返回生成代码的根目录
+func (t *TypeScriptBackend) outputRoot() string {
+	if t.req.OutputPath == "" {
+		return "."
+	}
+	return t.req.OutputPath
+}
+
+// renderRuntimeFile 在输出根目录生成编解码和 JSON 转换代码依赖的运行时
+func (t *TypeScriptBackend) renderRuntimeFile() {
+	if t.err != nil || !t.utils.NeedsRuntime() {
+		return
+	}
+
+	var w bytes.Buffer
+	if err := t.tpl.ExecuteTemplate(&w, "runtime", nil); err != nil {
+		t.err = fmt.Errorf("%s: %w", RuntimeFileName, err)
+		return
+	}
+	filename := filepath.Join(t.outputRoot(), RuntimeFileName)
+	t.res.Contents = append(t.res.Contents, &plugin.Generated{
+		Content: w.String(),
+		Name:    &filename,
+	})
+}
+
+// renderMockRuntimeFile 在输出根目录生成 mock 工厂函数依赖的运行时
+func (t *TypeScriptBackend) renderMockRuntimeFile() {
+	if t.err != nil || !t.utils.Features().Mocks {
+		return
+	}
+
+	var w bytes.Buffer
+	if err := t.tpl.ExecuteTemplate(&w, "mockRuntime", nil); err != nil {
+		t.err = fmt.Errorf("%s: %w", MockRuntimeFileName, err)
+		return
+	}
+	filename := filepath.Join(t.outputRoot(), MockRuntimeFileName)
+	t.res.Contents = append(t.res.Contents, &plugin.Generated{
+		Content: w.String(),
+		Name:    &filename,
+	})
+}
+
+// renderTransportFile 在输出根目录生成客户端依赖的 HTTP 传输层
+func (t *TypeScriptBackend) renderTransportFile() {
+	if t.err != nil || !t.hasClients || !t.utils.ShouldGenerateTransport() {
+		return
+	}
+
+	var w bytes.Buffer
+	if err := t.tpl.ExecuteTemplate(&w, "httpTransport", nil); err != nil {
+		t.err = fmt.Errorf("%s: %w", TransportFileName, err)
+		return
+	}
+	filename := filepath.Join(t.outputRoot(), TransportFileName)
+	t.res.Contents = append(t.res.Contents, &plugin.Generated{
+		Content: w.String(),
+		Name:    &filename,
+	})
+}
+
+// renderServerFile 在输出根目录生成服务端路由依赖的运行时
+func (t *TypeScriptBackend) renderServerFile() {
+	if t.err != nil || !t.hasServers {
+		return
+	}
+
+	// http_server.ts 位于输出根目录，BizException 的导入路径相对于根目录
+	t.utils.SetRootImportPath(t.outputRoot(), t.outputRoot())
+	var w bytes.Buffer
+	if err := t.tpl.ExecuteTemplate(&w, "httpServer", nil); err != nil {
+		t.err = fmt.Errorf("%s: %w", ServerFileName, err)
+		return
+	}
+	filename := filepath.Join(t.outputRoot(), ServerFileName)
+	t.res.Contents = append(t.res.Contents, &plugin.Generated{
+		Content: w.String(),
+		Name:    &filename,
+	})
+}
+
 var poolBuffer = sync.Pool{
 	New: func() any {
 		p := &bytes.Buffer{}
@@ -289,7 +421,7 @@ func (t *TypeScriptBackend) PostProcess(path string, content []byte) ([]byte, er
 
 // renderIndexFile 生成 index.ts 文件
 func (t *TypeScriptBackend) renderIndexFile(scope *Scope, executeTpl *template.Template, basePath string) error {
-	filename := filepath.Join(basePath, "index.ts")
+	filename := filepath.Join(basePath, "index"+t.utils.FileExt())
 
 	// index.ts 只导入外部文件，不导入本地文件
 	externalImports := []ImportInfo{}
@@ -307,6 +439,7 @@ func (t *TypeScriptBackend) renderIndexFile(scope *Scope, executeTpl *template.T
 		Unions:     scope.Unions,
 		Exceptions: scope.Exceptions,
 		Services:   scope.Services,
+		Constants:  scope.Constants,
 		utils:      scope.utils,
 	}
 
@@ -315,7 +448,7 @@ func (t *TypeScriptBackend) renderIndexFile(scope *Scope, executeTpl *template.T
 
 // renderEnumFile 生成枚举文件
 func (t *TypeScriptBackend) renderEnumFile(scope *Scope, executeTpl *template.Template, basePath string, enum *parser.Enum) error {
-	filename := filepath.Join(basePath, strings.ToLower(enum.Name)+".ts")
+	filename := filepath.Join(basePath, strings.ToLower(enum.Name)+t.utils.FileExt())
 
 	// 为枚举单独收集导入（枚举通常不需要外部导入）
 	enumImports := []ImportInfo{}
@@ -332,9 +465,30 @@ func (t *TypeScriptBackend) renderEnumFile(scope *Scope, executeTpl *template.Te
 	return t.renderByTemplateWithTemplate(enumScope, executeTpl, filename, "singleEnum")
 }
 
+// renderConstantsFile 生成常量文件，常量的值已展开为字面量，只需导入常量类型中引用的枚举和结构体
+func (t *TypeScriptBackend) renderConstantsFile(scope *Scope, executeTpl *template.Template, basePath string, ast *parser.Thrift) error {
+	filename := filepath.Join(basePath, "constants"+t.utils.FileExt())
+
+	// 把常量的类型当作字段，复用结构体的导入收集逻辑
+	holder := &parser.StructLike{Name: "constants"}
+	for _, constant := range scope.Constants {
+		holder.Fields = append(holder.Fields, &parser.Field{Name: constant.Name, Type: constant.Type})
+	}
+
+	constantScope := &Scope{
+		Filename:  scope.Filename,
+		Package:   scope.Package,
+		Imports:   t.collectImportsForStruct(scope, holder, ast),
+		Constants: scope.Constants,
+		utils:     scope.utils,
+	}
+
+	return t.renderByTemplateWithTemplate(constantScope, executeTpl, filename, "constants")
+}
+
 // renderStructFile 生成结构体文件
 func (t *TypeScriptBackend) renderStructFile(scope *Scope, executeTpl *template.Template, basePath string, structLike *parser.StructLike, ast *parser.Thrift) error {
-	filename := filepath.Join(basePath, strings.ToLower(structLike.Name)+".ts")
+	filename := filepath.Join(basePath, strings.ToLower(structLike.Name)+t.utils.FileExt())
 
 	// 为单个结构体收集导入
 	structImports := t.collectImportsForStruct(scope, structLike, ast)
@@ -354,7 +508,7 @@ func (t *TypeScriptBackend) renderStructFile(scope *Scope, executeTpl *template.
 
 // renderServiceFile 生成服务文件
 func (t *TypeScriptBackend) renderServiceFile(scope *Scope, executeTpl *template.Template, basePath string, service *parser.Service) error {
-	filename := filepath.Join(basePath, strings.ToLower(service.Name)+".ts")
+	filename := filepath.Join(basePath, strings.ToLower(service.Name)+t.utils.FileExt())
 
 	// 创建只包含该服务的 scope
 	serviceScope := &Scope{
@@ -401,9 +555,9 @@ func (t *TypeScriptBackend) collectImportsForStruct(scope *Scope, structLike *pa
 		expandedFieldNames = expandedStruct.ExpandedFieldNames
 	}
 
-	// 只收集未被展开的字段的导入
+	// 只收集未被展开的字段的导入，编解码方法、校验函数和 mock 工厂函数会整体处理被展开的字段，也需要导入其类型
 	for _, field := range structLike.Fields {
-		if !expandedFieldNames[field.Name] {
+		if !expandedFieldNames[field.Name] || t.utils.HasValueObject() || t.utils.Features().Validators || t.utils.Features().Mocks {
 			tempScope.collectImportsFromTypeWithCurrentFile(field.Type, importMap, ast, structLike.Name)
 			// 检查字段类型及其容器类型中的本地类型引用
 			t.collectLocalTypesFromType(field.Type, localTypes)
@@ -425,7 +579,8 @@ func (t *TypeScriptBackend) collectImportsForStruct(scope *Scope, structLike *pa
 
 	// 转换为 ImportInfo 列表，并去重
 	importSet := make(map[string]ImportInfo)
-	for module, types := range importMap {
+	for _, module := range sortedKeys(importMap) {
+		types := importMap[module]
 		if len(types) > 0 {
 			// 计算相对路径
 			relativePath := scope.calculateRelativePath(currentNamespace, module)
@@ -457,7 +612,7 @@ func (t *TypeScriptBackend) collectImportsForStruct(scope *Scope, structLike *pa
 	}
 
 	// 添加本地类型导入
-	for typeName := range localTypes {
+	for _, typeName := range sortedKeys(localTypes) {
 		// 在分离文件模式下，所有本地类型引用都需要导入
 		// 因为每个类型都会生成到单独的文件中
 		localPath := "./" + strings.ToLower(typeName)
@@ -486,9 +641,10 @@ func (t *TypeScriptBackend) collectImportsForStruct(scope *Scope, structLike *pa
 		}
 	}
 
-	// 将去重后的导入添加到列表中，过滤掉自引用的导入
+	// 将去重后的导入按路径排序后添加到列表中，过滤掉自引用的导入
 	var imports []ImportInfo
-	for _, importInfo := range importSet {
+	for _, path := range sortedKeys(importSet) {
+		importInfo := importSet[path]
 		// 过滤掉自引用的导入
 		if !isSelfReferenceImport(importInfo, structLike.Name) {
 			imports = append(imports, importInfo)
@@ -672,6 +828,7 @@ func (t *TypeScriptBackend) renderServiceImplementationFile(scope *Scope, execut
 // renderSimpleServiceImplementationFiles 生成简化版服务实现类文件
 func (t *TypeScriptBackend) renderSimpleServiceImplementationFiles(scope *Scope, executeTpl *template.Template, basePath string) error {
 	// 为每个服务生成简化版实现类文件
+	t.hasClients = true
 	for _, service := range scope.Services {
 		if err := t.renderSimpleServiceImplementationFile(scope, executeTpl, basePath, service); err != nil {
 			return err
@@ -700,15 +857,90 @@ func (t *TypeScriptBackend) renderSimpleServiceImplementationFile(scope *Scope,
 	}
 
 	// 为客户端文件单独收集导入信息
-	ast := GetGlobalAST()
-	if ast != nil {
-		// 在分离文件模式下，客户端文件需要导入其他类型文件
-		serviceScope.collectImportsForService(ast, service.Name)
-	}
+	t.collectServiceImports(serviceScope, service)
 
 	return t.renderByTemplateWithTemplate(serviceScope, executeTpl, filename, "simpleServiceImplementation")
 }
 
+// collectServiceImports 为客户端、hooks 和路由文件收集导入信息，合并输出时服务接口和类型从合并模块导入
+func (t *TypeScriptBackend) collectServiceImports(serviceScope *Scope, service *parser.Service) {
+	module := t.utils.bundleModule
+	if module == "" {
+		// 在分离文件模式下，服务相关文件需要导入其他类型文件
+		if ast := GetGlobalAST(); ast != nil {
+			serviceScope.collectImportsForService(ast, service.Name)
+		}
+		return
+	}
+	serviceScope.collectImportsForService(t.utils.currentAST, service.Name)
+	serviceScope.bundleImports(module, t.utils.Features().Bundle == BundleTree)
+}
+
+// renderServiceHooksFiles 生成数据请求 hooks 文件
+func (t *TypeScriptBackend) renderServiceHooksFiles(scope *Scope, executeTpl *template.Template, basePath string) error {
+	for _, service := range scope.Services {
+		if err := t.renderServiceHooksFile(scope, executeTpl, basePath, service); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// renderServiceHooksFile 生成单个服务的 hooks 文件，导入信息与客户端文件相同
+func (t *TypeScriptBackend) renderServiceHooksFile(scope *Scope, executeTpl *template.Template, basePath string, service *parser.Service) error {
+	filename := filepath.Join(basePath, strings.ToLower(service.Name)+"hooks.ts")
+
+	serviceScope := &Scope{
+		Filename:        scope.Filename,
+		Package:         scope.Package,
+		Imports:         []ImportInfo{},
+		Services:        []*parser.Service{service},
+		Structs:         scope.Structs,
+		Unions:          scope.Unions,
+		Exceptions:      scope.Exceptions,
+		Enums:           scope.Enums,
+		Typedefs:        scope.Typedefs,
+		ExpandedStructs: scope.ExpandedStructs,
+		utils:           scope.utils,
+	}
+	t.collectServiceImports(serviceScope, service)
+
+	return t.renderByTemplateWithTemplate(serviceScope, executeTpl, filename, "serviceHooks")
+}
+
+// renderServerRouterFiles 生成服务端路由文件
+func (t *TypeScriptBackend) renderServerRouterFiles(scope *Scope, executeTpl *template.Template, basePath string) error {
+	t.hasServers = true
+	for _, service := range scope.Services {
+		if err := t.renderServerRouterFile(scope, executeTpl, basePath, service); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// renderServerRouterFile 生成单个服务的路由文件，导入信息与客户端文件相同
+func (t *TypeScriptBackend) renderServerRouterFile(scope *Scope, executeTpl *template.Template, basePath string, service *parser.Service) error {
+	filename := filepath.Join(basePath, strings.ToLower(service.Name)+"router.ts")
+
+	serviceScope := &Scope{
+		Filename:        scope.Filename,
+		Package:         scope.Package,
+		Imports:         []ImportInfo{},
+		Services:        []*parser.Service{service},
+		Structs:         scope.Structs,
+		Unions:          scope.Unions,
+		Exceptions:      scope.Exceptions,
+		Enums:           scope.Enums,
+		Typedefs:        scope.Typedefs,
+		ExpandedStructs: scope.ExpandedStructs,
+		utils:           scope.utils,
+	}
+	t.collectServiceImports(serviceScope, service)
+
+	return t.renderByTemplateWithTemplate(serviceScope, executeTpl, filename, "serverRouter")
+}
+
 // renderFieldsFile 生成 fields.ts 文件
 func (t *TypeScriptBackend) renderFieldsFile(scope *Scope, executeTpl *template.Template, basePath string, structLike *parser.StructLike) error {
 	filename := filepath.Join(basePath, GetFieldsFileName(structLike))
@@ -0,0 +1,208 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package typescript
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cloudwego/thriftgo/parser"
+)
+
+// 参数在 HTTP 请求中的位置，取值为服务端请求对象上对应的字段名
+const (
+	sourcePath   = "params"
+	sourceQuery  = "query"
+	sourceHeader = "headers"
+	sourceForm   = "form"
+	sourceBody   = "body"
+)
+
+// httpRoute 表示方法上一个 api.get/api.post 等注解声明的路由
+type httpRoute struct {
+	Method string
+	Path   string
+}
+
+// getHTTPRoutes 返回方法上 api.* 注解声明的所有路由，按 apiMethods 的顺序排列，与 Go 生成器一致
+func getHTTPRoutes(f *parser.Function) (routes []*httpRoute) {
+	for _, m := range apiMethods {
+		for _, path := range f.Annotations.Get(m.annotation) {
+			if path = strings.TrimSpace(path); path != "" {
+				routes = append(routes, &httpRoute{Method: m.method, Path: path})
+			}
+		}
+	}
+	return
+}
+
+// paramBinding 表示方法参数或结构体参数的一个字段在 HTTP 请求中的位置
+type paramBinding struct {
+	Arg      *parser.Field // 方法参数
+	Field    *parser.Field // 绑定的字段，非结构体参数为参数本身
+	Property string        // 字段在参数上的属性访问，如 .userId，非结构体参数为空
+	Source   string
+	Key      string
+}
+
+// getParamBindings 返回方法参数在请求中的位置，客户端和服务端路由按同样的规则绑定：
+// api.path、api.query、api.header、api.form 和 api.body 绑定到路径、查询参数、请求头、表单和请求体，
+// 名称出现在路径中的非结构体参数绑定到路径，其余的 GET 和 DELETE 放在查询参数中，其他方法放在请求体中；
+// 一个请求只能有一种请求体，有表单字段时其余请求体中的字段也以表单发送
+func getParamBindings(f *parser.Function, route *httpRoute, features *Features) []*paramBinding {
+	defaultSource := sourceBody
+	if route.Method == "GET" || route.Method == "DELETE" {
+		defaultSource = sourceQuery
+	}
+	var bs []*paramBinding
+	bind := func(arg, field *parser.Field, property string) {
+		name := GetPropertyNameWithStyle(field.Name, features)
+		b := &paramBinding{Arg: arg, Field: field, Property: property, Source: defaultSource, Key: name}
+		for _, a := range []struct{ annotation, source string }{
+			{"api.path", sourcePath},
+			{"api.query", sourceQuery},
+			{"api.header", sourceHeader},
+			{"api.form", sourceForm},
+			{"api.body", sourceBody},
+		} {
+			if v := field.Annotations.Get(a.annotation); len(v) > 0 {
+				b.Source = a.source
+				if key := strings.TrimSpace(v[0]); key != "" {
+					b.Key = key
+				}
+				bs = append(bs, b)
+				return
+			}
+		}
+		if property == "" && strings.Contains(route.Path, ":"+name) {
+			b.Source = sourcePath
+		}
+		bs = append(bs, b)
+	}
+	for _, arg := range f.Arguments {
+		if !IsStructField(arg) {
+			bind(arg, arg, "")
+			continue
+		}
+		for _, field := range GetStructFields(arg) {
+			fields := GetFieldExpandedFields(field)
+			if len(fields) == 0 {
+				fields = []*parser.Field{field}
+			}
+			for _, f := range fields {
+				bind(arg, f, "."+GetPropertyNameWithStyle(f.Name, features))
+			}
+		}
+	}
+
+	hasForm := false
+	for _, b := range bs {
+		hasForm = hasForm || b.Source == sourceForm
+	}
+	if hasForm {
+		for _, b := range bs {
+			if b.Source == sourceBody {
+				b.Source = sourceForm
+			}
+		}
+	}
+	return bs
+}
+
+// GetClientRequest 生成客户端方法中发送请求并返回结果的语句，参数按 getParamBindings 的规则放入请求，
+// 有多个路由时使用第一个，没有 api.* 注解的方法不发送请求
+func (u *CodeUtils) GetClientRequest(f *parser.Function) string {
+	routes := getHTTPRoutes(f)
+	if len(routes) == 0 {
+		return ""
+	}
+	route := routes[0]
+	w := &codecWriter{ast: u.currentAST, i64As: u.features.I64As}
+	bs := getParamBindings(f, route, u.features)
+	sources := make(map[string]bool)
+	for _, b := range bs {
+		sources[b.Source] = true
+	}
+
+	w.line(3, "let url = '%s';", route.Path)
+	w.line(3, "const queryParams: any = {};")
+	if sources[sourceHeader] {
+		w.line(3, "const headers: { [key: string]: string } = {};")
+	}
+	hasBody := sources[sourceBody] || (!sources[sourceForm] && route.Method != "GET" && route.Method != "DELETE")
+	if hasBody {
+		w.line(3, "const bodyParam: any = {};")
+	}
+	if sources[sourceForm] {
+		w.line(3, "const formParams: any = {};")
+	}
+	for _, b := range bs {
+		value := u.GetClientArgName(b.Arg) + b.Property
+		w.line(3, "if (%s !== undefined && %s !== null) {", value, value)
+		switch b.Source {
+		case sourcePath:
+			w.line(4, "url = url.replace(':%s', encodeURIComponent(String(%s)));", b.Key, value)
+		case sourceQuery:
+			w.line(4, "queryParams['%s'] = %s;", b.Key, value)
+		case sourceHeader:
+			w.line(4, "headers['%s'] = %s;", b.Key, w.headerValue(b.Field.Type, value))
+		case sourceForm:
+			w.line(4, "formParams['%s'] = %s;", b.Key, value)
+		default:
+			w.line(4, "bodyParam['%s'] = %s;", b.Key, value)
+		}
+		w.line(3, "}")
+	}
+
+	req := fmt.Sprintf("method: '%s', url, query: queryParams", route.Method)
+	if sources[sourceHeader] {
+		req += ", headers"
+	}
+	if hasBody {
+		req += ", body: bodyParam"
+	}
+	if sources[sourceForm] {
+		req += ", form: formParams"
+	}
+	w.line(3, "const data = await this.transport.request({ %s });", req)
+	w.line(3, "if (data !== null && typeof data === 'object' && 'code' in data && data.code !== 0) {")
+	w.line(4, "throw new BizException(data.code, data.msg);")
+	w.line(3, "}")
+	w.line(3, "return %s;", u.GetClientResult(f))
+	return w.flush()
+}
+
+// IsOptionalArgument 判断客户端方法的第 i 个参数能否省略，之后还有必填参数时不能省略
+func IsOptionalArgument(f *parser.Function, i int) bool {
+	for _, arg := range f.Arguments[i:] {
+		if !IsOptional(arg) || IsStructField(arg) {
+			return false
+		}
+	}
+	return true
+}
+
+// headerValue 返回把 value 转换为请求头字符串的表达式：列表以逗号连接，结构体和 map 以 JSON 字符串传递
+func (w *codecWriter) headerValue(t *parser.Type, value string) string {
+	_, typ := w.deref(w.ast, t)
+	switch typ.Category {
+	case parser.Category_List, parser.Category_Set:
+		return fmt.Sprintf("Array.from(%s).join(',')", value)
+	case parser.Category_Map, parser.Category_Struct, parser.Category_Union, parser.Category_Exception:
+		return fmt.Sprintf("JSON.stringify(%s)", value)
+	default:
+		return fmt.Sprintf("String(%s)", value)
+	}
+}
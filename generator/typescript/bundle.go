@@ -0,0 +1,172 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package typescript
+
+import (
+	"bytes"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/cloudwego/thriftgo/parser"
+	"github.com/cloudwego/thriftgo/plugin"
+)
+
+// 合并输出的方式
+const (
+	BundleFile = "file" // 每个 IDL 生成一个模块，有 namespace 时生成到 namespace 目录下的 index 文件
+	BundleTree = "tree" // 整个 include 树生成一个模块
+)
+
+// bundlePart 是合并到同一个模块中的一个 IDL
+type bundlePart struct {
+	ast   *parser.Thrift
+	scope *Scope
+}
+
+// renderTreeBundle 把整个 include 树中的定义生成到输出根目录下以主 IDL 命名的单个模块中。
+// 各 IDL 的定义分别渲染，以便常量和 typedef 相对于所在的 IDL 解析；不同 IDL 中的同名定义会报错
+func (t *TypeScriptBackend) renderTreeBundle() error {
+	merged := &Scope{
+		Filename:        t.req.AST.Filename,
+		utils:           t.utils,
+		ExpandedStructs: make(map[string]*ExpandedStruct),
+	}
+	owners := make(map[string]string)
+	var parts []*bundlePart
+	processed := make(map[*parser.Thrift]bool)
+	for ast := range t.req.AST.DepthFirstSearch() {
+		if processed[ast] {
+			continue
+		}
+		processed[ast] = true
+		t.log.Info("Processing", ast.Filename)
+
+		scope, err := BuildScope(t.utils, ast)
+		if err != nil {
+			return err
+		}
+		for _, name := range scope.declaredNames() {
+			if owner, ok := owners[name]; ok {
+				return fmt.Errorf("bundle=tree: %q is defined in both %s and %s", name, owner, ast.Filename)
+			}
+			owners[name] = ast.Filename
+		}
+		merged.Constants = append(merged.Constants, scope.Constants...)
+		merged.Typedefs = append(merged.Typedefs, scope.Typedefs...)
+		merged.Enums = append(merged.Enums, scope.Enums...)
+		merged.Structs = append(merged.Structs, scope.Structs...)
+		merged.Unions = append(merged.Unions, scope.Unions...)
+		merged.Exceptions = append(merged.Exceptions, scope.Exceptions...)
+		merged.Services = append(merged.Services, scope.Services...)
+		for name, es := range scope.ExpandedStructs {
+			merged.ExpandedStructs[name] = es
+		}
+		parts = append(parts, &bundlePart{ast: ast, scope: scope})
+	}
+
+	root := t.outputRoot()
+	filename := filepath.Join(root, t.utils.GetFilename(t.req.AST))
+	t.utils.SetRootImportPath(root, root)
+
+	var w bytes.Buffer
+	t.utils.SetCurrentAST(t.req.AST)
+	t.utils.SetRootScope(merged)
+	if err := t.tpl.ExecuteTemplate(&w, "thriftHeader", merged); err != nil {
+		return fmt.Errorf("%s: %w", filename, err)
+	}
+	for _, part := range parts {
+		t.utils.SetCurrentAST(part.ast)
+		t.utils.SetRootScope(part.scope)
+		if err := t.tpl.ExecuteTemplate(&w, "thriftBody", part.scope); err != nil {
+			return fmt.Errorf("%s: %w", filename, err)
+		}
+	}
+	t.res.Contents = append(t.res.Contents, &plugin.Generated{
+		Content: w.String(),
+		Name:    &filename,
+	})
+
+	module := "./" + strings.TrimSuffix(filepath.Base(filename), t.utils.FileExt())
+	for _, part := range parts {
+		t.utils.SetCurrentAST(part.ast)
+		if err := t.renderBundleServiceFiles(part.scope, root, module); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// renderBundleServiceFiles 把服务的客户端、hooks 和路由生成到合并模块所在的目录 dir 中，
+// 它们从合并模块 module 导入服务接口和类型；合并模块不导出它们，以免循环导入
+func (t *TypeScriptBackend) renderBundleServiceFiles(scope *Scope, dir, module string) error {
+	t.utils.bundleModule = module
+	defer func() { t.utils.bundleModule = "" }()
+	return t.renderServiceRuntimeFiles(scope, t.tpl, dir)
+}
+
+// bundleImports 把导入改为从合并模块 module 导入，all 为 false 时只改写本 IDL 中定义的类型
+func (s *Scope) bundleImports(module string, all bool) {
+	var imports []ImportInfo
+	merged := ImportInfo{Module: ".", Path: module}
+	seen := make(map[string]bool)
+	for _, imp := range s.Imports {
+		if !all && !s.isLocalType(imp.Module) {
+			imports = append(imports, imp)
+			continue
+		}
+		for _, typ := range imp.Types {
+			if !seen[typ] {
+				seen[typ] = true
+				merged.Types = append(merged.Types, typ)
+			}
+		}
+	}
+	if len(merged.Types) > 0 {
+		imports = append(imports, merged)
+	}
+	s.Imports = imports
+}
+
+// declaredNames 返回作用域中定义的所有 TypeScript 顶层名称
+func (s *Scope) declaredNames() []string {
+	var names []string
+	for _, c := range s.Constants {
+		names = append(names, GetConstantName(c.Name))
+	}
+	for _, td := range s.Typedefs {
+		names = append(names, GetInterfaceName(td.Alias))
+	}
+	for _, e := range s.Enums {
+		names = append(names, GetInterfaceName(e.Name))
+	}
+	for _, list := range [][]*parser.StructLike{s.Structs, s.Unions, s.Exceptions} {
+		for _, st := range list {
+			names = append(names, GetInterfaceName(st.Name))
+		}
+	}
+	for _, svc := range s.Services {
+		names = append(names, "I"+GetInterfaceName(svc.Name))
+	}
+	return names
+}
+
+// GetServiceModulePath 获取客户端、hooks 和路由文件导入服务接口的路径
+func (u *CodeUtils) GetServiceModulePath(name string) string {
+	if u.bundleModule != "" {
+		return u.bundleModule
+	}
+	return "./" + strings.ToLower(name)
+}
@@ -0,0 +1,323 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package typescript
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/cloudwego/thriftgo/parser"
+	"github.com/cloudwego/thriftgo/semantic"
+)
+
+// RuntimeFileName 是 Thrift 运行时生成的文件名
+const RuntimeFileName = "thrift_runtime.ts"
+
+// CodecField 表示结构体中一个字段的编解码代码
+type CodecField struct {
+	ID       int32
+	Name     string // IDL 中的字段名
+	Property string // TypeScript 属性名
+	Required bool
+	Expanded bool   // 字段被展开到了外层接口中
+	Write    string // write 方法中写入该字段的语句
+	Read     string // read 方法中 case 分支的语句
+}
+
+// codecWriter 生成编解码语句，n 用于生成不冲突的临时变量名
+type codecWriter struct {
+	ast      *parser.Thrift
+	i64As    string
+	features *Features // 常量中结构体的属性名按命名风格生成
+	lines    []string
+	n        int
+}
+
+func (w *codecWriter) line(indent int, format string, args ...interface{}) {
+	w.lines = append(w.lines, strings.Repeat("  ", indent)+fmt.Sprintf(format, args...))
+}
+
+func (w *codecWriter) temp(prefix string) string {
+	w.n++
+	return fmt.Sprintf("%s%d", prefix, w.n)
+}
+
+func (w *codecWriter) flush() string {
+	s := strings.Join(w.lines, "\n")
+	w.lines = w.lines[:0]
+	return s
+}
+
+// SetCurrentAST 设置正在生成的 IDL，编解码代码据此解析 typedef
+func (u *CodeUtils) SetCurrentAST(ast *parser.Thrift) {
+	u.currentAST = ast
+}
+
+// SetRootImportPath 根据生成文件所在目录设置输出根目录的相对导入路径
+func (u *CodeUtils) SetRootImportPath(outputRoot, dir string) {
+	rel, err := filepath.Rel(dir, outputRoot)
+	if err != nil || rel == "." {
+		u.rootImportPath = "."
+		return
+	}
+	u.rootImportPath = filepath.ToSlash(rel)
+}
+
+// GetRuntimeImportPath 获取运行时的导入路径
+func (u *CodeUtils) GetRuntimeImportPath() string {
+	return u.rootImportPath + "/" + strings.TrimSuffix(RuntimeFileName, ".ts")
+}
+
+// GetCodecFields 获取结构体各字段的编解码代码，被展开的字段按原结构体整体读写
+func (u *CodeUtils) GetCodecFields(structLike *parser.StructLike) []*CodecField {
+	expanded := u.getExpandedFieldNames(structLike)
+	w := &codecWriter{ast: u.currentAST, i64As: u.features.I64As}
+	var fields []*CodecField
+	for _, f := range structLike.Fields {
+		cf := &CodecField{
+			ID:       f.ID,
+			Name:     f.Name,
+			Property: GetPropertyNameWithStyle(f.Name, u.features),
+			Required: f.Requiredness == parser.FieldType_Required,
+			Expanded: expanded[f.Name],
+		}
+		ast, typ := w.deref(w.ast, f.Type)
+		value := "value." + cf.Property
+		isUnion := structLike.Category == "union"
+
+		// write
+		indent := 2
+		if cf.Expanded {
+			value = "value as any"
+			// 可选的展开字段只在至少设置了一个展开后的属性时写入，原结构体的 write 会检查其 required 字段
+			if props := u.expandedProperties(ast, typ); !cf.Required && len(props) > 0 {
+				w.line(2, "if ([%s].some((v) => v !== undefined && v !== null)) {", strings.Join(props, ", "))
+				indent = 3
+			}
+		} else if cf.Required {
+			w.line(2, "if (%s === undefined || %s === null) {", value, value)
+			w.line(3, "throw new TProtocolException('required field %s of %s is unset');", f.Name, structLike.Name)
+			w.line(2, "}")
+		} else {
+			w.line(2, "if (%s !== undefined && %s !== null) {", value, value)
+			indent = 3
+		}
+		w.line(indent, "output.writeFieldBegin('%s', %s, %d);", f.Name, ttypeOf(typ), f.ID)
+		w.writeValue(ast, typ, value, indent)
+		w.line(indent, "output.writeFieldEnd();")
+		if isUnion {
+			w.line(indent, "count++;")
+		}
+		if indent == 3 {
+			w.line(2, "}")
+		}
+		cf.Write = w.flush()
+
+		// read
+		w.line(5, "if (field.type === %s) {", ttypeOf(typ))
+		if cf.Expanded {
+			w.line(6, "Object.assign(value, %s.read(input));", getSimpleTypeName(typ.Name))
+		} else {
+			w.readValue(ast, typ, "value."+cf.Property, 6)
+		}
+		if isUnion {
+			w.line(6, "count++;")
+		}
+		w.line(5, "} else {")
+		w.line(6, "input.skip(field.type);")
+		w.line(5, "}")
+		cf.Read = w.flush()
+
+		fields = append(fields, cf)
+	}
+	return fields
+}
+
+// expandedProperties 返回被展开的结构体 t 展开到外层接口中的属性
+func (u *CodeUtils) expandedProperties(ast *parser.Thrift, t *parser.Type) []string {
+	s := findStructLikeByName(t.Name, ast)
+	if s == nil {
+		return nil
+	}
+	var props []string
+	for _, f := range s.Fields {
+		props = append(props, "value."+GetPropertyNameWithStyle(f.Name, u.features))
+	}
+	return props
+}
+
+// deref 解析 typedef，返回实际类型及其所在的 IDL
+func (w *codecWriter) deref(ast *parser.Thrift, t *parser.Type) (*parser.Thrift, *parser.Type) {
+	if ast == nil {
+		return ast, t
+	}
+	a, typ, err := semantic.Deref(ast, t)
+	if err != nil {
+		return ast, t
+	}
+	return a, typ
+}
+
+// ttypeOf 返回类型对应的运行时 TType
+func ttypeOf(t *parser.Type) string {
+	switch t.Category {
+	case parser.Category_Bool:
+		return "TType.BOOL"
+	case parser.Category_Byte:
+		return "TType.BYTE"
+	case parser.Category_I16:
+		return "TType.I16"
+	case parser.Category_I32, parser.Category_Enum:
+		return "TType.I32"
+	case parser.Category_I64:
+		return "TType.I64"
+	case parser.Category_Double:
+		return "TType.DOUBLE"
+	case parser.Category_String, parser.Category_Binary:
+		return "TType.STRING"
+	case parser.Category_List:
+		return "TType.LIST"
+	case parser.Category_Set:
+		return "TType.SET"
+	case parser.Category_Map:
+		return "TType.MAP"
+	default:
+		return "TType.STRUCT"
+	}
+}
+
+// writeValue 生成把 value 写入 output 的语句
+func (w *codecWriter) writeValue(ast *parser.Thrift, t *parser.Type, value string, indent int) {
+	switch t.Category {
+	case parser.Category_Bool:
+		w.line(indent, "output.writeBool(%s);", value)
+	case parser.Category_Byte:
+		w.line(indent, "output.writeByte(%s);", value)
+	case parser.Category_I16:
+		w.line(indent, "output.writeI16(%s);", value)
+	case parser.Category_I32, parser.Category_Enum:
+		w.line(indent, "output.writeI32(%s);", value)
+	case parser.Category_I64:
+		w.line(indent, "output.writeI64(%s);", value)
+	case parser.Category_Double:
+		w.line(indent, "output.writeDouble(%s);", value)
+	case parser.Category_String:
+		w.line(indent, "output.writeString(%s);", value)
+	case parser.Category_Binary:
+		w.line(indent, "output.writeBinary(%s);", value)
+	case parser.Category_List, parser.Category_Set:
+		kind, size := "List", ".length"
+		if t.Category == parser.Category_Set {
+			kind, size = "Set", ".size"
+		}
+		elemAST, elem := w.deref(ast, t.ValueType)
+		name := w.temp("elem")
+		w.line(indent, "output.write%sBegin(%s, %s%s);", kind, ttypeOf(elem), value, size)
+		w.line(indent, "for (const %s of %s) {", name, value)
+		w.writeValue(elemAST, elem, name, indent+1)
+		w.line(indent, "}")
+		w.line(indent, "output.write%sEnd();", kind)
+	case parser.Category_Map:
+		keyAST, key := w.deref(ast, t.KeyType)
+		valAST, val := w.deref(ast, t.ValueType)
+		obj, keys, k := w.temp("map"), w.temp("keys"), w.temp("key")
+		w.line(indent, "const %s: any = %s;", obj, value)
+		w.line(indent, "const %s = Object.keys(%s);", keys, obj)
+		w.line(indent, "output.writeMapBegin(%s, %s, %s.length);", ttypeOf(key), ttypeOf(val), keys)
+		w.line(indent, "for (const %s of %s) {", k, keys)
+		w.writeValue(keyAST, key, mapKeyFromString(key, k), indent+1)
+		w.writeValue(valAST, val, obj+"["+k+"]", indent+1)
+		w.line(indent, "}")
+		w.line(indent, "output.writeMapEnd();")
+	default:
+		w.line(indent, "%s.write(output, %s);", getSimpleTypeName(t.Name), value)
+	}
+}
+
+// readValue 生成从 input 读取一个值并赋给 target 的语句
+func (w *codecWriter) readValue(ast *parser.Thrift, t *parser.Type, target string, indent int) {
+	switch t.Category {
+	case parser.Category_Bool:
+		w.line(indent, "%s = input.readBool();", target)
+	case parser.Category_Byte:
+		w.line(indent, "%s = input.readByte();", target)
+	case parser.Category_I16:
+		w.line(indent, "%s = input.readI16();", target)
+	case parser.Category_I32, parser.Category_Enum:
+		w.line(indent, "%s = input.readI32();", target)
+	case parser.Category_I64:
+		switch w.i64As {
+		case I64AsBigInt:
+			w.line(indent, "%s = input.readI64();", target)
+		case I64AsString:
+			w.line(indent, "%s = input.readI64().toString();", target)
+		default:
+			w.line(indent, "%s = Number(input.readI64());", target)
+		}
+	case parser.Category_Double:
+		w.line(indent, "%s = input.readDouble();", target)
+	case parser.Category_String:
+		w.line(indent, "%s = input.readString();", target)
+	case parser.Category_Binary:
+		w.line(indent, "%s = input.readBinary();", target)
+	case parser.Category_List, parser.Category_Set:
+		kind, init, add := "List", "[]", "push"
+		if t.Category == parser.Category_Set {
+			kind, init, add = "Set", "new Set<any>()", "add"
+		}
+		elemAST, elem := w.deref(ast, t.ValueType)
+		header, result, i, e := w.temp("tlist"), w.temp("list"), w.temp("i"), w.temp("elem")
+		w.line(indent, "const %s = input.read%sBegin();", header, kind)
+		w.line(indent, "const %s: any = %s;", result, init)
+		w.line(indent, "for (let %s = 0; %s < %s.size; %s++) {", i, i, header, i)
+		w.line(indent+1, "let %s: any;", e)
+		w.readValue(elemAST, elem, e, indent+1)
+		w.line(indent+1, "%s.%s(%s);", result, add, e)
+		w.line(indent, "}")
+		w.line(indent, "input.read%sEnd();", kind)
+		w.line(indent, "%s = %s;", target, result)
+	case parser.Category_Map:
+		keyAST, key := w.deref(ast, t.KeyType)
+		valAST, val := w.deref(ast, t.ValueType)
+		header, result, i, k, v := w.temp("tmap"), w.temp("map"), w.temp("i"), w.temp("key"), w.temp("val")
+		w.line(indent, "const %s = input.readMapBegin();", header)
+		w.line(indent, "const %s: any = {};", result)
+		w.line(indent, "for (let %s = 0; %s < %s.size; %s++) {", i, i, header, i)
+		w.line(indent+1, "let %s: any;", k)
+		w.readValue(keyAST, key, k, indent+1)
+		w.line(indent+1, "let %s: any;", v)
+		w.readValue(valAST, val, v, indent+1)
+		w.line(indent+1, "%s[%s] = %s;", result, k, v)
+		w.line(indent, "}")
+		w.line(indent, "input.readMapEnd();")
+		w.line(indent, "%s = %s;", target, result)
+	default:
+		w.line(indent, "%s = %s.read(input);", target, getSimpleTypeName(t.Name))
+	}
+}
+
+// mapKeyFromString 把对象的字符串键转换回 map 键的类型，i64 直接按字符串写入以免丢失精度
+func mapKeyFromString(t *parser.Type, key string) string {
+	switch t.Category {
+	case parser.Category_Bool:
+		return key + " === 'true'"
+	case parser.Category_Byte, parser.Category_I16, parser.Category_I32,
+		parser.Category_Double, parser.Category_Enum:
+		return "Number(" + key + ")"
+	default:
+		return key
+	}
+}
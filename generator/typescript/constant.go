@@ -0,0 +1,294 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package typescript
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cloudwego/thriftgo/parser"
+)
+
+// maxConstDepth 限制常量之间相互引用的深度，避免循环引用时无限递归
+const maxConstDepth = 32
+
+// GetConstantValue 获取常量的值，引用的常量和枚举值会被展开为字面量，i64 常量按 i64_as 生成
+func (u *CodeUtils) GetConstantValue(constant *parser.Constant) (string, error) {
+	if constant == nil || constant.Value == nil {
+		return "null", nil
+	}
+	w := &codecWriter{ast: u.currentAST, i64As: u.features.I64As, features: u.features}
+	value, err := w.constValue(w.ast, w.ast, constant.Type, constant.Value, false, 0)
+	if err != nil {
+		return "", fmt.Errorf("constant %s: %w", constant.Name, err)
+	}
+	return value, nil
+}
+
+// ClassDefault 类的构造函数中为属性设置的默认值
+type ClassDefault struct {
+	Property string
+	Value    string
+}
+
+// GetClassDefaults 获取类的构造函数中需要设置的字段默认值，结构体类型的默认值通过类的构造函数创建。
+// 被展开的字段使用原结构体中字段的默认值；联合体只能设置一个字段，不设置默认值
+func (u *CodeUtils) GetClassDefaults(structLike *parser.StructLike) ([]*ClassDefault, error) {
+	if structLike.Category == "union" {
+		return nil, nil
+	}
+	w := &codecWriter{ast: u.currentAST, i64As: u.features.I64As, features: u.features}
+	expanded := u.getExpandedFieldNames(structLike)
+	var defaults []*ClassDefault
+	add := func(ast *parser.Thrift, field *parser.Field) error {
+		if field.Default == nil {
+			return nil
+		}
+		value, err := w.constValue(ast, ast, field.Type, field.Default, true, 0)
+		if err != nil {
+			return fmt.Errorf("default value of %s.%s: %w", structLike.Name, field.Name, err)
+		}
+		defaults = append(defaults, &ClassDefault{
+			Property: GetPropertyNameWithStyle(field.Name, u.features),
+			Value:    value,
+		})
+		return nil
+	}
+	for _, f := range structLike.Fields {
+		if !expanded[f.Name] {
+			if err := add(w.ast, f); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		ast, typ := w.deref(w.ast, f.Type)
+		inner := findStructLikeByName(typ.Name, ast)
+		if inner == nil {
+			continue
+		}
+		for _, innerField := range inner.Fields {
+			if err := add(ast, innerField); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return defaults, nil
+}
+
+// constValue 返回常量值 v 作为 t 类型时的表达式。valueAST 是常量值所在的 IDL，用于解析引用；
+// typeAST 是类型 t 所在的 IDL，用于解析 typedef 和结构体
+func (w *codecWriter) constValue(valueAST, typeAST *parser.Thrift, t *parser.Type, v *parser.ConstValue, newStruct bool, depth int) (string, error) {
+	if depth > maxConstDepth {
+		return "", fmt.Errorf("constant reference is too deep or circular")
+	}
+	typeAST, t = w.deref(typeAST, t)
+
+	if v.Type == parser.ConstType_ConstIdentifier {
+		id := v.TypedValue.GetIdentifier()
+		if id != "true" && id != "false" {
+			return w.constReference(valueAST, typeAST, t, v, newStruct, depth)
+		}
+	}
+
+	switch t.Category {
+	case parser.Category_Bool:
+		switch v.Type {
+		case parser.ConstType_ConstInt:
+			return strconv.FormatBool(v.TypedValue.GetInt() > 0), nil
+		case parser.ConstType_ConstDouble:
+			return strconv.FormatBool(v.TypedValue.GetDouble() > 0), nil
+		case parser.ConstType_ConstIdentifier:
+			return v.TypedValue.GetIdentifier(), nil
+		}
+	case parser.Category_Byte, parser.Category_I16, parser.Category_I32, parser.Category_Enum:
+		if v.Type == parser.ConstType_ConstInt {
+			return strconv.FormatInt(v.TypedValue.GetInt(), 10), nil
+		}
+	case parser.Category_I64:
+		if v.Type == parser.ConstType_ConstInt {
+			value := strconv.FormatInt(v.TypedValue.GetInt(), 10)
+			switch w.i64As {
+			case I64AsBigInt:
+				return fmt.Sprintf("BigInt('%s')", value), nil
+			case I64AsString:
+				return fmt.Sprintf("'%s'", value), nil
+			}
+			return value, nil
+		}
+	case parser.Category_Double:
+		switch v.Type {
+		case parser.ConstType_ConstInt:
+			return strconv.FormatInt(v.TypedValue.GetInt(), 10), nil
+		case parser.ConstType_ConstDouble:
+			return strconv.FormatFloat(v.TypedValue.GetDouble(), 'g', -1, 64), nil
+		}
+	case parser.Category_String, parser.Category_Binary:
+		if v.Type == parser.ConstType_ConstLiteral {
+			// JSON 字符串同时也是合法的 JavaScript 字符串
+			b, _ := json.Marshal(v.TypedValue.GetLiteral())
+			if t.Category == parser.Category_Binary {
+				return fmt.Sprintf("new TextEncoder().encode(%s)", b), nil
+			}
+			return string(b), nil
+		}
+	case parser.Category_List, parser.Category_Set:
+		var elems []string
+		switch v.Type {
+		case parser.ConstType_ConstList:
+			for _, e := range v.TypedValue.List {
+				elem, err := w.constValue(valueAST, typeAST, t.ValueType, e, newStruct, depth)
+				if err != nil {
+					return "", err
+				}
+				elems = append(elems, elem)
+			}
+		case parser.ConstType_ConstMap:
+			// 空的 {} 也可以作为空列表
+			if len(v.TypedValue.Map) != 0 {
+				return "", constTypeError(t, v)
+			}
+		default:
+			return "", constTypeError(t, v)
+		}
+		list := "[" + strings.Join(elems, ", ") + "]"
+		if t.Category == parser.Category_Set {
+			return "new Set(" + list + ")", nil
+		}
+		return list, nil
+	case parser.Category_Map:
+		if v.Type == parser.ConstType_ConstList && len(v.TypedValue.List) == 0 {
+			return "{}", nil
+		}
+		if v.Type != parser.ConstType_ConstMap {
+			break
+		}
+		var entries []string
+		for _, m := range v.TypedValue.Map {
+			key, err := w.constValue(valueAST, typeAST, t.KeyType, m.Key, newStruct, depth)
+			if err != nil {
+				return "", err
+			}
+			val, err := w.constValue(valueAST, typeAST, t.ValueType, m.Value, newStruct, depth)
+			if err != nil {
+				return "", err
+			}
+			entries = append(entries, fmt.Sprintf("%s: %s", mapKeyLiteral(key), val))
+		}
+		if len(entries) == 0 {
+			return "{}", nil
+		}
+		return "{ " + strings.Join(entries, ", ") + " }", nil
+	case parser.Category_Struct, parser.Category_Union, parser.Category_Exception:
+		return w.constStruct(valueAST, typeAST, t, v, newStruct, depth)
+	}
+	return "", constTypeError(t, v)
+}
+
+// constReference 展开对常量或枚举值的引用
+func (w *codecWriter) constReference(valueAST, typeAST *parser.Thrift, t *parser.Type, v *parser.ConstValue, newStruct bool, depth int) (string, error) {
+	id := v.TypedValue.GetIdentifier()
+	extra := v.Extra
+	if extra == nil {
+		return "", fmt.Errorf("undefined value: %q", id)
+	}
+	target := valueAST
+	if extra.Index >= 0 {
+		if int(extra.Index) >= len(valueAST.Includes) {
+			return "", fmt.Errorf("undefined value: %q", id)
+		}
+		target = valueAST.Includes[extra.Index].Reference
+	}
+
+	if extra.IsEnum {
+		enum, ok := target.GetEnum(extra.Sel)
+		if !ok {
+			return "", fmt.Errorf("undefined enum: %q", id)
+		}
+		for _, ev := range enum.Values {
+			if ev.Name == extra.Name {
+				// 枚举值按数值展开，避免额外导入枚举
+				return w.constValue(valueAST, typeAST, t, &parser.ConstValue{
+					Type:       parser.ConstType_ConstInt,
+					TypedValue: &parser.ConstTypedValue{Int: &ev.Value},
+				}, newStruct, depth+1)
+			}
+		}
+		return "", fmt.Errorf("undefined enum value: %q", id)
+	}
+
+	c, ok := target.GetConstant(extra.Name)
+	if !ok {
+		return "", fmt.Errorf("undefined constant: %q", id)
+	}
+	// 被引用的常量按当前需要的类型展开，其中的引用相对于常量所在的 IDL 解析
+	return w.constValue(target, typeAST, t, c.Value, newStruct, depth+1)
+}
+
+// constStruct 返回结构体常量的对象字面量，键为 IDL 中的字段名
+func (w *codecWriter) constStruct(valueAST, typeAST *parser.Thrift, t *parser.Type, v *parser.ConstValue, newStruct bool, depth int) (string, error) {
+	if v.Type != parser.ConstType_ConstMap {
+		return "", constTypeError(t, v)
+	}
+	structLike := findStructLikeByName(t.Name, typeAST)
+	if structLike == nil {
+		return "", fmt.Errorf("undefined type: %q", t.Name)
+	}
+	var props []string
+	for _, m := range v.TypedValue.Map {
+		if m.Key.Type != parser.ConstType_ConstLiteral {
+			return "", fmt.Errorf("invalid field name of %s: %s", structLike.Name, m.Key)
+		}
+		name := m.Key.TypedValue.GetLiteral()
+		var field *parser.Field
+		for _, f := range structLike.Fields {
+			if f.Name == name {
+				field = f
+				break
+			}
+		}
+		if field == nil {
+			return "", fmt.Errorf("%s has no field named %q", structLike.Name, name)
+		}
+		// 只有最外层的结构体通过构造函数创建，嵌套的结构体类型不一定被当前文件导入
+		val, err := w.constValue(valueAST, typeAST, field.Type, m.Value, false, depth)
+		if err != nil {
+			return "", err
+		}
+		props = append(props, fmt.Sprintf("%s: %s", GetPropertyNameWithStyle(name, w.features), val))
+	}
+	literal := "{}"
+	if len(props) > 0 {
+		literal = "{ " + strings.Join(props, ", ") + " }"
+	}
+	if newStruct {
+		return fmt.Sprintf("new %s(%s)", GetClassName(structLike.Name), literal), nil
+	}
+	return literal, nil
+}
+
+// mapKeyLiteral 返回 map 键在对象字面量中的写法，非负整数和字符串字面量可以直接作为属性名，
+// 其余的键（负数、小数、BigInt 等）使用计算属性名
+func mapKeyLiteral(key string) string {
+	if _, err := strconv.ParseUint(key, 10, 64); err == nil || strings.HasPrefix(key, `"`) {
+		return key
+	}
+	return "[" + key + "]"
+}
+
+func constTypeError(t *parser.Type, v *parser.ConstValue) error {
+	return fmt.Errorf("type error: cannot use %s as %s", v, t.Name)
+}
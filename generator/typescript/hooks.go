@@ -0,0 +1,111 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package typescript
+
+import (
+	"strings"
+
+	"github.com/cloudwego/thriftgo/parser"
+)
+
+// 生成的数据请求 hooks 所基于的库
+const (
+	HooksReactQuery = "react-query"
+	HooksSWR        = "swr"
+)
+
+// Hook 表示为服务方法生成的一个 hook
+type Hook struct {
+	Name       string // hook 名，如 useGetUser
+	Keys       string // 服务的 query key 工厂名
+	Method     string // 客户端的方法名
+	Query      bool   // GET 方法生成查询，其余生成变更
+	Params     string // 查询 hook 的参数声明，与客户端方法一致
+	Args       string // 调用客户端方法的实参
+	KeyArgs    string // query key 中的参数，需要 JSON 转换时为转换后的值，避免 bigint 无法序列化
+	Variables  string // 变更 hook 的参数类型
+	CallArgs   string // 变更 hook 中调用客户端方法的实参
+	ResultType string
+}
+
+// GetHooksImportPath 获取 hooks 所基于的库的导入路径
+func (u *CodeUtils) GetHooksImportPath() string {
+	if u.features.HooksImport != "" {
+		return u.features.HooksImport
+	}
+	if u.features.Hooks == HooksSWR {
+		return "swr"
+	}
+	return "@tanstack/react-query"
+}
+
+// GetHookKeysName 获取服务的 query key 工厂名，如 userServiceKeys
+func GetHookKeysName(svc *parser.Service) string {
+	name := GetInterfaceName(svc.Name)
+	return strings.ToLower(name[:1]) + name[1:] + "Keys"
+}
+
+// GetHooks 获取服务中每个方法对应的 hook，带有 api.get 注解的方法生成查询，其余方法生成变更
+func (u *CodeUtils) GetHooks(svc *parser.Service) []*Hook {
+	types := &typeMapper{i64Type: u.features.I64As}
+	var hooks []*Hook
+	for _, f := range svc.Functions {
+		method := GetPropertyNameWithStyle(f.Name, u.features)
+		h := &Hook{
+			Name:       "use" + strings.ToUpper(method[:1]) + method[1:],
+			Keys:       GetHookKeysName(svc),
+			Method:     method,
+			Query:      len(f.Annotations.Get("api.get")) > 0,
+			ResultType: "void",
+		}
+		if !f.Void && f.FunctionType != nil {
+			h.ResultType = types.typeOf(f.FunctionType)
+		}
+
+		var params, args, keyArgs, vars []string
+		for i, arg := range f.Arguments {
+			name := GetPropertyNameWithStyle(arg.Name, u.features)
+			param := name
+			if IsOptionalArgument(f, i) {
+				param += "?"
+			}
+			param += ": " + types.fieldType(arg)
+			if IsStructField(arg) && IsStructEmptyOrAllFieldsOptional(arg) {
+				param += " = {}"
+			}
+			params = append(params, param)
+			args = append(args, name)
+			keyArgs = append(keyArgs, u.argToJSON(arg))
+			vars = append(vars, types.fieldType(arg))
+		}
+		h.Params = strings.Join(params, ", ")
+		h.Args = strings.Join(args, ", ")
+		h.KeyArgs = strings.Join(keyArgs, ", ")
+
+		// 变更的参数只有一个时直接使用该参数，多个时使用元组
+		switch len(vars) {
+		case 0:
+			h.Variables = "void"
+		case 1:
+			h.Variables = vars[0]
+			h.CallArgs = "variables"
+		default:
+			h.Variables = "[" + strings.Join(vars, ", ") + "]"
+			h.CallArgs = "...variables"
+		}
+		hooks = append(hooks, h)
+	}
+	return hooks
+}
@@ -0,0 +1,192 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package typescript
+
+import (
+	"fmt"
+
+	"github.com/cloudwego/thriftgo/parser"
+)
+
+// JSONField 表示结构体中一个字段的 JSON 转换代码
+type JSONField struct {
+	Name     string // IDL 中的字段名
+	Property string // TypeScript 属性名，也是 JSON 中的键
+	Expanded bool   // 字段被展开到了外层接口中
+	FromJSON string // fromJSON 方法中读取该字段的语句
+	ToJSON   string // toJSON 方法中写出该字段的语句
+}
+
+// GetJSONFields 获取结构体各字段的 JSON 转换代码，i64 按 i64_as 无损转换，被展开的字段按原结构体整体转换
+func (u *CodeUtils) GetJSONFields(structLike *parser.StructLike) []*JSONField {
+	expanded := u.getExpandedFieldNames(structLike)
+	w := &codecWriter{ast: u.currentAST, i64As: u.features.I64As}
+	var fields []*JSONField
+	for _, f := range structLike.Fields {
+		jf := &JSONField{
+			Name:     f.Name,
+			Property: GetPropertyNameWithStyle(f.Name, u.features),
+			Expanded: expanded[f.Name],
+		}
+		ast, typ := w.deref(w.ast, f.Type)
+		if jf.Expanded {
+			name := getSimpleTypeName(typ.Name)
+			jf.FromJSON = fmt.Sprintf("    Object.assign(value, %s.fromJSON(json));", name)
+			jf.ToJSON = fmt.Sprintf("    Object.assign(json, %s.toJSON(value as any));", name)
+		} else {
+			src, dst := "json."+jf.Property, "value."+jf.Property
+			w.line(2, "if (%s !== undefined && %s !== null) {", src, src)
+			w.line(3, "%s = %s;", dst, w.fromJSON(ast, typ, src, 0))
+			w.line(2, "}")
+			jf.FromJSON = w.flush()
+
+			src, dst = "value."+jf.Property, "json."+jf.Property
+			w.line(2, "if (%s !== undefined && %s !== null) {", src, src)
+			w.line(3, "%s = %s;", dst, w.toJSON(ast, typ, src, 0))
+			w.line(2, "}")
+			jf.ToJSON = w.flush()
+		}
+		fields = append(fields, jf)
+	}
+	return fields
+}
+
+// fromJSON 返回把 JSON 值 value 转换为 t 类型的表达式，depth 用于生成回调参数名
+func (w *codecWriter) fromJSON(ast *parser.Thrift, t *parser.Type, value string, depth int) string {
+	switch t.Category {
+	case parser.Category_I64:
+		return fmt.Sprintf("i64FromJSON(%s)", value)
+	case parser.Category_Double:
+		return fmt.Sprintf("doubleFromJSON(%s)", value)
+	case parser.Category_List, parser.Category_Set:
+		fn := "listFromJSON"
+		if t.Category == parser.Category_Set {
+			fn = "setFromJSON"
+		}
+		elemAST, elem := w.deref(ast, t.ValueType)
+		if convert := w.jsonCallback(elemAST, elem, depth, w.fromJSON); convert != "" {
+			return fmt.Sprintf("%s(%s, %s)", fn, value, convert)
+		}
+		return fmt.Sprintf("%s(%s)", fn, value)
+	case parser.Category_Map:
+		_, key := w.deref(ast, t.KeyType)
+		valAST, val := w.deref(ast, t.ValueType)
+		convertKey := "undefined"
+		if key.Category == parser.Category_I64 {
+			convertKey = "i64KeyFromJSON"
+		}
+		convertValue := w.jsonCallback(valAST, val, depth, w.fromJSON)
+		switch {
+		case convertValue != "":
+			return fmt.Sprintf("mapFromJSON(%s, %s, %s)", value, convertKey, convertValue)
+		case convertKey != "undefined":
+			return fmt.Sprintf("mapFromJSON(%s, %s)", value, convertKey)
+		default:
+			return fmt.Sprintf("mapFromJSON(%s)", value)
+		}
+	case parser.Category_Struct, parser.Category_Union, parser.Category_Exception:
+		return fmt.Sprintf("%s.fromJSON(%s)", getSimpleTypeName(t.Name), value)
+	default:
+		return value
+	}
+}
+
+// toJSON 返回把 t 类型的值 value 转换为 JSON 值的表达式，set 转换为数组，i64 转换为字符串
+func (w *codecWriter) toJSON(ast *parser.Thrift, t *parser.Type, value string, depth int) string {
+	switch t.Category {
+	case parser.Category_I64:
+		return fmt.Sprintf("i64ToJSON(%s)", value)
+	case parser.Category_List, parser.Category_Set:
+		elemAST, elem := w.deref(ast, t.ValueType)
+		convert := w.jsonCallback(elemAST, elem, depth, w.toJSON)
+		switch {
+		case convert != "":
+			return fmt.Sprintf("listToJSON(%s, %s)", value, convert)
+		case t.Category == parser.Category_Set:
+			return fmt.Sprintf("listToJSON(%s)", value)
+		default:
+			return value
+		}
+	case parser.Category_Map:
+		valAST, val := w.deref(ast, t.ValueType)
+		if convert := w.jsonCallback(valAST, val, depth, w.toJSON); convert != "" {
+			return fmt.Sprintf("mapToJSON(%s, %s)", value, convert)
+		}
+		return value
+	case parser.Category_Struct, parser.Category_Union, parser.Category_Exception:
+		return fmt.Sprintf("%s.toJSON(%s)", getSimpleTypeName(t.Name), value)
+	default:
+		return value
+	}
+}
+
+// jsonCallback 返回转换容器元素的回调，元素无需转换时返回空字符串
+func (w *codecWriter) jsonCallback(ast *parser.Thrift, t *parser.Type, depth int,
+	convert func(*parser.Thrift, *parser.Type, string, int) string,
+) string {
+	v := fmt.Sprintf("v%d", depth)
+	expr := convert(ast, t, v, depth+1)
+	if expr == v {
+		return ""
+	}
+	return fmt.Sprintf("(%s: any) => %s", v, expr)
+}
+
+// GetClientArgName 获取客户端中读取参数的变量名，需要 JSON 转换时读取转换后的变量
+func (u *CodeUtils) GetClientArgName(arg *parser.Field) string {
+	name := GetPropertyNameWithStyle(arg.Name, u.features)
+	if u.JSONHelpers() {
+		return name + "JSON"
+	}
+	return name
+}
+
+// GetClientArgJSON 获取客户端中把参数转换为 JSON 值的语句，i64 转换为字符串，避免 JSON.stringify 和 URL 拼接时出错
+func (u *CodeUtils) GetClientArgJSON(arg *parser.Field) string {
+	if !u.JSONHelpers() {
+		return ""
+	}
+	name := GetPropertyNameWithStyle(arg.Name, u.features)
+	return fmt.Sprintf("const %sJSON: any = %s;", name, u.argToJSON(arg))
+}
+
+// argToJSON 返回把参数转换为 JSON 值的表达式，不需要转换时返回参数名
+func (u *CodeUtils) argToJSON(arg *parser.Field) string {
+	name := GetPropertyNameWithStyle(arg.Name, u.features)
+	if !u.JSONHelpers() {
+		return name
+	}
+	w := &codecWriter{ast: u.currentAST, i64As: u.features.I64As}
+	ast, typ := w.deref(w.ast, arg.Type)
+	expr := w.toJSON(ast, typ, name, 0)
+	if expr == name {
+		return name
+	}
+	return fmt.Sprintf("%s === undefined || %s === null ? %s : %s", name, name, name, expr)
+}
+
+// GetClientResult 获取客户端中把响应体 data 转换为返回值的表达式，i64 按 i64_as 无损转换
+func (u *CodeUtils) GetClientResult(f *parser.Function) string {
+	if !u.JSONHelpers() || f.Void || f.FunctionType == nil {
+		return "data"
+	}
+	w := &codecWriter{ast: u.currentAST, i64As: u.features.I64As}
+	ast, typ := w.deref(w.ast, f.FunctionType)
+	expr := w.fromJSON(ast, typ, "data", 0)
+	if expr == "data" {
+		return expr
+	}
+	return fmt.Sprintf("data === undefined || data === null ? data : %s", expr)
+}
@@ -0,0 +1,164 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package typescript
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cloudwego/thriftgo/parser"
+)
+
+// MockRuntimeFileName 是 mock 工厂函数依赖的运行时生成的文件名
+const MockRuntimeFileName = "mock_runtime.ts"
+
+// GetMockName 获取类型的 mock 工厂函数名
+func GetMockName(name string) string {
+	return "mock" + GetInterfaceName(getSimpleTypeName(name))
+}
+
+// GetMockRuntimeImportPath 获取 mock 运行时的导入路径
+func (u *CodeUtils) GetMockRuntimeImportPath() string {
+	return u.rootImportPath + "/" + strings.TrimSuffix(MockRuntimeFileName, ".ts")
+}
+
+// GetMockBody 生成结构体、联合体或异常的 mock 工厂函数体。所有字段都会被填充，被展开的字段由原结构体的工厂函数填充；
+// 联合体只填充一个字段，达到深度上限时优先选择不会继续嵌套的字段
+func (u *CodeUtils) GetMockBody(structLike *parser.StructLike) string {
+	expanded := u.getExpandedFieldNames(structLike)
+	w := &codecWriter{ast: u.currentAST, i64As: u.features.I64As}
+	name := GetInterfaceName(structLike.Name)
+	result := "Object.assign(value, overrides) as " + name
+	if u.features.GenerateClasses {
+		result = fmt.Sprintf("new %s(Object.assign(value, overrides))", name)
+	}
+
+	if structLike.Category == "union" {
+		// 覆盖的字段会替换随机选择的字段，保证只有一个字段被设置
+		w.line(1, "if (overrides && Object.keys(overrides).length > 0) {")
+		if u.features.GenerateClasses {
+			w.line(2, "return new %s(overrides);", name)
+		} else {
+			w.line(2, "return { ...overrides } as %s;", name)
+		}
+		w.line(1, "}")
+		w.line(1, "const value: any = {};")
+		if len(structLike.Fields) == 0 {
+			w.line(1, "return value as %s;", name)
+			return w.flush()
+		}
+		safe := 0
+		for i := len(structLike.Fields) - 1; i >= 0; i-- {
+			if _, typ := w.deref(w.ast, structLike.Fields[i].Type); !typ.Category.IsStructLike() {
+				safe = i
+			}
+		}
+		w.line(1, "ctx.nested(() => {")
+		w.line(2, "switch (ctx.atLimit() ? %d : ctx.int(0, %d)) {", safe, len(structLike.Fields)-1)
+		for i, f := range structLike.Fields {
+			ast, typ := w.deref(w.ast, f.Type)
+			w.line(3, "case %d:", i)
+			w.line(4, "value.%s = %s;", GetPropertyNameWithStyle(f.Name, u.features), w.mockValue(ast, f.Type, typ, f.Name))
+			w.line(4, "break;")
+		}
+		w.line(2, "}")
+		w.line(1, "});")
+		w.line(1, "return %s;", result)
+		return w.flush()
+	}
+
+	w.line(1, "const value: any = {};")
+	w.line(1, "ctx.nested(() => {")
+	for _, f := range structLike.Fields {
+		if expanded[f.Name] {
+			w.line(2, "Object.assign(value, %s(undefined, ctx));", GetMockName(f.Type.Name))
+			continue
+		}
+		ast, typ := w.deref(w.ast, f.Type)
+		property := GetPropertyNameWithStyle(f.Name, u.features)
+		value := w.mockValue(ast, f.Type, typ, f.Name)
+		if typ.Category.IsStructLike() && f.Requiredness != parser.FieldType_Required {
+			// 可选的结构体字段在达到深度上限后不再生成，避免递归类型无限展开
+			w.line(2, "if (!ctx.atLimit()) {")
+			w.line(3, "value.%s = %s;", property, value)
+			w.line(2, "}")
+			continue
+		}
+		w.line(2, "value.%s = %s;", property, value)
+	}
+	w.line(1, "});")
+	w.line(1, "return %s;", result)
+	return w.flush()
+}
+
+// GetEnumMockValues 获取枚举 mock 工厂函数可以选择的取值
+func GetEnumMockValues(enum *parser.Enum) string {
+	name := GetEnumName(enum.Name)
+	var values []string
+	for _, v := range enum.Values {
+		values = append(values, name+"."+GetEnumValueName(v.Name))
+	}
+	return "[" + strings.Join(values, ", ") + "]"
+}
+
+// GetTypedefMockValue 获取类型别名 mock 工厂函数的返回值表达式
+func (u *CodeUtils) GetTypedefMockValue(typedef *parser.Typedef) string {
+	w := &codecWriter{ast: u.currentAST, i64As: u.features.I64As}
+	ast, typ := w.deref(w.ast, typedef.Type)
+	return w.mockValue(ast, typedef.Type, typ, typedef.Alias)
+}
+
+// mockValue 返回生成 t 类型随机值的表达式，hint 用作字符串的前缀。named 是声明时使用的类型，
+// 枚举和结构体按其名称调用对应的工厂函数，与接口中使用的类型名一致
+func (w *codecWriter) mockValue(ast *parser.Thrift, named, t *parser.Type, hint string) string {
+	switch t.Category {
+	case parser.Category_Bool:
+		return "ctx.bool()"
+	case parser.Category_Byte:
+		return "ctx.int(0, 127)"
+	case parser.Category_I16, parser.Category_I32:
+		return "ctx.int(0, 10000)"
+	case parser.Category_I64:
+		switch w.i64As {
+		case I64AsBigInt:
+			return "BigInt(ctx.int(0, 1000000))"
+		case I64AsString:
+			return "String(ctx.int(0, 1000000))"
+		default:
+			return "ctx.int(0, 1000000)"
+		}
+	case parser.Category_Double:
+		return "ctx.double()"
+	case parser.Category_String:
+		return fmt.Sprintf("ctx.string('%s')", hint)
+	case parser.Category_Binary:
+		return "ctx.bytes()"
+	case parser.Category_List:
+		elemAST, elem := w.deref(ast, t.ValueType)
+		return fmt.Sprintf("ctx.list(() => %s)", w.mockValue(elemAST, t.ValueType, elem, hint))
+	case parser.Category_Set:
+		elemAST, elem := w.deref(ast, t.ValueType)
+		return fmt.Sprintf("new Set(ctx.list(() => %s))", w.mockValue(elemAST, t.ValueType, elem, hint))
+	case parser.Category_Map:
+		keyAST, key := w.deref(ast, t.KeyType)
+		valAST, val := w.deref(ast, t.ValueType)
+		return fmt.Sprintf("ctx.record(() => %s, () => %s)",
+			w.mockValue(keyAST, t.KeyType, key, hint), w.mockValue(valAST, t.ValueType, val, hint))
+	case parser.Category_Enum, parser.Category_Struct, parser.Category_Union, parser.Category_Exception:
+		return GetMockName(named.Name) + "(undefined, ctx)"
+	default:
+		return "undefined"
+	}
+}
@@ -31,7 +31,7 @@ var allParams = []param{
 	},
 	{
 		name: "generate_classes",
-		desc: "生成类定义",
+		desc: "为结构体和异常生成与接口同名的类，构造函数设置 IDL 中的默认值，编解码和 JSON 转换方法作为静态方法",
 	},
 	{
 		name: "use_strict_mode",
@@ -53,4 +53,64 @@ var allParams = []param{
 		name: "lower_camel_case_property_name",
 		desc: "使用 lowerCamelCase 命名属性（默认）",
 	},
+	{
+		name: "thrift_codec",
+		desc: "为结构体、联合体和异常生成 Thrift binary/compact 编解码方法，并生成 thrift_runtime.ts 运行时",
+	},
+	{
+		name: "i64_as",
+		desc: "i64 的映射方式：number（默认）、bigint 或 string，非 number 时生成无损的 JSON 转换方法",
+	},
+	{
+		name: "validators",
+		desc: "为枚举、结构体、联合体、异常和类型别名生成 validate<Type> 运行时校验函数，检查 required 字段、字段类型和简单的 api.vd 规则",
+	},
+	{
+		name: "zod_schemas",
+		desc: "为枚举、结构体、联合体、异常和类型别名生成 Zod schema <Type>Schema 及推导类型 <Type>Inferred",
+	},
+	{
+		name: "zod_import",
+		desc: "zod 的导入路径，默认为 zod",
+	},
+	{
+		name: "mocks",
+		desc: "为枚举、结构体、联合体、异常和类型别名生成 mock<Type> 测试数据工厂函数，按 seed 确定性地填充所有字段，并生成 mock_runtime.ts 运行时",
+	},
+	{
+		name: "server_router",
+		desc: "为服务生成 express 或 fastify 路由 <service>router.ts，根据 API 注解绑定参数并调用 I<Service> 的实现，throws 中的异常按 api.http_code 映射状态码",
+	},
+	{
+		name: "hooks",
+		desc: "基于客户端为服务生成 react-query 或 swr 的 hooks <service>hooks.ts，GET 方法生成查询，其余方法生成变更",
+	},
+	{
+		name: "hooks_import",
+		desc: "hooks 所基于的库的导入路径，默认为 @tanstack/react-query 或 swr",
+	},
+	{
+		name: "http_transport",
+		desc: "客户端默认使用的 HTTP 传输层：fetch（默认）或 axios",
+	},
+	{
+		name: "transport_import",
+		desc: "HttpTransport 等传输层定义的导入路径，设置后不再生成 http_transport.ts",
+	},
+	{
+		name: "axios_import",
+		desc: "http_transport=axios 时 axios 实例的导入路径，默认为 axios",
+	},
+	{
+		name: "biz_exception_import",
+		desc: "BizException 的导入路径，默认从传输层导入",
+	},
+	{
+		name: "declarations",
+		desc: "只生成 .d.ts 声明文件，包含枚举、接口、类型别名、常量和服务接口，不生成客户端等运行时代码",
+	},
+	{
+		name: "bundle",
+		desc: "合并输出：file 为每个 IDL 生成一个模块（有 namespace 时为 namespace 目录下的 index），tree 把整个 include 树生成到以主 IDL 命名的单个模块；服务的客户端、hooks 和路由仍生成到合并模块所在目录下的 <service>client.ts 等文件中，从合并模块导入类型，合并模块不导出它们",
+	},
 }
@@ -0,0 +1,254 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package typescript
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/cloudwego/thriftgo/internal/apiutil"
+	"github.com/cloudwego/thriftgo/parser"
+)
+
+// ServerFileName 是服务端路由依赖的运行时生成的文件名
+const ServerFileName = "http_server.ts"
+
+// 服务端路由支持的框架
+const (
+	ServerRouterExpress = "express"
+	ServerRouterFastify = "fastify"
+)
+
+// apiMethods 是路由支持的 api 注解及对应的 HTTP 方法，按客户端的优先级排列
+var apiMethods = []struct {
+	annotation string
+	method     string
+}{
+	{"api.get", "GET"},
+	{"api.post", "POST"},
+	{"api.put", "PUT"},
+	{"api.delete", "DELETE"},
+	{"api.patch", "PATCH"},
+}
+
+// Route 表示服务端注册的一条路由
+type Route struct {
+	Name   string // IDL 中的方法名
+	Method string // HTTP 方法
+	Path   string
+	Bind   string // 从请求中绑定参数的语句
+	Call   string // 调用服务实现的表达式
+	Void   bool
+	Result string // 把返回值转换为响应体的表达式
+
+	Exceptions []*RouteException // throws 中声明的异常
+}
+
+// RouteException 表示方法在 throws 中声明的异常及其响应的状态码
+type RouteException struct {
+	Name   string // IDL 中的异常名，与 ThriftException 的 type 对应
+	Status int
+	Type   string // 生成类时用于 instanceof 判断的类名
+	ToJSON string // 把异常转换为响应体的方法，i64 不映射为 number 时使用
+}
+
+// GetServerImportPath 获取服务端运行时的导入路径
+func (u *CodeUtils) GetServerImportPath() string {
+	return u.rootImportPath + "/" + strings.TrimSuffix(ServerFileName, ".ts")
+}
+
+// routeWriter 生成路由中绑定参数的语句，indent 是语句在处理函数中的缩进，sources 记录用到的请求字段
+type routeWriter struct {
+	*codecWriter
+	features *Features
+	indent   int
+	sources  map[string]bool
+}
+
+// GetRoutes 获取服务中带有 api.get/api.post 等注解的方法对应的路由，方法上的每个注解都注册一条路由，
+// 参数按 getParamBindings 的规则绑定，与生成的客户端一致；throws 中的异常按 api.http_code 响应
+func (u *CodeUtils) GetRoutes(svc *parser.Service) []*Route {
+	var routes []*Route
+	for _, f := range svc.Functions {
+		for _, route := range getHTTPRoutes(f) {
+			routes = append(routes, u.getRoute(f, route))
+		}
+	}
+	return routes
+}
+
+func (u *CodeUtils) getRoute(f *parser.Function, route *httpRoute) *Route {
+	w := &routeWriter{
+		codecWriter: &codecWriter{ast: u.currentAST, i64As: u.features.I64As},
+		features:    u.features,
+		indent:      3,
+		sources:     make(map[string]bool),
+	}
+	if u.features.ServerRouter == ServerRouterFastify {
+		w.indent = 5
+	}
+	bindings := getParamBindings(f, route, u.features)
+	var args []string
+	for i, arg := range f.Arguments {
+		name := fmt.Sprintf("arg%d", i)
+		if IsStructField(arg) {
+			w.line(w.indent, "const %s: any = {};", name)
+		} else {
+			w.line(w.indent, "let %s: any;", name)
+		}
+		for _, b := range bindings {
+			if b.Arg == arg {
+				w.bindValue(b, name+b.Property)
+			}
+		}
+		args = append(args, name)
+	}
+	bind := w.flush()
+	var header []string
+	for _, src := range []string{sourcePath, sourceQuery, sourceBody, sourceForm, sourceHeader} {
+		if w.sources[src] {
+			field := src
+			if src == sourceForm {
+				// 表单由 express.urlencoded 或 @fastify/formbody 解析到请求体中
+				field = "body"
+			}
+			header = append(header, fmt.Sprintf("%sconst %s: any = req.%s || {};", strings.Repeat("  ", w.indent), src, field))
+		}
+	}
+	if len(header) > 0 {
+		bind = strings.Join(header, "\n") + "\n" + bind
+	}
+
+	void := f.Void || f.FunctionType == nil
+	result := "result"
+	if void {
+		result = "null"
+	} else if u.JSONHelpers() {
+		ast, typ := w.deref(w.ast, f.FunctionType)
+		result = w.toJSON(ast, typ, "result", 0)
+	}
+	return &Route{
+		Name:   f.Name,
+		Method: route.Method,
+		Path:   route.Path,
+		Bind:   strings.TrimRight(bind, "\n"),
+		Call:   fmt.Sprintf("impl.%s(%s)", GetPropertyNameWithStyle(f.Name, u.features), strings.Join(args, ", ")),
+		Void:   void,
+		Result: result,
+
+		Exceptions: u.getRouteExceptions(w.codecWriter, f),
+	}
+}
+
+// getRouteExceptions 获取方法声明的异常，状态码与 Go 和 OpenAPI 生成器一致：
+// 优先读取 throws 字段上的 api.http_code，其次读取异常定义上的，默认为 500
+func (u *CodeUtils) getRouteExceptions(w *codecWriter, f *parser.Function) []*RouteException {
+	var es []*RouteException
+	seen := make(map[string]bool)
+	for _, field := range f.Throws {
+		_, typ := w.deref(w.ast, field.Type)
+		name := getSimpleTypeName(typ.Name)
+		if seen[name] {
+			continue
+		}
+		seen[name] = true
+		code, err := apiutil.ExceptionCode(w.ast, field)
+		if err != nil {
+			u.log.Warnf("%s", err.Error())
+		}
+		e := &RouteException{Name: name, Status: code}
+		if u.features.GenerateClasses {
+			e.Type = name
+		}
+		if u.JSONHelpers() {
+			e.ToJSON = name + ".toJSON"
+		}
+		es = append(es, e)
+	}
+	return es
+}
+
+// bindValue 生成从请求中读取 b 绑定的值并赋给 target 的语句，缺少 required 字段时返回 400，
+// 请求体中的值按 JSON 转换，路径、查询参数、表单和请求头中的字符串按类型解析
+func (w *routeWriter) bindValue(b *paramBinding, target string) {
+	src, key := b.Source, b.Key
+	if src == sourceHeader {
+		// express 和 fastify 的请求头名都是小写的
+		key = strings.ToLower(key)
+	}
+	w.sources[src] = true
+	value := fmt.Sprintf("%s['%s']", src, key)
+	ast, typ := w.deref(w.ast, b.Field.Type)
+	var expr string
+	if src == sourceBody {
+		w.line(w.indent, "if (%s !== undefined && %s !== null) {", value, value)
+		expr = value
+		if w.i64As != I64AsNumber {
+			expr = w.fromJSON(ast, typ, value, 0)
+		}
+	} else {
+		w.line(w.indent, "if (%s !== undefined) {", value)
+		param := value
+		if src == sourceHeader && (typ.Category == parser.Category_List || typ.Category == parser.Category_Set) {
+			// 客户端把请求头中的列表以逗号连接
+			param = fmt.Sprintf("splitHeaderParam(%s)", value)
+		}
+		expr = w.parseParam(ast, typ, param, key)
+	}
+	w.line(w.indent+1, "%s = %s;", target, expr)
+	if b.Field.Requiredness == parser.FieldType_Required {
+		w.line(w.indent, "} else {")
+		w.line(w.indent+1, "throw new HttpStatusError(400, 'missing required parameter %s');", key)
+	}
+	w.line(w.indent, "}")
+}
+
+// parseParam 返回把路径、查询参数或请求头中的字符串 value 转换为 t 类型的表达式
+func (w *routeWriter) parseParam(ast *parser.Thrift, t *parser.Type, value, key string) string {
+	switch t.Category {
+	case parser.Category_Bool:
+		return fmt.Sprintf("parseBoolParam(%s, '%s')", value, key)
+	case parser.Category_Byte, parser.Category_I16, parser.Category_I32, parser.Category_Enum:
+		return fmt.Sprintf("parseIntParam(%s, '%s')", value, key)
+	case parser.Category_I64:
+		switch w.i64As {
+		case I64AsBigInt:
+			return fmt.Sprintf("parseBigIntParam(%s, '%s')", value, key)
+		case I64AsString:
+			return fmt.Sprintf("parseI64StringParam(%s, '%s')", value, key)
+		default:
+			return fmt.Sprintf("parseIntParam(%s, '%s')", value, key)
+		}
+	case parser.Category_Double:
+		return fmt.Sprintf("parseNumberParam(%s, '%s')", value, key)
+	case parser.Category_String:
+		return fmt.Sprintf("parseStringParam(%s, '%s')", value, key)
+	case parser.Category_List, parser.Category_Set:
+		elemAST, elem := w.deref(ast, t.ValueType)
+		if (isPrimitiveType(elem.Category) && elem.Category != parser.Category_Binary) || elem.Category == parser.Category_Enum {
+			list := fmt.Sprintf("parseListParam(%s, (v: unknown) => %s)", value, w.parseParam(elemAST, elem, "v", key))
+			if t.Category == parser.Category_Set {
+				return fmt.Sprintf("new Set(%s)", list)
+			}
+			return list
+		}
+	}
+	// 复杂类型以 JSON 字符串传递
+	value = fmt.Sprintf("parseJSONParam(%s, '%s')", value, key)
+	if w.i64As != I64AsNumber {
+		return w.fromJSON(ast, t, value, 0)
+	}
+	return value
+}
@@ -15,7 +15,9 @@
 package typescript
 
 import (
+	"fmt"
 	"path/filepath"
+	"sort"
 	"strings"
 
 	"github.com/cloudwego/thriftgo/generator/backend"
@@ -87,7 +89,7 @@ func (s *Scope) GetPackageName() string {
 func (s *Scope) GetFileName() string {
 	base := filepath.Base(s.Filename)
 	name := strings.TrimSuffix(base, ".thrift")
-	return name + ".ts"
+	return name + s.utils.FileExt()
 }
 
 // GetSourceThriftFile 获取来源的 Thrift 文件路径
@@ -175,7 +177,12 @@ func (s *Scope) collectImports(ast *parser.Thrift) {
 
 	// 转换为 ImportInfo 列表，并去重
 	importSet := make(map[string]ImportInfo)
-	for module, types := range importMap {
+	for _, module := range sortedKeys(importMap) {
+		types := importMap[module]
+		// 没有 namespace 或合并输出时所有类型生成到同一个文件中，本地类型不需要导入
+		if (currentNamespace == "" || s.utils.features.Bundle != "") && s.isLocalType(module) {
+			continue
+		}
 		if len(types) > 0 {
 			// 计算相对路径
 			relativePath := s.calculateRelativePath(currentNamespace, module)
@@ -206,10 +213,20 @@ func (s *Scope) collectImports(ast *parser.Thrift) {
 		}
 	}
 
-	// 将去重后的导入添加到列表中
-	for _, importInfo := range importSet {
-		s.Imports = append(s.Imports, importInfo)
+	// 将去重后的导入按路径排序后添加到列表中，保证生成结果稳定
+	for _, path := range sortedKeys(importSet) {
+		s.Imports = append(s.Imports, importSet[path])
+	}
+}
+
+// sortedKeys 返回按字典序排列的 map 键，使生成结果不依赖 map 的遍历顺序
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
 	}
+	sort.Strings(keys)
+	return keys
 }
 
 // collectImportsForService 为服务文件收集导入信息
@@ -278,7 +295,8 @@ func (s *Scope) collectImportsForService(ast *parser.Thrift, serviceName string)
 
 	// 转换为 ImportInfo 列表，并去重
 	importSet := make(map[string]ImportInfo)
-	for module, types := range importMap {
+	for _, module := range sortedKeys(importMap) {
+		types := importMap[module]
 		if len(types) > 0 {
 			// 计算相对路径
 			relativePath := s.calculateRelativePath(currentNamespace, module)
@@ -309,9 +327,9 @@ func (s *Scope) collectImportsForService(ast *parser.Thrift, serviceName string)
 		}
 	}
 
-	// 将去重后的导入添加到列表中
-	for _, importInfo := range importSet {
-		s.Imports = append(s.Imports, importInfo)
+	// 将去重后的导入按路径排序后添加到列表中，保证生成结果稳定
+	for _, path := range sortedKeys(importSet) {
+		s.Imports = append(s.Imports, importSet[path])
 	}
 }
 
@@ -323,9 +341,9 @@ func (s *Scope) collectImportsFromStruct(structLike *parser.StructLike, importMa
 		expandedFieldNames = expandedStruct.ExpandedFieldNames
 	}
 
-	// 只收集未被展开的字段的导入信息
+	// 只收集未被展开的字段的导入信息，编解码方法、校验函数和 mock 工厂函数会整体处理被展开的字段，也需要导入其类型
 	for _, field := range structLike.Fields {
-		if !expandedFieldNames[field.Name] {
+		if !expandedFieldNames[field.Name] || s.utils.HasValueObject() || s.utils.features.Validators || s.utils.features.Mocks {
 			s.collectImportsFromType(field.Type, importMap, ast)
 		}
 	}
@@ -388,6 +406,11 @@ func (s *Scope) collectImportsFromServiceWithCurrentFile(service *parser.Service
 		if function.FunctionType != nil {
 			s.collectImportsFromTypeWithCurrentFile(function.FunctionType, importMap, ast, currentFileType)
 		}
+
+		// 收集声明的异常的导入，路由按异常映射状态码
+		for _, exception := range function.Throws {
+			s.collectImportsFromTypeWithCurrentFile(exception.Type, importMap, ast, currentFileType)
+		}
 	}
 }
 
@@ -608,6 +631,10 @@ type CodeUtils struct {
 	features  *Features
 	log       backend.LogFunc
 	rootScope *Scope
+
+	currentAST     *parser.Thrift // 正在生成的 IDL
+	rootImportPath string         // 当前文件到输出根目录的相对路径，用于导入运行时等公共文件
+	bundleModule   string         // 合并输出时服务相关文件导入的合并模块
 }
 
 // Features TypeScript 生成特性
@@ -620,6 +647,34 @@ type Features struct {
 	// 命名风格选项
 	SnakeStylePropertyName     bool // 使用 snake_case 命名属性
 	LowerCamelCasePropertyName bool // 使用 lowerCamelCase 命名属性（默认）
+	// 生成 Thrift binary/compact 编解码方法
+	ThriftCodec bool
+	// i64 的映射方式：number、bigint 或 string
+	I64As string
+	// 生成运行时校验函数 validate<Type>
+	Validators bool
+	// 生成 Zod schema <Type>Schema
+	ZodSchemas bool
+	// zod 的导入路径
+	ZodImport string
+	// 生成服务端路由使用的框架：express 或 fastify，为空时不生成
+	ServerRouter string
+	// 生成数据请求 hooks 使用的库：react-query 或 swr，为空时不生成
+	Hooks string
+	// hooks 所基于的库的导入路径，为空时使用 @tanstack/react-query 或 swr
+	HooksImport string
+	// 客户端默认使用的 HTTP 传输层：fetch 或 axios
+	HttpTransport string
+	// 传输层、axios 实例和 BizException 的导入路径，为空时使用生成的 http_transport.ts
+	TransportImport    string
+	AxiosImport        string
+	BizExceptionImport string
+	// 只生成 .d.ts 声明文件，不包含运行时代码
+	Declarations bool
+	// 合并输出的方式：file 或 tree，为空时按 namespace 为每个类型生成单独的文件
+	Bundle string
+	// 生成测试数据工厂函数 mock<Type>
+	Mocks bool
 }
 
 // NewCodeUtils 创建新的代码工具
@@ -633,6 +688,10 @@ func NewCodeUtils(log backend.LogFunc) *CodeUtils {
 			UseES6Modules:              true,
 			SnakeStylePropertyName:     false,
 			LowerCamelCasePropertyName: true, // 默认使用小驼峰命名
+			I64As:                      I64AsNumber,
+			HttpTransport:              HttpTransportFetch,
+			AxiosImport:                "axios",
+			ZodImport:                  "zod",
 		},
 		log: log,
 	}
@@ -678,18 +737,121 @@ func (u *CodeUtils) HandleOptions(args []string) error {
 				u.features.LowerCamelCasePropertyName = true
 				u.features.SnakeStylePropertyName = false
 			}
+		case "generate_classes":
+			u.features.GenerateClasses = value == "true"
+		case "thrift_codec":
+			u.features.ThriftCodec = value == "true"
+		case "i64_as":
+			switch value {
+			case I64AsNumber, I64AsBigInt, I64AsString:
+				u.features.I64As = value
+			default:
+				return fmt.Errorf("invalid value %q for i64_as, expect number, bigint or string", value)
+			}
+		case "validators":
+			u.features.Validators = value == "true"
+		case "mocks":
+			u.features.Mocks = value == "true"
+		case "zod_schemas":
+			u.features.ZodSchemas = value == "true"
+		case "server_router":
+			switch value {
+			case "", ServerRouterExpress, ServerRouterFastify:
+				u.features.ServerRouter = value
+			default:
+				return fmt.Errorf("invalid value %q for server_router, expect express or fastify", value)
+			}
+		case "hooks":
+			switch value {
+			case "", HooksReactQuery, HooksSWR:
+				u.features.Hooks = value
+			default:
+				return fmt.Errorf("invalid value %q for hooks, expect react-query or swr", value)
+			}
+		case "hooks_import":
+			u.features.HooksImport = value
+		case "zod_import":
+			if value != "" {
+				u.features.ZodImport = value
+			}
+		case "http_transport":
+			switch value {
+			case HttpTransportFetch, HttpTransportAxios:
+				u.features.HttpTransport = value
+			default:
+				return fmt.Errorf("invalid value %q for http_transport, expect fetch or axios", value)
+			}
+		case "transport_import":
+			u.features.TransportImport = value
+		case "axios_import":
+			if value != "" {
+				u.features.AxiosImport = value
+			}
+		case "biz_exception_import":
+			u.features.BizExceptionImport = value
+		case "declarations":
+			u.features.Declarations = value == "true"
+		case "bundle":
+			switch value {
+			case "", BundleFile, BundleTree:
+				u.features.Bundle = value
+			default:
+				return fmt.Errorf("invalid value %q for bundle, expect file or tree", value)
+			}
 		}
 	}
+	if u.features.Declarations {
+		// 声明文件中不能包含运行时代码
+		u.features.GenerateClasses = false
+		u.features.ThriftCodec = false
+		u.features.Validators = false
+		u.features.ZodSchemas = false
+		u.features.Mocks = false
+		u.features.ServerRouter = ""
+		u.features.Hooks = ""
+	}
 	return nil
 }
 
+// JSONHelpers 检查是否需要生成 JSON 转换方法，i64 不映射为 number 时需要
+func (u *CodeUtils) JSONHelpers() bool {
+	return u.features.I64As != I64AsNumber && !u.features.Declarations
+}
+
+// HasValueObject 检查是否为结构体生成同名的值对象（类、编解码或 JSON 转换方法）
+func (u *CodeUtils) HasValueObject() bool {
+	return u.features.GenerateClasses || u.NeedsRuntime()
+}
+
+// NeedsRuntime 检查生成的代码是否依赖运行时（编解码或 JSON 转换方法）
+func (u *CodeUtils) NeedsRuntime() bool {
+	return u.features.ThriftCodec || u.JSONHelpers()
+}
+
+// MethodModifier 返回值对象方法的修饰符，生成类时方法为静态方法
+func (u *CodeUtils) MethodModifier() string {
+	if u.features.GenerateClasses {
+		return "static "
+	}
+	return ""
+}
+
+// MethodSeparator 返回值对象方法之间的分隔符，类的成员之间不需要逗号
+func (u *CodeUtils) MethodSeparator() string {
+	if u.features.GenerateClasses {
+		return ""
+	}
+	return ","
+}
+
 // BuildFuncMap 构建模板函数映射
 func (u *CodeUtils) BuildFuncMap() map[string]interface{} {
+	types := &typeMapper{i64Type: u.features.I64As}
 	return map[string]interface{}{
-		"GetTypeScriptType":        GetTypeScriptType,
-		"GetFieldType":             GetFieldType,
-		"GetMethodSignature":       GetMethodSignature,
-		"GetAsyncMethodSignature":  GetAsyncMethodSignature,
+		"GetTypeScriptType":        types.typeOf,
+		"GetFieldType":             types.fieldType,
+		"GetMethodSignature":       types.methodSignature,
+		"GetAsyncMethodSignature":  types.asyncMethodSignature,
 		"GetInterfaceName":         GetInterfaceName,
 		"GetClassName":             GetClassName,
 		"GetEnumName":              GetEnumName,
@@ -700,7 +862,7 @@ func (u *CodeUtils) BuildFuncMap() map[string]interface{} {
 		"IsOptional":               IsOptional,
 		"GetDefaultValue":          GetDefaultValue,
 		"GetDefaultValueForType":   GetDefaultValueForType,
-		"GetConstantValue":         GetConstantValue,
+		"GetConstantValue":         u.GetConstantValue,
 		"IsSelfReference":          IsSelfReference,
 		"IsExpandField":            isExpandField,
 		"IsExpandableStruct":       isExpandableStruct,
@@ -756,6 +918,52 @@ func (u *CodeUtils) BuildFuncMap() map[string]interface{} {
 		"ShouldGenerateFieldsFile":                     ShouldGenerateFieldsFile,
 		"GetFieldsFileName":                            GetFieldsFileName,
 		"GetStructFieldNames":                          func(structLike *parser.StructLike) []string { return u.getStructFieldNames(structLike) },
+		"ThriftCodec":                                  func() bool { return u.features.ThriftCodec },
+		"GenerateClasses":                              func() bool { return u.features.GenerateClasses },
+		"NeedsRuntime":                                 u.NeedsRuntime,
+		"Declarations":                                 func() bool { return u.features.Declarations },
+		"GetClassDefaults":                             u.GetClassDefaults,
+		"MethodModifier":                               u.MethodModifier,
+		"MethodSeparator":                              u.MethodSeparator,
+		"JSONHelpers":                                  u.JSONHelpers,
+		"I64As":                                        func() string { return u.features.I64As },
+		"Validators":                                   func() bool { return u.features.Validators },
+		"GetValidatorName":                             GetValidatorName,
+		"GetValidatorBody":                             u.GetValidatorBody,
+		"GetTypedefValidatorCheck":                     u.GetTypedefValidatorCheck,
+		"ZodSchemas":                                   func() bool { return u.features.ZodSchemas },
+		"GetZodImportPath":                             func() string { return u.features.ZodImport },
+		"GetZodSchemaName":                             GetZodSchemaName,
+		"GetZodSchema":                                 u.GetZodSchema,
+		"GetTypedefZodSchema":                          u.GetTypedefZodSchema,
+		"Mocks":                                        func() bool { return u.features.Mocks },
+		"GetMockName":                                  GetMockName,
+		"GetMockBody":                                  u.GetMockBody,
+		"GetEnumMockValues":                            GetEnumMockValues,
+		"GetTypedefMockValue":                          u.GetTypedefMockValue,
+		"GetMockRuntimeImportPath":                     u.GetMockRuntimeImportPath,
+		"IsRecursiveStruct":                            u.IsRecursiveStruct,
+		"ServerRouter":                                 func() string { return u.features.ServerRouter },
+		"GetServerImportPath":                          u.GetServerImportPath,
+		"GetRoutes":                                    u.GetRoutes,
+		"GetClientArgName":                             u.GetClientArgName,
+		"GetClientArgJSON":                             u.GetClientArgJSON,
+		"GetClientResult":                              u.GetClientResult,
+		"GetClientRequest":                             u.GetClientRequest,
+		"IsOptionalArgument":                           IsOptionalArgument,
+		"GetServiceModulePath":                         u.GetServiceModulePath,
+		"Hooks":                                        func() string { return u.features.Hooks },
+		"GetHooksImportPath":                           u.GetHooksImportPath,
+		"GetHookKeysName":                              GetHookKeysName,
+		"GetHooks":                                     u.GetHooks,
+		"HttpTransport":                                func() string { return u.features.HttpTransport },
+		"GetTransportImportPath":                       u.GetTransportImportPath,
+		"GetAxiosImportPath":                           func() string { return u.features.AxiosImport },
+		"GetBizExceptionImportPath":                    u.GetBizExceptionImportPath,
+		"HasValueObject":                               u.HasValueObject,
+		"GetJSONFields":                                u.GetJSONFields,
+		"GetCodecFields":                               u.GetCodecFields,
+		"GetRuntimeImportPath":                         u.GetRuntimeImportPath,
 	}
 }
 
@@ -1120,7 +1328,15 @@ func (s *Scope) isTypeDefinedInOtherFiles(typeName string, ast *parser.Thrift) b
 func (u *CodeUtils) GetFilename(ast *parser.Thrift) string {
 	base := filepath.Base(ast.Filename)
 	name := strings.TrimSuffix(base, ".thrift")
-	return name + ".ts"
+	return name + u.FileExt()
+}
+
+// FileExt 获取生成文件的扩展名，只生成声明时为 .d.ts
+func (u *CodeUtils) FileExt() string {
+	if u.features.Declarations {
+		return ".d.ts"
+	}
+	return ".ts"
 }
 
 // getExpandedFields 获取结构体的展开字段（无调试信息）
@@ -35,5 +35,13 @@ func Templates() []string {
 		templates.SingleServiceTemplate,
 		templates.SimpleServiceImplementationTemplate,
 		templates.FieldsTemplate,
+		templates.CodecTemplate,
+		templates.RuntimeTemplate,
+		templates.HttpTransportTemplate,
+		templates.ValidatorTemplate,
+		templates.ZodTemplate,
+		templates.ServerTemplate,
+		templates.HooksTemplate,
+		templates.MockTemplate,
 	}
 }
@@ -0,0 +1,147 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package templates
+
+// 编解码模板，为结构体、联合体和异常生成与接口同名的值对象或类，包含 read/write 和 fromJSON/toJSON 方法
+const CodecTemplate = `
+{{- define "codecImports" -}}
+{{- if ThriftCodec -}}
+import { TProtocolException, TType } from '{{ GetRuntimeImportPath }}';
+import type { TProtocol } from '{{ GetRuntimeImportPath }}';
+{{- end }}
+{{- if JSONHelpers }}
+{{- if ThriftCodec }}
+{{ end -}}
+import { i64FromJSON, i64KeyFromJSON, i64ToJSON, doubleFromJSON, listFromJSON, setFromJSON, mapFromJSON, listToJSON, mapToJSON } from '{{ GetRuntimeImportPath }}';
+{{- end }}
+{{- end -}}
+
+{{- define "codec" -}}
+{{- $name := GetInterfaceName .Name }}
+{{- if GenerateClasses }}
+/**
+ * {{ $name }} 的类，构造时先设置 IDL 中的默认值，再合并传入的属性
+ */
+export class {{ $name }} {
+  constructor(init?: Partial<{{ $name }}>) {
+{{- range GetClassDefaults . }}
+    this.{{ .Property }} = {{ .Value }};
+{{- end }}
+    if (init) {
+      Object.assign(this, init);
+    }
+  }
+{{- if ThriftCodec }}
+{{ template "codecMethods" . }}{{ end }}
+{{- if JSONHelpers }}
+{{ template "jsonMethods" . }}
+{{- end }}
+}
+{{- else }}
+/**
+ * {{ $name }} 的编解码方法
+ */
+export const {{ $name }} = {
+{{- if ThriftCodec }}{{ template "codecMethods" . }}{{ end }}
+{{- if JSONHelpers }}
+{{- if ThriftCodec }}
+{{ end }}{{ template "jsonMethods" . }}
+{{- end }}
+};
+{{- end }}
+{{- end -}}
+
+{{- define "codecMethods" -}}
+{{- $name := GetInterfaceName .Name }}
+{{- $isUnion := eq .Category "union" }}
+  {{ MethodModifier }}write(output: TProtocol, value: {{ $name }}): void {
+{{- if $isUnion }}
+    let count = 0;
+{{- end }}
+    output.writeStructBegin('{{ .Name }}');
+{{- range GetCodecFields . }}
+{{ .Write }}
+{{- end }}
+{{- if $isUnion }}
+    if (count !== 1) {
+      throw new TProtocolException('union {{ .Name }} must have exactly one field set, got ' + count);
+    }
+{{- end }}
+    output.writeFieldStop();
+    output.writeStructEnd();
+  }{{ MethodSeparator }}
+
+  {{ MethodModifier }}read(input: TProtocol): {{ $name }} {
+    const value: any = {};
+{{- if $isUnion }}
+    let count = 0;
+{{- end }}
+    input.readStructBegin();
+    for (;;) {
+      const field = input.readFieldBegin();
+      if (field.type === TType.STOP) {
+        break;
+      }
+      switch (field.id) {
+{{- range GetCodecFields . }}
+        case {{ .ID }}:
+{{ .Read }}
+          break;
+{{- end }}
+        default:
+          input.skip(field.type);
+      }
+      input.readFieldEnd();
+    }
+    input.readStructEnd();
+{{- if $isUnion }}
+    if (count !== 1) {
+      throw new TProtocolException('union {{ .Name }} must have exactly one field set, got ' + count);
+    }
+{{- end }}
+{{- range GetCodecFields . }}
+{{- if and .Required (not .Expanded) }}
+    if (value.{{ .Property }} === undefined) {
+      throw new TProtocolException('required field {{ .Name }} of {{ $.Name }} is unset');
+    }
+{{- end }}
+{{- end }}
+    return {{ template "codecResult" . }};
+  }{{ MethodSeparator }}
+{{- end -}}
+
+{{- define "jsonMethods" -}}
+{{- $name := GetInterfaceName .Name }}
+  {{ MethodModifier }}fromJSON(json: any): {{ $name }} {
+    const value: any = {};
+{{- range GetJSONFields . }}
+{{ .FromJSON }}
+{{- end }}
+    return {{ template "codecResult" . }};
+  }{{ MethodSeparator }}
+
+  {{ MethodModifier }}toJSON(value: {{ $name }}): any {
+    const json: any = {};
+{{- range GetJSONFields . }}
+{{ .ToJSON }}
+{{- end }}
+    return json;
+  }{{ MethodSeparator }}
+{{- end -}}
+
+{{- define "codecResult" -}}
+{{- if GenerateClasses }}new {{ GetInterfaceName .Name }}(value){{ else }}value{{ end }}
+{{- end -}}
+`
@@ -14,9 +14,28 @@
 
 package templates
 
-// 常量模板
+// 常量模板，引用的常量和枚举值展开为字面量，分离文件模式下常量生成到 constants.ts
 const ConstantTemplate = `
 {{- define "constant" -}}
+{{- if Declarations -}}
+export declare const {{ GetConstantName .Name }}: {{ GetTypeScriptType .Type }};
+{{- else -}}
 export const {{ GetConstantName .Name }}: {{ GetTypeScriptType .Type }} = {{ GetConstantValue . }};
 {{- end -}}
+{{- end -}}
+
+{{- define "constants" -}}
+{{ if not Declarations }}"use strict";
+
+{{ end -}}
+// Generated by thriftgo {{Version}}
+// DO NOT EDIT UNLESS YOU ARE SURE THAT YOU KNOW WHAT YOU ARE DOING
+
+{{- if .Imports }}
+{{ template "imports" . }}
+{{- end }}
+{{ range .Constants }}
+{{ template "constant" . }}
+{{- end }}
+{{- end -}}
 `
@@ -21,7 +21,7 @@ const EnumTemplate = `
 {{- if $enumComment }}
 {{ $enumComment }}
 {{- end }}
-export enum {{ GetInterfaceName .Name }} {
+export {{ if Declarations }}declare {{ end }}enum {{ GetInterfaceName .Name }} {
 {{- range .Values }}
 {{- $valueComment := GetEnumValueComment . }}
 {{- if $valueComment }}
@@ -31,7 +31,14 @@ export enum {{ GetInterfaceName .Name }} {
 {{- end }}
 }
 
-{{- if HasEnumValueWithTag . }}
+{{- if and (HasEnumValueWithTag .) Declarations }}
+// 枚举选项数组，用于下拉菜单等场景
+export declare const {{ GetInterfaceName .Name }}Options: readonly [
+{{- range GetEnumOptions . }}
+  { readonly label: '{{ .label }}'; readonly value: {{ .value }};{{ if .color }} readonly color: '{{ .color }}';{{ end }} },
+{{- end }}
+];
+{{- else if HasEnumValueWithTag . }}
 // 枚举选项数组，用于下拉菜单等场景
 export const {{ GetInterfaceName .Name }}Options = [
 {{- range GetEnumOptions . }}
@@ -43,5 +50,14 @@ export const {{ GetInterfaceName .Name }}Options = [
 {{- end }}
 ] as const;
 {{- end }}
+{{- if Validators }}
+{{ template "enumValidator" . }}
+{{- end }}
+{{- if ZodSchemas }}
+{{ template "enumZodSchema" . }}
+{{- end }}
+{{- if Mocks }}
+{{ template "enumMock" . }}
+{{- end }}
 {{- end -}}
 `
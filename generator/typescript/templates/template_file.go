@@ -14,19 +14,39 @@
 
 package templates
 
-// 文件模板
+// 文件模板，thriftHeader 包含文件头和导入，thriftBody 包含 IDL 中的定义，合并输出时按 IDL 分别渲染
 const FileTemplate = `
 {{- define "thrift" -}}
-"use strict";
+{{ template "thriftHeader" . }}{{ template "thriftBody" . }}
+{{- end -}}
+
+{{- define "thriftHeader" -}}
+{{ if not Declarations }}"use strict";
 
 
+{{ end -}}
 // Generated by thriftgo {{Version}}
 // DO NOT EDIT UNLESS YOU ARE SURE THAT YOU KNOW WHAT YOU ARE DOING
 
 {{- if .Imports }}
 {{ template "imports" . }}
+{{- if Validators }}{{ template "validatorImports" . }}{{ end }}
 {{- end }}
 
+{{- if and NeedsRuntime (or .Structs .Unions .Exceptions) }}
+{{ template "codecImports" . }}
+{{- end }}
+
+{{- if and ZodSchemas (or .Enums .Structs .Typedefs .Unions .Exceptions) }}
+{{ template "zodImports" . }}
+{{- end }}
+
+{{- if and Mocks (or .Enums .Structs .Typedefs .Unions .Exceptions) }}
+{{ template "mockImports" . }}
+{{- end }}
+{{- end -}}
+
+{{- define "thriftBody" -}}
 {{- range .Enums }}
 {{ template "enum" . }}
 {{- end }}
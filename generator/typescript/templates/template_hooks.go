@@ -0,0 +1,137 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package templates
+
+// 数据请求 hooks 模板，基于客户端为每个服务方法生成 React Query 或 SWR 的 hook，
+// GET 方法生成查询，其余方法生成变更
+const HooksTemplate = `
+{{- define "serviceHooks" -}}
+"use strict";
+
+/* tslint:disable */
+/* eslint-disable */
+
+// Generated by thriftgo {{Version}}
+// DO NOT EDIT UNLESS YOU ARE SURE THAT YOU KNOW WHAT YOU ARE DOING
+
+{{- if .Imports }}
+{{ template "imports" . }}
+{{- end }}
+{{- if JSONHelpers }}
+import { i64ToJSON, listToJSON, mapToJSON } from '{{ GetRuntimeImportPath }}';
+{{- end }}
+
+{{- range .Services }}
+{{- $name := GetInterfaceName .Name }}
+{{- $keys := GetHookKeysName . }}
+import type { I{{ $name }} } from '{{ GetServiceModulePath .Name }}';
+import { {{ $name }}Client } from './{{ ToLower .Name }}client';
+{{- if eq Hooks "swr" }}
+import useSWR from '{{ GetHooksImportPath }}';
+import useSWRMutation from '{{ GetHooksImportPath }}/mutation';
+import type { SWRConfiguration } from '{{ GetHooksImportPath }}';
+import type { SWRMutationConfiguration } from '{{ GetHooksImportPath }}/mutation';
+{{- else }}
+import { useMutation, useQuery } from '{{ GetHooksImportPath }}';
+import type { UseMutationOptions, UseQueryOptions } from '{{ GetHooksImportPath }}';
+{{- end }}
+
+let client: I{{ $name }} | undefined;
+
+/**
+ * 设置 hooks 使用的客户端，未设置时使用默认配置的 {{ $name }}Client
+ */
+export function set{{ $name }}HooksClient(c: I{{ $name }}): void {
+  client = c;
+}
+
+function getClient(): I{{ $name }} {
+  if (!client) {
+    client = new {{ $name }}Client();
+  }
+  return client;
+}
+
+/**
+ * {{ $name }} 的 query key 工厂，可用于 invalidateQueries、mutate 等缓存操作
+{{- if JSONHelpers }}
+ * 参数以 JSON 值放入 key，i64 转换为字符串，使 key 可以用 JSON.stringify 计算哈希
+{{- end }}
+ */
+export const {{ $keys }} = {
+  all: ['{{ $name }}'] as const,
+{{- range GetHooks . }}
+{{- if .Query }}
+  {{ .Method }}: ({{ .Params }}) => ['{{ $name }}', '{{ .Method }}'{{ if .KeyArgs }}, {{ .KeyArgs }}{{ end }}] as const,
+{{- else }}
+  {{ .Method }}: () => ['{{ $name }}', '{{ .Method }}'] as const,
+{{- end }}
+{{- end }}
+};
+{{- range GetHooks . }}
+{{ if .Query }}{{ template "queryHook" . }}{{ else }}{{ template "mutationHook" . }}{{ end }}
+{{- end }}
+{{- end }}
+{{- end -}}
+
+{{- define "queryHook" -}}
+{{- $keys := .Keys }}
+/**
+ * {{ .Method }} 的查询 hook
+ */
+{{- if eq Hooks "swr" }}
+export function {{ .Name }}({{ .Params }}{{ if .Params }}, {{ end }}config?: SWRConfiguration<{{ .ResultType }}, Error>) {
+  return useSWR({{ $keys }}.{{ .Method }}({{ .Args }}), () => getClient().{{ .Method }}({{ .Args }}), config);
+}
+{{- else }}
+export function {{ .Name }}(
+  {{ .Params }}{{ if .Params }},
+  {{ end }}options?: Omit<UseQueryOptions<{{ .ResultType }}, Error, {{ .ResultType }}, ReturnType<typeof {{ $keys }}.{{ .Method }}>>, 'queryKey' | 'queryFn'>,
+) {
+  return useQuery({
+    queryKey: {{ $keys }}.{{ .Method }}({{ .Args }}),
+    queryFn: () => getClient().{{ .Method }}({{ .Args }}),
+    ...options,
+  });
+}
+{{- end }}
+{{- end -}}
+
+{{- define "mutationHook" -}}
+{{- $keys := .Keys }}
+/**
+ * {{ .Method }} 的变更 hook
+ */
+{{- if eq Hooks "swr" }}
+export function {{ .Name }}(config?: SWRMutationConfiguration<{{ .ResultType }}, Error, ReturnType<typeof {{ $keys }}.{{ .Method }}>, {{ .Variables }}>) {
+  return useSWRMutation(
+    {{ $keys }}.{{ .Method }}(),
+    {{ if .CallArgs }}(_key: unknown, { arg: variables }: { arg: {{ .Variables }} }){{ else }}(){{ end }} => getClient().{{ .Method }}({{ .CallArgs }}),
+    config,
+  );
+}
+{{- else }}
+export function {{ .Name }}(
+  options?: Omit<UseMutationOptions<{{ .ResultType }}, Error, {{ .Variables }}>, 'mutationKey' | 'mutationFn'>,
+) {
+  return useMutation({
+    mutationKey: {{ $keys }}.{{ .Method }}(),
+    mutationFn: ({{ if .CallArgs }}variables: {{ .Variables }}{{ end }}) => getClient().{{ .Method }}({{ .CallArgs }}),
+    ...options,
+  });
+}
+{{- end }}
+{{- end -}}
+`
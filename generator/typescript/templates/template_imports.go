@@ -18,7 +18,7 @@ package templates
 const ImportsTemplate = `
 {{- define "imports" -}}
 {{- range .Imports }}
-import type { {{ range $index, $type := .Types }}{{ if $index }}, {{ end }}{{ $type }}{{ end }} } from '{{ .Path }}';
+import {{ if not HasValueObject }}type {{ end }}{ {{ range $index, $type := .Types }}{{ if $index }}, {{ end }}{{ $type }}{{ end }} } from '{{ .Path }}';
 {{- end }}
 {{- end -}}
 `
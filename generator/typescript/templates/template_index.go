@@ -17,22 +17,42 @@ package templates
 // 索引模板
 const IndexTemplate = `
 {{- define "index" -}}
-"use strict";
+{{ if not Declarations }}"use strict";
 
+{{ end -}}
 // Generated by thriftgo {{Version}}
 // DO NOT EDIT UNLESS YOU ARE SURE THAT YOU KNOW WHAT YOU ARE DOING
 
 {{- range .Enums }}
-export { {{ GetInterfaceName .Name }} } from './{{ ToLower .Name }}';
+export { {{ GetInterfaceName .Name }}{{ if Validators }}, {{ GetValidatorName .Name }}{{ end }}{{ if ZodSchemas }}, {{ GetZodSchemaName .Name }}{{ end }}{{ if Mocks }}, {{ GetMockName .Name }}{{ end }} } from './{{ ToLower .Name }}';
+{{- if ZodSchemas }}
+export type { {{ GetInterfaceName .Name }}Inferred } from './{{ ToLower .Name }}';
+{{- end }}
 {{- end }}
 
 {{- range .Structs }}
-export type { {{ GetInterfaceName .Name }} } from './{{ ToLower .Name }}';
+export {{ if not HasValueObject }}type {{ end }}{ {{ GetInterfaceName .Name }} } from './{{ ToLower .Name }}';
+{{- if Validators }}
+export { {{ GetValidatorName .Name }} } from './{{ ToLower .Name }}';
+{{- end }}
+{{- if ZodSchemas }}
+export { {{ GetZodSchemaName .Name }} } from './{{ ToLower .Name }}';
+export type { {{ GetInterfaceName .Name }}Inferred } from './{{ ToLower .Name }}';
+{{- end }}
+{{- if Mocks }}
+export { {{ GetMockName .Name }} } from './{{ ToLower .Name }}';
+{{- end }}
+{{- end }}
+
+{{- if .Constants }}
+export * from './constants';
 {{- end }}
 
 {{- range .Services }}
 export type { I{{ GetInterfaceName .Name }} } from './{{ ToLower .Name }}';
+{{- if not Declarations }}
 export { {{ GetInterfaceName .Name }}Client } from './{{ ToLower .Name }}client';
 {{- end }}
+{{- end }}
 {{- end -}}
 `
@@ -0,0 +1,155 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package templates
+
+// mock 模板，为每个类型生成 mock<Type> 工厂函数，使用 mock_runtime.ts 中的 MockContext 按 seed 确定性地生成数据
+const MockTemplate = `
+{{- define "mockImports" -}}
+import { MockContext } from '{{ GetMockRuntimeImportPath }}';
+{{- range .Imports }}
+import { {{ range $index, $type := .Types }}{{ if $index }}, {{ end }}{{ GetMockName $type }}{{ end }} } from '{{ .Path }}';
+{{- end }}
+{{- end -}}
+
+{{- define "mock" -}}
+{{- $name := GetInterfaceName .Name }}
+/**
+ * 生成 {{ $name }} 的测试数据，overrides 中的属性会覆盖生成的值
+ */
+export function {{ GetMockName .Name }}(overrides?: Partial<{{ $name }}>, ctx: MockContext = new MockContext()): {{ $name }} {
+{{ GetMockBody . }}
+}
+{{- end -}}
+
+{{- define "enumMock" -}}
+{{- $name := GetEnumName .Name }}
+/**
+ * 生成 {{ $name }} 的测试数据，指定 override 时直接返回
+ */
+export function {{ GetMockName .Name }}(override?: {{ $name }}, ctx: MockContext = new MockContext()): {{ $name }} {
+{{- if .Values }}
+  return override !== undefined ? override : ctx.pick({{ GetEnumMockValues . }});
+{{- else }}
+  return override !== undefined ? override : (0 as {{ $name }});
+{{- end }}
+}
+{{- end -}}
+
+{{- define "typedefMock" -}}
+{{- $name := GetInterfaceName .Alias }}
+/**
+ * 生成 {{ $name }} 的测试数据，指定 override 时直接返回
+ */
+export function {{ GetMockName .Alias }}(override?: {{ $name }}, ctx: MockContext = new MockContext()): {{ $name }} {
+  return override !== undefined ? override : {{ GetTypedefMockValue . }};
+}
+{{- end -}}
+
+{{- define "mockRuntime" -}}
+"use strict";
+
+/* tslint:disable */
+/* eslint-disable */
+
+// Generated by thriftgo {{Version}}
+// DO NOT EDIT UNLESS YOU ARE SURE THAT YOU KNOW WHAT YOU ARE DOING
+
+/**
+ * mock<Type> 工厂函数使用的随机数据源，相同的 seed 总是生成相同的数据。
+ * 结构体每嵌套一层 depth 加一，达到 maxDepth 后容器为空、可选的结构体字段不再生成，
+ * 联合体选择不会继续嵌套的字段，以此终止递归类型的生成
+ */
+export class MockContext {
+  depth = 0;
+  private state: number;
+
+  constructor(seed: number = 1, readonly maxDepth: number = 3, readonly maxItems: number = 3) {
+    this.state = seed >>> 0;
+  }
+
+  /**
+   * 返回 [0, 1) 之间的伪随机数（mulberry32）
+   */
+  next(): number {
+    this.state = (this.state + 0x6d2b79f5) >>> 0;
+    let t = this.state;
+    t = Math.imul(t ^ (t >>> 15), t | 1);
+    t ^= t + Math.imul(t ^ (t >>> 7), t | 61);
+    return ((t ^ (t >>> 14)) >>> 0) / 4294967296;
+  }
+
+  int(min: number, max: number): number {
+    return min + Math.floor(this.next() * (max - min + 1));
+  }
+
+  double(): number {
+    return this.int(0, 1000000) / 100;
+  }
+
+  bool(): boolean {
+    return this.next() < 0.5;
+  }
+
+  string(prefix: string): string {
+    return prefix + '_' + this.int(0, 0xffff).toString(16);
+  }
+
+  bytes(): Uint8Array {
+    const out = new Uint8Array(this.int(1, 8));
+    for (let i = 0; i < out.length; i++) {
+      out[i] = this.int(0, 255);
+    }
+    return out;
+  }
+
+  pick<T>(values: readonly T[]): T {
+    return values[this.int(0, values.length - 1)];
+  }
+
+  atLimit(): boolean {
+    return this.depth >= this.maxDepth;
+  }
+
+  list<T>(item: () => T): T[] {
+    const out: T[] = [];
+    if (this.atLimit()) {
+      return out;
+    }
+    const n = this.int(1, this.maxItems);
+    for (let i = 0; i < n; i++) {
+      out.push(item());
+    }
+    return out;
+  }
+
+  record<V>(key: () => unknown, value: () => V): { [key: string]: V } {
+    const out: { [key: string]: V } = {};
+    for (const k of this.list(key)) {
+      out[String(k)] = value();
+    }
+    return out;
+  }
+
+  nested(fill: () => void): void {
+    this.depth++;
+    try {
+      fill();
+    } finally {
+      this.depth--;
+    }
+  }
+}
+{{- end -}}
+`
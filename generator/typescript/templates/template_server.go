@@ -0,0 +1,361 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package templates
+
+// 服务端路由模板，serverRouter 为每个服务生成把请求绑定到 I<Service> 实现的路由，
+// httpServer 生成到输出目录的 http_server.ts 中，包含参数转换和错误映射
+const ServerTemplate = `
+{{- define "serverRouter" -}}
+"use strict";
+
+/* tslint:disable */
+/* eslint-disable */
+
+// Generated by thriftgo {{Version}}
+// DO NOT EDIT UNLESS YOU ARE SURE THAT YOU KNOW WHAT YOU ARE DOING
+
+{{- if .Imports }}
+{{ template "imports" . }}
+{{- end }}
+{{- if JSONHelpers }}
+import { i64FromJSON, i64KeyFromJSON, i64ToJSON, doubleFromJSON, listFromJSON, setFromJSON, mapFromJSON, listToJSON, mapToJSON } from '{{ GetRuntimeImportPath }}';
+{{- end }}
+
+{{- range .Services }}
+import type { I{{ GetInterfaceName .Name }} } from '{{ GetServiceModulePath .Name }}';
+import {
+  HttpStatusError,
+  defaultMapError,
+  parseBoolParam,
+  parseIntParam,
+  parseBigIntParam,
+  parseI64StringParam,
+  parseNumberParam,
+  parseStringParam,
+  parseListParam,
+  parseJSONParam,
+  splitHeaderParam,
+} from '{{ GetServerImportPath }}';
+{{- if eq ServerRouter "fastify" }}
+import type { FastifyInstanceLike, FastifyReplyLike, ServerRequest, ServerRouterOptions } from '{{ GetServerImportPath }}';
+{{- else }}
+import type { ExpressRouterLike, ExpressResponseLike, ServerRequest, ServerRouterOptions } from '{{ GetServerImportPath }}';
+{{- end }}
+{{ template "serverRoutes" . }}
+{{- end }}
+{{- end -}}
+
+{{- define "serverRoutes" -}}
+{{- $name := GetInterfaceName .Name }}
+{{- if eq ServerRouter "fastify" }}
+/**
+ * {{ $name }} 的 fastify 路由插件，根据 API 注解把请求转发给 impl
+ * 用法：app.register(create{{ $name }}Router(impl))
+ */
+export function create{{ $name }}Router(impl: I{{ $name }}, options: ServerRouterOptions = {}): (fastify: FastifyInstanceLike) => Promise<void> {
+  const mapError = options.mapError || defaultMapError;
+  return async (fastify: FastifyInstanceLike): Promise<void> => {
+{{- range GetRoutes . }}
+    // {{ .Name }}
+    fastify.route({
+      method: '{{ .Method }}',
+      url: '{{ .Path }}',
+      handler: async (req: ServerRequest, reply: FastifyReplyLike) => {
+        try {
+{{ .Bind }}
+          {{ if .Void }}await {{ .Call }}{{ else }}const result = await {{ .Call }}{{ end }};
+          return reply.code(200).send({{ .Result }});
+        } catch (error) {
+          const { status, body } = mapError(error{{ template "routeExceptions" . }});
+          return reply.code(status).send(body);
+        }
+      },
+    });
+{{- end }}
+  };
+}
+{{- else }}
+/**
+ * 在 express Router 上注册 {{ $name }} 的路由，根据 API 注解把请求转发给 impl
+ * 用法：app.use(express.json(), create{{ $name }}Router(express.Router(), impl))
+ */
+export function create{{ $name }}Router<R extends ExpressRouterLike>(router: R, impl: I{{ $name }}, options: ServerRouterOptions = {}): R {
+  const mapError = options.mapError || defaultMapError;
+{{- range GetRoutes . }}
+
+  // {{ .Name }}
+  router.{{ ToLower .Method }}('{{ .Path }}', async (req: ServerRequest, res: ExpressResponseLike) => {
+    try {
+{{ .Bind }}
+      {{ if .Void }}await {{ .Call }}{{ else }}const result = await {{ .Call }}{{ end }};
+      res.status(200).json({{ .Result }});
+    } catch (error) {
+      const { status, body } = mapError(error{{ template "routeExceptions" . }});
+      res.status(status).json(body);
+    }
+  });
+{{- end }}
+
+  return router;
+}
+{{- end }}
+{{- end -}}
+
+{{- define "routeExceptions" -}}
+{{- if .Exceptions }}, [
+{{- range $i, $e := .Exceptions }}{{ if $i }}, {{ end }}{ name: '{{ .Name }}', status: {{ .Status }}{{ if .Type }}, type: {{ .Type }}{{ end }}{{ if .ToJSON }}, toJSON: {{ .ToJSON }}{{ end }} }{{ end -}}
+]{{ end }}
+{{- end -}}
+
+{{- define "httpServer" -}}
+"use strict";
+
+/* tslint:disable */
+/* eslint-disable */
+
+// Generated by thriftgo {{Version}}
+// DO NOT EDIT UNLESS YOU ARE SURE THAT YOU KNOW WHAT YOU ARE DOING
+import { BizException } from '{{ GetBizExceptionImportPath }}';
+
+/**
+ * 路由收到的请求，express 和 fastify 的请求对象都满足该接口
+ */
+export interface ServerRequest {
+  params?: any;
+  query?: any;
+  body?: any;
+  headers?: any;
+}
+
+/**
+ * express Router 中生成的路由用到的部分
+ */
+export interface ExpressRouterLike {
+  get(path: string, handler: (req: any, res: any) => unknown): unknown;
+  post(path: string, handler: (req: any, res: any) => unknown): unknown;
+  put(path: string, handler: (req: any, res: any) => unknown): unknown;
+  delete(path: string, handler: (req: any, res: any) => unknown): unknown;
+  patch(path: string, handler: (req: any, res: any) => unknown): unknown;
+}
+
+/**
+ * express Response 中生成的路由用到的部分
+ */
+export interface ExpressResponseLike {
+  status(code: number): { json(body: unknown): unknown };
+}
+
+/**
+ * fastify 实例中生成的路由用到的部分
+ */
+export interface FastifyInstanceLike {
+  route(options: {
+    method: string;
+    url: string;
+    handler: (req: any, reply: any) => Promise<unknown>;
+  }): unknown;
+}
+
+/**
+ * fastify Reply 中生成的路由用到的部分
+ */
+export interface FastifyReplyLike {
+  code(statusCode: number): { send(payload?: unknown): unknown };
+}
+
+/**
+ * 错误对应的 HTTP 响应
+ */
+export interface ServerErrorResponse {
+  status: number;
+  body: unknown;
+}
+
+/**
+ * 方法在 throws 中声明的异常，由生成的路由传给 mapError
+ */
+export interface DeclaredException {
+  // IDL 中的异常名，与 ThriftException 的 type 对应
+  name: string;
+  // api.http_code 声明的状态码，默认为 500
+  status: number;
+  // 生成类时异常的类，抛出该类的实例也视为该异常
+  type?: Function;
+  // 把异常转换为响应体，i64 不映射为 number 时使用生成的 toJSON
+  toJSON?: (value: any) => unknown;
+}
+
+/**
+ * 路由的配置
+ */
+export interface ServerRouterOptions {
+  // 把服务实现抛出的错误转换为 HTTP 响应，默认使用 defaultMapError，exceptions 为方法声明的异常
+  mapError?: (error: unknown, exceptions?: DeclaredException[]) => ServerErrorResponse;
+}
+
+/**
+ * 带有 HTTP 状态码的错误，服务实现可以直接抛出，参数不合法时路由抛出 400
+ */
+export class HttpStatusError extends Error {
+  constructor(
+    public readonly status: number,
+    message: string,
+  ) {
+    super(message);
+    this.name = 'HttpStatusError';
+  }
+}
+
+/**
+ * IDL 中声明的异常，异常生成为接口时服务实现用它包装后抛出，
+ * type 为 IDL 中的异常名，value 为异常的值
+ */
+export class ThriftException<T = unknown> extends Error {
+  constructor(
+    public readonly type: string,
+    public readonly value: T,
+  ) {
+    super(type);
+    this.name = 'ThriftException';
+  }
+}
+
+/**
+ * 判断错误是否为方法声明的异常，是则返回异常的值
+ */
+export function matchException(error: unknown, exception: DeclaredException): { value: unknown } | undefined {
+  if (error instanceof ThriftException && error.type === exception.name) {
+    return { value: error.value };
+  }
+  if (exception.type && error instanceof exception.type) {
+    return { value: error };
+  }
+  return undefined;
+}
+
+/**
+ * 默认的错误映射：
+ * 方法声明的异常使用 api.http_code 声明的状态码，响应体为异常的值；
+ * BizException 返回 200 和 { code, msg }，与客户端对业务错误的处理一致；
+ * 带有 4xx/5xx 的 status 或 statusCode 属性的错误（如 HttpStatusError、HttpError）使用该状态码；
+ * 其余错误返回 500
+ */
+export function defaultMapError(error: unknown, exceptions: DeclaredException[] = []): ServerErrorResponse {
+  for (const exception of exceptions) {
+    const matched = matchException(error, exception);
+    if (matched) {
+      return { status: exception.status, body: exception.toJSON ? exception.toJSON(matched.value) : matched.value };
+    }
+  }
+  if (error instanceof BizException) {
+    return { status: 200, body: { code: error.code, msg: error.msg } };
+  }
+  const e: any = error;
+  if (e !== null && typeof e === 'object') {
+    const status = typeof e.status === 'number' ? e.status : e.statusCode;
+    if (typeof status === 'number' && status >= 400 && status <= 599) {
+      return { status, body: { message: typeof e.message === 'string' ? e.message : String(status) } };
+    }
+  }
+  return { status: 500, body: { message: 'Internal Server Error' } };
+}
+
+function firstParam(value: unknown): unknown {
+  return Array.isArray(value) ? value[0] : value;
+}
+
+function invalidParam(name: string, value: unknown): HttpStatusError {
+  return new HttpStatusError(400, 'invalid parameter ' + name + ': ' + String(value));
+}
+
+export function parseStringParam(value: unknown, name: string): string {
+  const v = firstParam(value);
+  if (typeof v !== 'string') {
+    throw invalidParam(name, v);
+  }
+  return v;
+}
+
+export function parseBoolParam(value: unknown, name: string): boolean {
+  const v = firstParam(value);
+  if (v === true || v === 'true' || v === '1') {
+    return true;
+  }
+  if (v === false || v === 'false' || v === '0') {
+    return false;
+  }
+  throw invalidParam(name, v);
+}
+
+export function parseNumberParam(value: unknown, name: string): number {
+  const v = firstParam(value);
+  const n = typeof v === 'number' ? v : typeof v === 'string' && v.trim() !== '' ? Number(v) : NaN;
+  if (Number.isNaN(n)) {
+    throw invalidParam(name, v);
+  }
+  return n;
+}
+
+export function parseIntParam(value: unknown, name: string): number {
+  const n = parseNumberParam(value, name);
+  if (!Number.isSafeInteger(n)) {
+    throw invalidParam(name, firstParam(value));
+  }
+  return n;
+}
+
+export function parseBigIntParam(value: unknown, name: string): bigint {
+  return BigInt(parseI64StringParam(value, name));
+}
+
+export function parseI64StringParam(value: unknown, name: string): string {
+  const v = String(firstParam(value));
+  if (!/^-?\d+$/.test(v) || BigInt(v) < -(BigInt(1) << BigInt(63)) || BigInt(v) >= BigInt(1) << BigInt(63)) {
+    throw invalidParam(name, v);
+  }
+  return v;
+}
+
+/**
+ * 解析列表参数，同名参数重复出现时为数组，只出现一次时为单个值
+ */
+export function parseListParam<T>(value: unknown, parse: (v: unknown) => T): T[] {
+  return (Array.isArray(value) ? value : [value]).map((v) => parse(v));
+}
+
+/**
+ * 拆分请求头中以逗号连接的列表
+ */
+export function splitHeaderParam(value: unknown): unknown {
+  const v = firstParam(value);
+  return typeof v === 'string' ? v.split(',').map((s) => s.trim()) : v;
+}
+
+/**
+ * 解析以 JSON 字符串传递的复杂类型参数
+ */
+export function parseJSONParam(value: unknown, name: string): any {
+  const v = firstParam(value);
+  if (typeof v !== 'string') {
+    return v;
+  }
+  try {
+    return JSON.parse(v);
+  } catch {
+    throw invalidParam(name, v);
+  }
+}
+{{- end -}}
+`
@@ -29,16 +29,34 @@ const SimpleServiceImplementationTemplate = `
 
 {{- range .Services }}
 // 导入服务接口
-import type { I{{ GetInterfaceName .Name }} } from './{{ ToLower .Name }}';
-import axios from '@/utils/request';
-import { BizException } from "@/types/exception"
+import type { I{{ GetInterfaceName .Name }} } from '{{ GetServiceModulePath .Name }}';
+{{- if eq HttpTransport "axios" }}
+import { createAxiosTransport } from '{{ GetTransportImportPath }}';
+import axios from '{{ GetAxiosImportPath }}';
+{{- else }}
+import { createFetchTransport } from '{{ GetTransportImportPath }}';
+{{- end }}
+import type { HttpTransport } from '{{ GetTransportImportPath }}';
+import { BizException } from '{{ GetBizExceptionImportPath }}';
+{{- if JSONHelpers }}
+import { parseJSON, i64FromJSON, i64KeyFromJSON, i64ToJSON, doubleFromJSON, listFromJSON, setFromJSON, mapFromJSON, listToJSON, mapToJSON } from '{{ GetRuntimeImportPath }}';
+{{- end }}
 
 /**
- * {{ GetInterfaceName .Name }} Axios HTTP 客户端实现
+ * {{ GetInterfaceName .Name }} HTTP 客户端实现
  * 根据 Thrift 服务定义和 API 注解自动生成的 HTTP 请求实现
- * 支持使用项目中已实例化的 axios 实例
+ * 请求通过 HttpTransport 发送，未指定时使用{{ if eq HttpTransport "axios" }}项目中已实例化的 axios 实例{{ else }} fetch{{ end }}
+{{- if JSONHelpers }}
+ * i64 在请求中以字符串发送，响应需要用 parseJSON 解析以免丢失精度，自定义传输层时应传入 parse: parseJSON
+{{- end }}
  */
 export class {{ GetInterfaceName .Name }}Client implements I{{ GetInterfaceName .Name }} {
+  private readonly transport: HttpTransport;
+
+  constructor(transport?: HttpTransport) {
+    this.transport = transport || {{ if eq HttpTransport "axios" }}createAxiosTransport(axios{{ if JSONHelpers }}, { parse: parseJSON }{{ end }}){{ else }}createFetchTransport({{ if JSONHelpers }}{ parse: parseJSON }{{ end }}){{ end }};
+  }
+
 {{- range .Functions }}
   /**
    * {{ .Name }}
@@ -63,245 +81,17 @@ export class {{ GetInterfaceName .Name }}Client implements I{{ GetInterfaceName
 {{- end }}
    */
   async {{ GetPropertyNameWithStyle .Name }}(
-    {{ range $index, $arg := .Arguments }}{{ if $index }}, {{ end }}{{ GetPropertyNameWithStyle .Name }}{{ if and (IsOptional .) (not (IsStructField .)) }}?{{ end }}: {{ GetFieldType . }}{{ if and (IsStructField .) (IsStructEmptyOrAllFieldsOptional .) }} = {}{{ end }}{{ end }}
+    {{ $fn := . }}{{ range $index, $arg := .Arguments }}{{ if $index }}, {{ end }}{{ GetPropertyNameWithStyle .Name }}{{ if IsOptionalArgument $fn $index }}?{{ end }}: {{ GetFieldType . }}{{ if and (IsStructField .) (IsStructEmptyOrAllFieldsOptional .) }} = {}{{ end }}{{ end }}
   ): Promise<{{ if .FunctionType }}{{ GetTypeScriptType .FunctionType }}{{ else }}void{{ end }}> {
-    {{- $apiMethod := "" }}
-    {{- if .Annotations.Get "api.get" }}
-    {{- $apiMethod = "GET" }}
-    {{- else if .Annotations.Get "api.post" }}
-    {{- $apiMethod = "POST" }}
-    {{- else if .Annotations.Get "api.put" }}
-    {{- $apiMethod = "PUT" }}
-    {{- else if .Annotations.Get "api.delete" }}
-    {{- $apiMethod = "DELETE" }}
-    {{- else if .Annotations.Get "api.patch" }}
-    {{- $apiMethod = "PATCH" }}
-    {{- end }}
     try {
-      let url = '{{ if .Annotations.Get "api.get" }}{{ index (.Annotations.Get "api.get") 0 }}{{ else if .Annotations.Get "api.post" }}{{ index (.Annotations.Get "api.post") 0 }}{{ else if .Annotations.Get "api.put" }}{{ index (.Annotations.Get "api.put") 0 }}{{ else if .Annotations.Get "api.delete" }}{{ index (.Annotations.Get "api.delete") 0 }}{{ else if .Annotations.Get "api.patch" }}{{ index (.Annotations.Get "api.patch") 0 }}{{ end }}';
-{{- range $index, $arg := .Arguments }}
-{{- end }}
-
-{{- range $argIndex, $arg := .Arguments }}
-{{- if $arg.Annotations.Get "api.path" }}
-{{- $pathValue := index ($arg.Annotations.Get "api.path") 0 }}
-{{- if $pathValue }}
-      if ({{ GetPropertyNameWithStyle $arg.Name }} !== undefined && {{ GetPropertyNameWithStyle $arg.Name }} !== null) {
-        url = url.replace(String(':'+'{{ $pathValue }}'), String({{ GetPropertyNameWithStyle $arg.Name }}));
-      }
-{{- end }}
-{{- end }}
-{{- end }}
-
-{{- range $argIndex, $arg := .Arguments }}
-{{- if IsStructField $arg }}
-{{- $expandedFields := GetFieldExpandedFields $arg }}
-{{- if len $expandedFields | eq 0 }}
-{{- $structAnnotations := GetStructFieldAnnotationsForTemplate $arg }}
-{{- range $fieldName, $fieldAnnotations := $structAnnotations }}
-{{- if index $fieldAnnotations "api.path" }}
-{{- $pathValue := index $fieldAnnotations "api.path" }}
-{{- if $pathValue }}
-      if ({{ GetPropertyNameWithStyle $arg.Name }}.{{ GetPropertyNameWithStyle $fieldName }} !== undefined && {{ GetPropertyNameWithStyle $arg.Name }}.{{ GetPropertyNameWithStyle $fieldName }} !== null) {
-        url = url.replace(String(':'+'{{ $pathValue }}'), String({{ GetPropertyNameWithStyle $arg.Name }}.{{ GetPropertyNameWithStyle $fieldName }}));
-      }
-{{- end }}
-{{- end }}
-{{- end }}
-{{- end }}
-{{- if $expandedFields }}
-{{- range $expandedField := $expandedFields }}
-{{- if $expandedField.Annotations.Get "api.path" }}
-{{- $pathValue := index ($expandedField.Annotations.Get "api.path") 0 }}
-{{- if $pathValue }}
-      if ({{ GetPropertyNameWithStyle $expandedField.Name }} !== undefined && {{ GetPropertyNameWithStyle $expandedField.Name }} !== null) {
-        url = url.replace(String(':'+'{{ $pathValue }}'), String({{ GetPropertyNameWithStyle $expandedField.Name }}));
-      }
-{{- end }}
-{{- end }}
-{{- end }}
+{{- range $arg := .Arguments }}
+{{- with GetClientArgJSON $arg }}
+      {{ . }}
 {{- end }}
 {{- end }}
+{{- with GetClientRequest . }}
+{{ . }}
 {{- end }}
-
-{{- range $argIndex, $arg := .Arguments }}
-      {{- if not (IsStructField $arg) }}
-      if (url.includes(':'+'{{ GetPropertyNameWithStyle $arg.Name }}')) {
-        url = url.replace(String(':'+'{{ GetPropertyNameWithStyle $arg.Name }}'), String({{ GetPropertyNameWithStyle $arg.Name }}));
-      }
-      {{- end }}
-{{- end }}
-
-      let queryParams: any = {};
-
-{{- range $argIndex, $arg := .Arguments }}
-{{- if $arg.Annotations.Get "api.query" }}
-{{- $queryValue := index ($arg.Annotations.Get "api.query") 0 }}
-{{- if $queryValue }}
-      if ({{ GetPropertyNameWithStyle $arg.Name }} !== undefined && {{ GetPropertyNameWithStyle $arg.Name }} !== null) {
-        queryParams['{{ $queryValue }}'] = {{ GetPropertyNameWithStyle $arg.Name }};
-      }
-{{- end }}
-{{- end }}
-{{- end }}
-
-{{- range $argIndex, $arg := .Arguments }}
-{{- if IsStructField $arg }}
-{{- $structFields := GetStructFields $arg }}
-{{- range $structField := $structFields }}
-{{- $expandedFields := GetFieldExpandedFields $structField }}
-{{- if len $expandedFields | eq 0 }}
-{{- if $structField.Annotations.Get "api.query" }}
-{{- $queryValue := index ($structField.Annotations.Get "api.query") 0 }}
-{{- if $queryValue }}
-      if ({{ GetPropertyNameWithStyle $arg.Name }}.{{ GetPropertyNameWithStyle $structField.Name }} !== undefined && {{ GetPropertyNameWithStyle $arg.Name }}.{{ GetPropertyNameWithStyle $structField.Name }} !== null) {
-        queryParams['{{ $queryValue }}'] = {{ GetPropertyNameWithStyle $arg.Name }}.{{ GetPropertyNameWithStyle $structField.Name }};
-      }
-{{- end }}
-{{- end }}
-{{- else }}
-{{- range $expandedField := $expandedFields }}
-{{- if $expandedField.Annotations.Get "api.query" }}
-{{- $queryValue := index ($expandedField.Annotations.Get "api.query") 0 }}
-{{- if $queryValue }}
-      if ({{ GetPropertyNameWithStyle $arg.Name }}.{{ GetPropertyNameWithStyle $expandedField.Name }} !== undefined && {{ GetPropertyNameWithStyle $arg.Name }}.{{ GetPropertyNameWithStyle $expandedField.Name }} !== null) {
-        queryParams['{{ $queryValue }}'] = {{ GetPropertyNameWithStyle $arg.Name }}.{{ GetPropertyNameWithStyle $expandedField.Name }};
-      }
-{{- end }}
-{{- end }}
-{{- end }}
-{{- end }}
-{{- end }}
-{{- end }}
-{{- end }}
-
-      {{- if or (eq $apiMethod "POST") (eq $apiMethod "PUT") (eq $apiMethod "PATCH") }}
-      let bodyParam : any = {};
-      
-      {{- range $argIndex, $arg := .Arguments }}
-      {{- $isBodyParam := false }}
-      {{- if $arg.Annotations.Get "api.body" }}
-      {{- $isBodyParam = true }}
-      {{- else if and (IsStructField $arg) (not ($arg.Annotations.Get "api.path")) (not ($arg.Annotations.Get "api.query")) }}
-      {{- $isBodyParam = true }}
-      {{- end }}
-      {{- if and (IsStructField $arg) $isBodyParam }}
-      {{- $structFields := GetStructFields $arg }}
-      {{- range $structField := $structFields }}
-      {{- $expandedFields := GetFieldExpandedFields $structField }}
-      {{- if not $expandedFields }}
-      {{- if not ($structField.Annotations.Get "api.query") }}
-      {{- if not ($structField.Annotations.Get "api.path") }}
-      if ({{ GetPropertyNameWithStyle $arg.Name }}.{{ GetPropertyNameWithStyle $structField.Name }} !== undefined && {{ GetPropertyNameWithStyle $arg.Name }}.{{ GetPropertyNameWithStyle $structField.Name }} !== null) {
-        bodyParam['{{ GetPropertyNameWithStyle $structField.Name }}'] = {{ GetPropertyNameWithStyle $arg.Name }}.{{ GetPropertyNameWithStyle $structField.Name }};
-      }
-      {{- end }}
-      {{- end }}
-      {{- else }}
-      {{- range $expandedField := $expandedFields }}
-      {{- if not ($expandedField.Annotations.Get "api.query") }}
-      {{- if not ($expandedField.Annotations.Get "api.path") }}
-      if ({{ GetPropertyNameWithStyle $arg.Name }}.{{ GetPropertyNameWithStyle $expandedField.Name }} !== undefined) {
-        bodyParam['{{ GetPropertyNameWithStyle $expandedField.Name }}'] = {{ GetPropertyNameWithStyle $arg.Name }}.{{ GetPropertyNameWithStyle $expandedField.Name }};
-      }
-      {{- end }}
-      {{- end }}
-      {{- end }}
-      {{- end }}
-      {{- end }}
-      {{- end }}
-      {{- end }}
-      {{- end }}
-
-      {{- if eq $apiMethod "GET" }}
-      {{- range $argIndex, $arg := .Arguments }}
-      {{- if IsStructField $arg }}
-      {{- if and (not ($arg.Annotations.Get "api.path")) (not ($arg.Annotations.Get "api.query")) (not ($arg.Annotations.Get "api.body")) }}
-      {{- $structFields := GetStructFields $arg }}
-      {{- range $structField := $structFields }}
-      {{- $expandedFields := GetFieldExpandedFields $structField }}
-      {{- if len $expandedFields | eq 0 }}
-      {{- if not ($structField.Annotations.Get "api.query") }}
-      {{- if not ($structField.Annotations.Get "api.path") }}
-      if ({{ GetPropertyNameWithStyle $arg.Name }}.{{ GetPropertyNameWithStyle $structField.Name }} !== undefined && {{ GetPropertyNameWithStyle $arg.Name }}.{{ GetPropertyNameWithStyle $structField.Name }} !== null) {
-        queryParams['{{ GetPropertyNameWithStyle $structField.Name }}'] = {{ GetPropertyNameWithStyle $arg.Name }}.{{ GetPropertyNameWithStyle $structField.Name }};
-      }
-      {{- end }}
-      {{- end }}
-      {{- else }}
-      {{- range $expandedField := $expandedFields }}
-      {{- if not ($expandedField.Annotations.Get "api.query") }}
-      {{- if not ($expandedField.Annotations.Get "api.path") }}
-      if ({{ GetPropertyNameWithStyle $arg.Name }}.{{ GetPropertyNameWithStyle $expandedField.Name }} !== undefined && {{ GetPropertyNameWithStyle $arg.Name }}.{{ GetPropertyNameWithStyle $expandedField.Name }} !== null) {
-        queryParams['{{ GetPropertyNameWithStyle $expandedField.Name }}'] = {{ GetPropertyNameWithStyle $arg.Name }}.{{ GetPropertyNameWithStyle $expandedField.Name }};
-      }
-      {{- end }}
-      {{- end }}
-      {{- end }}
-      {{- end }}
-      {{- end }}
-      {{- end }}
-      {{- else if not (IsStructField $arg) }}
-      {{- if and (not ($arg.Annotations.Get "api.path")) (not ($arg.Annotations.Get "api.query")) (not ($arg.Annotations.Get "api.body")) }}
- 	  if ({{ GetPropertyNameWithStyle $arg.Name }} !== undefined && {{ GetPropertyNameWithStyle $arg.Name }} !== null && !url.includes(':'+'{{ GetPropertyNameWithStyle $arg.Name }}')) {
-        queryParams['{{ GetPropertyNameWithStyle $arg.Name }}'] = {{ GetPropertyNameWithStyle $arg.Name }};
-      }
-      {{- end }}
-      {{- end }}
-      {{- end }}
-      {{- end }}
-      
-      {{- if eq $apiMethod "GET" }}
-      let response = await axios.get(url, { params: queryParams });
-      if (response.status >= 200 && response.status < 300) {
-     	if (response.data.code !== 0) {
-          throw new BizException(response.data.code, response.data.msg);
-        }
-        return response.data;
-      } else {
-        throw new Error(` + "`" + `HTTP ${response.status}: ${response.statusText}` + "`" + `);
-      }
-      {{- else if eq $apiMethod "POST" }}
-      let response = await axios.post(url, bodyParam, { params: queryParams });
-      if (response.status >= 200 && response.status < 300) {
-     	if (response.data.code !== 0) {
-          throw new BizException(response.data.code, response.data.msg);
-        }
-        return response.data;
-      } else {
-        throw new Error(` + "`" + `HTTP ${response.status}: ${response.statusText}` + "`" + `);
-      }
-      {{- else if eq $apiMethod "PUT" }}
-      let response = await axios.put(url, bodyParam, { params: queryParams });
-      if (response.status >= 200 && response.status < 300) {
-     	if (response.data.code !== 0) {
-          throw new BizException(response.data.code, response.data.msg);
-        }
-        return response.data;
-      } else {
-        throw new Error(` + "`" + `HTTP ${response.status}: ${response.statusText}` + "`" + `);
-      }
-      {{- else if eq $apiMethod "DELETE" }}
-      let response = await axios.delete(url, { params: queryParams });
-      if (response.status >= 200 && response.status < 300) {
-     	if (response.data.code !== 0) {
-          throw new BizException(response.data.code, response.data.msg);
-        }
-        return response.data;
-      } else {
-        throw new Error(` + "`" + `HTTP ${response.status}: ${response.statusText}` + "`" + `);
-      }
-      {{- else if eq $apiMethod "PATCH" }}
-      let response = await axios.patch(url, bodyParam, { params: queryParams });
-      if (response.status >= 200 && response.status < 300) {
-     	if (response.data.code !== 0) {
-          throw new BizException(response.data.code, response.data.msg);
-        }
-        return response.data;
-      } else {
-        throw new Error(` + "`" + `HTTP ${response.status}: ${response.statusText}` + "`" + `);
-      }
-      {{- end }}
     } catch (error) {
       console.error('{{ .Name }} request failed:', error);
       throw error;
@@ -17,8 +17,9 @@ package templates
 // 单独枚举文件模板
 const SingleEnumTemplate = `
 {{- define "singleEnum" -}}
-"use strict";
+{{ if not Declarations }}"use strict";
 
+{{ end -}}
 // Generated by thriftgo {{Version}}
 // DO NOT EDIT UNLESS YOU ARE SURE THAT YOU KNOW WHAT YOU ARE DOING
 
@@ -26,6 +27,14 @@ const SingleEnumTemplate = `
 {{ template "imports" . }}
 {{- end }}
 
+{{- if ZodSchemas }}
+{{ template "zodImports" . }}
+{{- end }}
+
+{{- if Mocks }}
+{{ template "mockImports" . }}
+{{- end }}
+
 {{- range .Enums }}
 {{ template "enum" . }}
 {{- end }}
@@ -17,8 +17,9 @@ package templates
 // 单独服务文件模板
 const SingleServiceTemplate = `
 {{- define "singleService" -}}
-"use strict";
+{{ if not Declarations }}"use strict";
 
+{{ end -}}
 // Generated by thriftgo {{Version}}
 // DO NOT EDIT UNLESS YOU ARE SURE THAT YOU KNOW WHAT YOU ARE DOING
 
@@ -17,13 +17,27 @@ package templates
 // 单独结构体文件模板
 const SingleStructTemplate = `
 {{- define "singleStruct" -}}
-"use strict";
+{{ if not Declarations }}"use strict";
 
+{{ end -}}
 // Generated by thriftgo {{Version}}
 // DO NOT EDIT UNLESS YOU ARE SURE THAT YOU KNOW WHAT YOU ARE DOING
 
 {{- if .Imports }}
 {{ template "imports" . }}
+{{- if Validators }}{{ template "validatorImports" . }}{{ end }}
+{{- end }}
+
+{{- if ZodSchemas }}
+{{ template "zodImports" . }}
+{{- end }}
+
+{{- if Mocks }}
+{{ template "mockImports" . }}
+{{- end }}
+
+{{- if NeedsRuntime }}
+{{ template "codecImports" . }}
 {{- end }}
 
 {{- range .Structs }}
@@ -44,5 +44,17 @@ export interface {{ GetInterfaceName .Name }} {
 {{- end }}
 {{- end }}
 }
+{{- if HasValueObject }}
+{{ template "codec" . }}
+{{- end }}
+{{- if Validators }}
+{{ template "validator" . }}
+{{- end }}
+{{- if ZodSchemas }}
+{{ template "zodSchema" . }}
+{{- end }}
+{{- if Mocks }}
+{{ template "mock" . }}
+{{- end }}
 {{- end -}}
 `
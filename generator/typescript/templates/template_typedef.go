@@ -18,5 +18,14 @@ package templates
 const TypedefTemplate = `
 {{- define "typedef" -}}
 export type {{ GetInterfaceName .Alias }} = {{ GetTypeScriptType .Type }};
+{{- if Validators }}
+{{ template "typedefValidator" . }}
+{{- end }}
+{{- if ZodSchemas }}
+{{ template "typedefZodSchema" . }}
+{{- end }}
+{{- if Mocks }}
+{{ template "typedefMock" . }}
+{{- end }}
 {{- end -}}
 `
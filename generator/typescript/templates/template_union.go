@@ -22,5 +22,17 @@ export interface {{ GetInterfaceName .Name }} {
   {{ GetPropertyNameWithStyle .Name }}{{ if IsOptional . }}?{{ end }}: {{ GetFieldType . }};
 {{- end }}
 }
+{{- if HasValueObject }}
+{{ template "codec" . }}
+{{- end }}
+{{- if Validators }}
+{{ template "validator" . }}
+{{- end }}
+{{- if ZodSchemas }}
+{{ template "zodSchema" . }}
+{{- end }}
+{{- if Mocks }}
+{{ template "mock" . }}
+{{- end }}
 {{- end -}}
 `
@@ -0,0 +1,54 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package templates
+
+// 校验函数模板，为每个类型生成 validate<Type>(value: unknown): value is Type 类型守卫
+const ValidatorTemplate = `
+{{- define "validatorImports" -}}
+{{- range .Imports }}
+import { {{ range $index, $type := .Types }}{{ if $index }}, {{ end }}{{ GetValidatorName $type }}{{ end }} } from '{{ .Path }}';
+{{- end }}
+{{- end -}}
+
+{{- define "validator" -}}
+{{- $name := GetInterfaceName .Name }}
+/**
+ * 校验 value 是否为合法的 {{ $name }}
+ */
+export function {{ GetValidatorName .Name }}(value: unknown): value is {{ $name }} {
+{{ GetValidatorBody . }}
+}
+{{- end -}}
+
+{{- define "enumValidator" -}}
+{{- $name := GetEnumName .Name }}
+/**
+ * 校验 value 是否为合法的 {{ $name }}
+ */
+export function {{ GetValidatorName .Name }}(value: unknown): value is {{ $name }} {
+  return typeof value === 'number' && {{ $name }}[value] !== undefined;
+}
+{{- end -}}
+
+{{- define "typedefValidator" -}}
+{{- $name := GetInterfaceName .Alias }}
+/**
+ * 校验 value 是否为合法的 {{ $name }}
+ */
+export function {{ GetValidatorName .Alias }}(value: unknown): value is {{ $name }} {
+  return {{ GetTypedefValidatorCheck . }};
+}
+{{- end -}}
+`
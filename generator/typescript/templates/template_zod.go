@@ -0,0 +1,58 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package templates
+
+// Zod schema 模板，为每个类型生成 <Type>Schema 及推导出的 <Type>Inferred 类型，
+// 递归引用的结构体无法推导类型，<Type>Inferred 直接使用接口
+const ZodTemplate = `
+{{- define "zodImports" -}}
+import { z } from '{{ GetZodImportPath }}';
+{{- range .Imports }}
+import { {{ range $index, $type := .Types }}{{ if $index }}, {{ end }}{{ GetZodSchemaName $type }}{{ end }} } from '{{ .Path }}';
+{{- end }}
+{{- end -}}
+
+{{- define "zodSchema" -}}
+{{- $name := GetInterfaceName .Name }}
+/**
+ * {{ $name }} 的 Zod schema
+ */
+{{- if IsRecursiveStruct . }}
+export const {{ GetZodSchemaName .Name }}: z.ZodTypeAny = {{ GetZodSchema . }};
+export type {{ $name }}Inferred = {{ $name }};
+{{- else }}
+export const {{ GetZodSchemaName .Name }} = {{ GetZodSchema . }};
+export type {{ $name }}Inferred = z.infer<typeof {{ GetZodSchemaName .Name }}>;
+{{- end }}
+{{- end -}}
+
+{{- define "enumZodSchema" -}}
+{{- $name := GetEnumName .Name }}
+/**
+ * {{ $name }} 的 Zod schema
+ */
+export const {{ GetZodSchemaName .Name }} = z.nativeEnum({{ $name }});
+export type {{ $name }}Inferred = z.infer<typeof {{ GetZodSchemaName .Name }}>;
+{{- end -}}
+
+{{- define "typedefZodSchema" -}}
+{{- $name := GetInterfaceName .Alias }}
+/**
+ * {{ $name }} 的 Zod schema
+ */
+export const {{ GetZodSchemaName .Alias }} = {{ GetTypedefZodSchema . }};
+export type {{ $name }}Inferred = z.infer<typeof {{ GetZodSchemaName .Alias }}>;
+{{- end -}}
+`
@@ -0,0 +1,22 @@
+"use strict";
+
+
+// Generated by thriftgo ?
+// DO NOT EDIT UNLESS YOU ARE SURE THAT YOU KNOW WHAT YOU ARE DOING
+
+
+/**
+ * 分页请求结构体
+ */
+export interface PageReq {
+
+/**
+ * 页码
+ */
+  pageNum?: number;
+
+/**
+ * 每页大小
+ */
+  pageSize?: number;
+}
\ No newline at end of file
@@ -0,0 +1,56 @@
+"use strict";
+
+
+// Generated by thriftgo ?
+// DO NOT EDIT UNLESS YOU ARE SURE THAT YOU KNOW WHAT YOU ARE DOING
+
+import type { Address } from '../../test_codec';
+
+export enum OrderStatus {
+  PENDING = 1,
+  PAID = 2,
+}
+
+export interface ListOrdersReq {
+  userId: number;
+  page?: number | undefined;
+  statuses?: Array<OrderStatus> | undefined;
+  withItems?: boolean | undefined;
+  traceId?: string | undefined;
+}
+
+export interface Order {
+  orderId: number;
+  status: OrderStatus;
+  amount?: number | undefined;
+  tags?: Set<string> | undefined;
+  address?: Address | undefined;
+}
+
+export interface ListOrdersResp {
+  orders?: Array<Order>;
+  total?: number;
+}
+
+export interface CreateOrderReq {
+  shopId: string;
+  order: Order;
+  remark?: string | undefined;
+}
+
+/**
+ * IOrderService 异步接口
+ * 用于 Axios 客户端等异步实现
+ */
+export interface IOrderService {
+  listOrders(req: ListOrdersReq): Promise<ListOrdersResp>;
+  createOrder(req: CreateOrderReq): Promise<Order>;
+  getOrder(orderId: number, token: string): Promise<Order>;
+  updateRemark(orderId: number, remark: string): Promise<any>;
+  cancelOrder(orderId: number, reason: string): Promise<any>;
+  ping(): Promise<any>;
+}
+export interface OrderNotFound {
+  orderId: number;
+  message?: string | undefined;
+}
\ No newline at end of file
@@ -0,0 +1,44 @@
+"use strict";
+
+/* tslint:disable */
+/* eslint-disable */
+
+// Generated by thriftgo ?
+// DO NOT EDIT UNLESS YOU ARE SURE THAT YOU KNOW WHAT YOU ARE DOING
+
+import { Address, Contact, NotFound, Tracking, User } from './test_server';
+// 导入服务接口
+import type { IUserService } from './test_server';
+import { createFetchTransport } from './http_transport';
+import type { HttpTransport } from './http_transport';
+import { BizException } from './http_transport';
+import { parseJSON, i64FromJSON, i64KeyFromJSON, i64ToJSON, doubleFromJSON, listFromJSON, setFromJSON, mapFromJSON, listToJSON, mapToJSON } from './thrift_runtime';
+
+/**
+ * UserService HTTP 客户端实现
+ * 根据 Thrift 服务定义和 API 注解自动生成的 HTTP 请求实现
+ * 请求通过 HttpTransport 发送，未指定时使用 fetch
+ * i64 在请求中以字符串发送，响应需要用 parseJSON 解析以免丢失精度，自定义传输层时应传入 parse: parseJSON
+ */
+export class UserServiceClient implements IUserService {
+  private readonly transport: HttpTransport;
+
+  constructor(transport?: HttpTransport) {
+    this.transport = transport || createFetchTransport({ parse: parseJSON });
+  }
+  /**
+   * GetUser
+   * @param id id
+   * @returns User
+   */
+  async getUser(
+    id?: bigint
+  ): Promise<User> {
+    try {
+      const idJSON: any = id === undefined || id === null ? id : i64ToJSON(id);
+    } catch (error) {
+      console.error('GetUser request failed:', error);
+      throw error;
+    }
+  }
+}
\ No newline at end of file
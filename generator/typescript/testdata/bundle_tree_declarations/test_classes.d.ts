@@ -0,0 +1,65 @@
+// Generated by thriftgo ?
+// DO NOT EDIT UNLESS YOU ARE SURE THAT YOU KNOW WHAT YOU ARE DOING
+
+
+/**
+ * 分页请求结构体
+ */
+export interface PageReq {
+
+/**
+ * 页码
+ */
+  pageNum?: number;
+
+/**
+ * 每页大小
+ */
+  pageSize?: number;
+}
+
+export declare enum Level {
+  LOW = 1,
+  HIGH = 2,
+}
+
+export interface Point {
+  x?: number;
+  y?: number;
+}
+
+export interface Settings {
+  corner?: Point | undefined;
+  path?: Array<Point>;
+  level?: Level;
+  tags?: Set<string>;
+  ranges?: { [key: string]: Array<number> };
+  ratio?: number;
+  enabled?: boolean;
+  maxId?: number;
+
+/**
+ * 页码
+ */
+  pageNum?: number;
+
+/**
+ * 每页大小
+ */
+  pageSize?: number;
+}
+export declare const DEFAULT_PAGE_SIZE: number;
+export declare const DEFAULT_LEVEL: Level;
+export declare const DEFAULT_TAGS: Array<string>;
+export declare const LIMITS: { [key: string]: number };
+export declare const CODES: { [key: number]: string };
+export declare const MAX_ID: number;
+export declare const ORIGIN: Point;
+export interface Target {
+  id?: number | undefined;
+  name?: string | undefined;
+}
+export interface SettingsError {
+  message?: string;
+  code?: number;
+}
\ No newline at end of file
@@ -0,0 +1,12 @@
+// Generated by thriftgo ?
+// DO NOT EDIT UNLESS YOU ARE SURE THAT YOU KNOW WHAT YOU ARE DOING
+
+import type { OrderStatus } from './orderstatus';
+
+export interface ListOrdersReq {
+  userId: number;
+  page?: number | undefined;
+  statuses?: Array<OrderStatus> | undefined;
+  withItems?: boolean | undefined;
+  traceId?: string | undefined;
+}
\ No newline at end of file
@@ -0,0 +1,7 @@
+// Generated by thriftgo ?
+// DO NOT EDIT UNLESS YOU ARE SURE THAT YOU KNOW WHAT YOU ARE DOING
+
+export interface OrderNotFound {
+  orderId: number;
+  message?: string | undefined;
+}
\ No newline at end of file
@@ -0,0 +1,71 @@
+// Generated by thriftgo ?
+// DO NOT EDIT UNLESS YOU ARE SURE THAT YOU KNOW WHAT YOU ARE DOING
+
+export declare enum Gender {
+  UNKNOWN = 0,
+  MALE = 1,
+  FEMALE = 2,
+}
+
+export interface Address {
+  city: string;
+  street?: string | undefined;
+}
+
+export interface Tracking {
+  traceId?: string | undefined;
+}
+
+
+/**
+ * 覆盖所有 Thrift 类型的结构体
+ */
+export interface User {
+  id: number;
+  name: string;
+  active?: boolean | undefined;
+  level?: number | undefined;
+  rank?: number | undefined;
+  age?: number | undefined;
+  score?: number | undefined;
+  avatar?: Uint8Array | undefined;
+  gender?: Gender | undefined;
+  tags?: Array<string> | undefined;
+  roles?: Set<number> | undefined;
+  counters?: { [key: string]: number } | undefined;
+  history?: { [key: number]: Array<Address> } | undefined;
+  address?: Address | undefined;
+  contact?: Contact | undefined;
+  friends?: Array<any> | undefined;
+  location?: Location | undefined;
+  nested?: Array<{ [key: string]: Set<boolean> }>;
+  traceId?: string | undefined;
+
+/**
+ * 页码
+ */
+  pageNum?: number;
+
+/**
+ * 每页大小
+ */
+  pageSize?: number;
+}
+
+/**
+ * IUserService 异步接口
+ * 用于 Axios 客户端等异步实现
+ */
+export interface IUserService {
+  getUser(id: number): Promise<User>;
+}
+export type IdList = Array<number>;
+export type Location = Address;
+export interface Contact {
+  email?: string | undefined;
+  phone?: string | undefined;
+}
+export interface NotFound {
+  code: number;
+  message?: string | undefined;
+}
\ No newline at end of file
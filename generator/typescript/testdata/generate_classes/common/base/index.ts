@@ -0,0 +1,5 @@
+"use strict";
+
+// Generated by thriftgo ?
+// DO NOT EDIT UNLESS YOU ARE SURE THAT YOU KNOW WHAT YOU ARE DOING
+export { PageReq } from './pagereq';
\ No newline at end of file
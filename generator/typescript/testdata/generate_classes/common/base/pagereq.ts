@@ -0,0 +1,32 @@
+"use strict";
+
+// Generated by thriftgo ?
+// DO NOT EDIT UNLESS YOU ARE SURE THAT YOU KNOW WHAT YOU ARE DOING
+
+
+/**
+ * 分页请求结构体
+ */
+export interface PageReq {
+
+/**
+ * 页码
+ */
+  pageNum?: number;
+
+/**
+ * 每页大小
+ */
+  pageSize?: number;
+}
+
+/**
+ * PageReq 的类，构造时先设置 IDL 中的默认值，再合并传入的属性
+ */
+export class PageReq {
+  constructor(init?: Partial<PageReq>) {
+    if (init) {
+      Object.assign(this, init);
+    }
+  }
+}
\ No newline at end of file
@@ -0,0 +1,15 @@
+"use strict";
+
+// Generated by thriftgo ?
+// DO NOT EDIT UNLESS YOU ARE SURE THAT YOU KNOW WHAT YOU ARE DOING
+
+import { Level } from './level';
+import { Point } from './point';
+
+export const DEFAULT_PAGE_SIZE: number = 20;
+export const DEFAULT_LEVEL: Level = 2;
+export const DEFAULT_TAGS: Array<string> = ["a", "b"];
+export const LIMITS: { [key: string]: number } = { "page": 20, "max": 100 };
+export const CODES: { [key: number]: string } = { [-1]: "unknown", 0: "ok" };
+export const MAX_ID: number = 9007199254740993;
+export const ORIGIN: Point = { x: 0, y: 0 };
\ No newline at end of file
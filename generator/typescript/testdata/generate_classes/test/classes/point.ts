@@ -0,0 +1,22 @@
+"use strict";
+
+// Generated by thriftgo ?
+// DO NOT EDIT UNLESS YOU ARE SURE THAT YOU KNOW WHAT YOU ARE DOING
+
+export interface Point {
+  x?: number;
+  y?: number;
+}
+
+/**
+ * Point 的类，构造时先设置 IDL 中的默认值，再合并传入的属性
+ */
+export class Point {
+  constructor(init?: Partial<Point>) {
+    this.x = 0;
+    this.y = 20;
+    if (init) {
+      Object.assign(this, init);
+    }
+  }
+}
\ No newline at end of file
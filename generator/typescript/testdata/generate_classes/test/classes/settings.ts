@@ -0,0 +1,48 @@
+"use strict";
+
+// Generated by thriftgo ?
+// DO NOT EDIT UNLESS YOU ARE SURE THAT YOU KNOW WHAT YOU ARE DOING
+
+import { PageReq } from '../../common/base';
+import { Level } from './level';
+import { Point } from './point';
+
+export interface Settings {
+  corner?: Point | undefined;
+  path?: Array<Point>;
+  level?: Level;
+  tags?: Set<string>;
+  ranges?: { [key: string]: Array<number> };
+  ratio?: number;
+  enabled?: boolean;
+  maxId?: number;
+
+/**
+ * 页码
+ */
+  pageNum?: number;
+
+/**
+ * 每页大小
+ */
+  pageSize?: number;
+}
+
+/**
+ * Settings 的类，构造时先设置 IDL 中的默认值，再合并传入的属性
+ */
+export class Settings {
+  constructor(init?: Partial<Settings>) {
+    this.corner = new Point({ x: 1 });
+    this.path = [new Point({ x: 1, y: 2 }), new Point({ x: 0, y: 0 })];
+    this.level = 2;
+    this.tags = new Set(["a", "b"]);
+    this.ranges = { "page": [1, 20] };
+    this.ratio = 1.5;
+    this.enabled = true;
+    this.maxId = 9007199254740993;
+    if (init) {
+      Object.assign(this, init);
+    }
+  }
+}
\ No newline at end of file
@@ -0,0 +1,22 @@
+"use strict";
+
+// Generated by thriftgo ?
+// DO NOT EDIT UNLESS YOU ARE SURE THAT YOU KNOW WHAT YOU ARE DOING
+
+export interface SettingsError {
+  message?: string;
+  code?: number;
+}
+
+/**
+ * SettingsError 的类，构造时先设置 IDL 中的默认值，再合并传入的属性
+ */
+export class SettingsError {
+  constructor(init?: Partial<SettingsError>) {
+    this.message = "invalid settings";
+    this.code = 400;
+    if (init) {
+      Object.assign(this, init);
+    }
+  }
+}
\ No newline at end of file
@@ -0,0 +1,20 @@
+"use strict";
+
+// Generated by thriftgo ?
+// DO NOT EDIT UNLESS YOU ARE SURE THAT YOU KNOW WHAT YOU ARE DOING
+
+export interface Target {
+  id?: number | undefined;
+  name?: string | undefined;
+}
+
+/**
+ * Target 的类，构造时先设置 IDL 中的默认值，再合并传入的属性
+ */
+export class Target {
+  constructor(init?: Partial<Target>) {
+    if (init) {
+      Object.assign(this, init);
+    }
+  }
+}
\ No newline at end of file
@@ -0,0 +1,8 @@
+"use strict";
+
+// Generated by thriftgo ?
+// DO NOT EDIT UNLESS YOU ARE SURE THAT YOU KNOW WHAT YOU ARE DOING
+export { Level } from './level';
+export { Point } from './point';
+export { Settings } from './settings';
+export * from './constants';
\ No newline at end of file
@@ -0,0 +1,9 @@
+"use strict";
+
+// Generated by thriftgo ?
+// DO NOT EDIT UNLESS YOU ARE SURE THAT YOU KNOW WHAT YOU ARE DOING
+
+export enum Level {
+  LOW = 1,
+  HIGH = 2,
+}
\ No newline at end of file
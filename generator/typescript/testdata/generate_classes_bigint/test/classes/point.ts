@@ -0,0 +1,45 @@
+"use strict";
+
+// Generated by thriftgo ?
+// DO NOT EDIT UNLESS YOU ARE SURE THAT YOU KNOW WHAT YOU ARE DOING
+import { i64FromJSON, i64KeyFromJSON, i64ToJSON, doubleFromJSON, listFromJSON, setFromJSON, mapFromJSON, listToJSON, mapToJSON } from '../../thrift_runtime';
+
+export interface Point {
+  x?: number;
+  y?: number;
+}
+
+/**
+ * Point 的类，构造时先设置 IDL 中的默认值，再合并传入的属性
+ */
+export class Point {
+  constructor(init?: Partial<Point>) {
+    this.x = 0;
+    this.y = 20;
+    if (init) {
+      Object.assign(this, init);
+    }
+  }
+
+  static fromJSON(json: any): Point {
+    const value: any = {};
+    if (json.x !== undefined && json.x !== null) {
+      value.x = json.x;
+    }
+    if (json.y !== undefined && json.y !== null) {
+      value.y = json.y;
+    }
+    return new Point(value);
+  }
+
+  static toJSON(value: Point): any {
+    const json: any = {};
+    if (value.x !== undefined && value.x !== null) {
+      json.x = value.x;
+    }
+    if (value.y !== undefined && value.y !== null) {
+      json.y = value.y;
+    }
+    return json;
+  }
+}
\ No newline at end of file
@@ -0,0 +1,109 @@
+"use strict";
+
+// Generated by thriftgo ?
+// DO NOT EDIT UNLESS YOU ARE SURE THAT YOU KNOW WHAT YOU ARE DOING
+
+import { PageReq } from '../../common/base';
+import { Level } from './level';
+import { Point } from './point';
+import { i64FromJSON, i64KeyFromJSON, i64ToJSON, doubleFromJSON, listFromJSON, setFromJSON, mapFromJSON, listToJSON, mapToJSON } from '../../thrift_runtime';
+
+export interface Settings {
+  corner?: Point | undefined;
+  path?: Array<Point>;
+  level?: Level;
+  tags?: Set<string>;
+  ranges?: { [key: string]: Array<number> };
+  ratio?: number;
+  enabled?: boolean;
+  maxId?: bigint;
+
+/**
+ * 页码
+ */
+  pageNum?: number;
+
+/**
+ * 每页大小
+ */
+  pageSize?: number;
+}
+
+/**
+ * Settings 的类，构造时先设置 IDL 中的默认值，再合并传入的属性
+ */
+export class Settings {
+  constructor(init?: Partial<Settings>) {
+    this.corner = new Point({ x: 1 });
+    this.path = [new Point({ x: 1, y: 2 }), new Point({ x: 0, y: 0 })];
+    this.level = 2;
+    this.tags = new Set(["a", "b"]);
+    this.ranges = { "page": [1, 20] };
+    this.ratio = 1.5;
+    this.enabled = true;
+    this.maxId = BigInt('9007199254740993');
+    if (init) {
+      Object.assign(this, init);
+    }
+  }
+
+  static fromJSON(json: any): Settings {
+    const value: any = {};
+    if (json.corner !== undefined && json.corner !== null) {
+      value.corner = Point.fromJSON(json.corner);
+    }
+    if (json.path !== undefined && json.path !== null) {
+      value.path = listFromJSON(json.path, (v0: any) => Point.fromJSON(v0));
+    }
+    if (json.level !== undefined && json.level !== null) {
+      value.level = json.level;
+    }
+    if (json.tags !== undefined && json.tags !== null) {
+      value.tags = setFromJSON(json.tags);
+    }
+    if (json.ranges !== undefined && json.ranges !== null) {
+      value.ranges = mapFromJSON(json.ranges, undefined, (v0: any) => listFromJSON(v0));
+    }
+    if (json.ratio !== undefined && json.ratio !== null) {
+      value.ratio = doubleFromJSON(json.ratio);
+    }
+    if (json.enabled !== undefined && json.enabled !== null) {
+      value.enabled = json.enabled;
+    }
+    if (json.maxId !== undefined && json.maxId !== null) {
+      value.maxId = i64FromJSON(json.maxId);
+    }
+    Object.assign(value, PageReq.fromJSON(json));
+    return new Settings(value);
+  }
+
+  static toJSON(value: Settings): any {
+    const json: any = {};
+    if (value.corner !== undefined && value.corner !== null) {
+      json.corner = Point.toJSON(value.corner);
+    }
+    if (value.path !== undefined && value.path !== null) {
+      json.path = listToJSON(value.path, (v0: any) => Point.toJSON(v0));
+    }
+    if (value.level !== undefined && value.level !== null) {
+      json.level = value.level;
+    }
+    if (value.tags !== undefined && value.tags !== null) {
+      json.tags = listToJSON(value.tags);
+    }
+    if (value.ranges !== undefined && value.ranges !== null) {
+      json.ranges = value.ranges;
+    }
+    if (value.ratio !== undefined && value.ratio !== null) {
+      json.ratio = value.ratio;
+    }
+    if (value.enabled !== undefined && value.enabled !== null) {
+      json.enabled = value.enabled;
+    }
+    if (value.maxId !== undefined && value.maxId !== null) {
+      json.maxId = i64ToJSON(value.maxId);
+    }
+    Object.assign(json, PageReq.toJSON(value as any));
+    return json;
+  }
+}
\ No newline at end of file
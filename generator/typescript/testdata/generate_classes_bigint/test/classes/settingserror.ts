@@ -0,0 +1,45 @@
+"use strict";
+
+// Generated by thriftgo ?
+// DO NOT EDIT UNLESS YOU ARE SURE THAT YOU KNOW WHAT YOU ARE DOING
+import { i64FromJSON, i64KeyFromJSON, i64ToJSON, doubleFromJSON, listFromJSON, setFromJSON, mapFromJSON, listToJSON, mapToJSON } from '../../thrift_runtime';
+
+export interface SettingsError {
+  message?: string;
+  code?: number;
+}
+
+/**
+ * SettingsError 的类，构造时先设置 IDL 中的默认值，再合并传入的属性
+ */
+export class SettingsError {
+  constructor(init?: Partial<SettingsError>) {
+    this.message = "invalid settings";
+    this.code = 400;
+    if (init) {
+      Object.assign(this, init);
+    }
+  }
+
+  static fromJSON(json: any): SettingsError {
+    const value: any = {};
+    if (json.message !== undefined && json.message !== null) {
+      value.message = json.message;
+    }
+    if (json.code !== undefined && json.code !== null) {
+      value.code = json.code;
+    }
+    return new SettingsError(value);
+  }
+
+  static toJSON(value: SettingsError): any {
+    const json: any = {};
+    if (value.message !== undefined && value.message !== null) {
+      json.message = value.message;
+    }
+    if (value.code !== undefined && value.code !== null) {
+      json.code = value.code;
+    }
+    return json;
+  }
+}
\ No newline at end of file
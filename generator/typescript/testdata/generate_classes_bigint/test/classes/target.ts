@@ -0,0 +1,43 @@
+"use strict";
+
+// Generated by thriftgo ?
+// DO NOT EDIT UNLESS YOU ARE SURE THAT YOU KNOW WHAT YOU ARE DOING
+import { i64FromJSON, i64KeyFromJSON, i64ToJSON, doubleFromJSON, listFromJSON, setFromJSON, mapFromJSON, listToJSON, mapToJSON } from '../../thrift_runtime';
+
+export interface Target {
+  id?: number | undefined;
+  name?: string | undefined;
+}
+
+/**
+ * Target 的类，构造时先设置 IDL 中的默认值，再合并传入的属性
+ */
+export class Target {
+  constructor(init?: Partial<Target>) {
+    if (init) {
+      Object.assign(this, init);
+    }
+  }
+
+  static fromJSON(json: any): Target {
+    const value: any = {};
+    if (json.id !== undefined && json.id !== null) {
+      value.id = json.id;
+    }
+    if (json.name !== undefined && json.name !== null) {
+      value.name = json.name;
+    }
+    return new Target(value);
+  }
+
+  static toJSON(value: Target): any {
+    const json: any = {};
+    if (value.id !== undefined && value.id !== null) {
+      json.id = value.id;
+    }
+    if (value.name !== undefined && value.name !== null) {
+      json.name = value.name;
+    }
+    return json;
+  }
+}
\ No newline at end of file
@@ -0,0 +1,39 @@
+"use strict";
+
+// Generated by thriftgo ?
+// DO NOT EDIT UNLESS YOU ARE SURE THAT YOU KNOW WHAT YOU ARE DOING
+
+import { Order } from './order';
+import { i64FromJSON, i64KeyFromJSON, i64ToJSON, doubleFromJSON, listFromJSON, setFromJSON, mapFromJSON, listToJSON, mapToJSON } from '../../thrift_runtime';
+
+export interface ListOrdersResp {
+  orders?: Array<Order>;
+  total?: number;
+}
+
+/**
+ * ListOrdersResp 的编解码方法
+ */
+export const ListOrdersResp = {
+  fromJSON(json: any): ListOrdersResp {
+    const value: any = {};
+    if (json.orders !== undefined && json.orders !== null) {
+      value.orders = listFromJSON(json.orders, (v0: any) => Order.fromJSON(v0));
+    }
+    if (json.total !== undefined && json.total !== null) {
+      value.total = json.total;
+    }
+    return value;
+  },
+
+  toJSON(value: ListOrdersResp): any {
+    const json: any = {};
+    if (value.orders !== undefined && value.orders !== null) {
+      json.orders = listToJSON(value.orders, (v0: any) => Order.toJSON(v0));
+    }
+    if (value.total !== undefined && value.total !== null) {
+      json.total = value.total;
+    }
+    return json;
+  },
+};
\ No newline at end of file
@@ -0,0 +1,61 @@
+"use strict";
+
+// Generated by thriftgo ?
+// DO NOT EDIT UNLESS YOU ARE SURE THAT YOU KNOW WHAT YOU ARE DOING
+
+import { Address } from '../../test_codec';
+import { OrderStatus } from './orderstatus';
+import { i64FromJSON, i64KeyFromJSON, i64ToJSON, doubleFromJSON, listFromJSON, setFromJSON, mapFromJSON, listToJSON, mapToJSON } from '../../thrift_runtime';
+
+export interface Order {
+  orderId: bigint;
+  status: OrderStatus;
+  amount?: number | undefined;
+  tags?: Set<string> | undefined;
+  address?: Address | undefined;
+}
+
+/**
+ * Order 的编解码方法
+ */
+export const Order = {
+  fromJSON(json: any): Order {
+    const value: any = {};
+    if (json.orderId !== undefined && json.orderId !== null) {
+      value.orderId = i64FromJSON(json.orderId);
+    }
+    if (json.status !== undefined && json.status !== null) {
+      value.status = json.status;
+    }
+    if (json.amount !== undefined && json.amount !== null) {
+      value.amount = doubleFromJSON(json.amount);
+    }
+    if (json.tags !== undefined && json.tags !== null) {
+      value.tags = setFromJSON(json.tags);
+    }
+    if (json.address !== undefined && json.address !== null) {
+      value.address = Address.fromJSON(json.address);
+    }
+    return value;
+  },
+
+  toJSON(value: Order): any {
+    const json: any = {};
+    if (value.orderId !== undefined && value.orderId !== null) {
+      json.orderId = i64ToJSON(value.orderId);
+    }
+    if (value.status !== undefined && value.status !== null) {
+      json.status = value.status;
+    }
+    if (value.amount !== undefined && value.amount !== null) {
+      json.amount = value.amount;
+    }
+    if (value.tags !== undefined && value.tags !== null) {
+      json.tags = listToJSON(value.tags);
+    }
+    if (value.address !== undefined && value.address !== null) {
+      json.address = Address.toJSON(value.address);
+    }
+    return json;
+  },
+};
\ No newline at end of file
@@ -0,0 +1,129 @@
+"use strict";
+
+/* tslint:disable */
+/* eslint-disable */
+
+// Generated by thriftgo ?
+// DO NOT EDIT UNLESS YOU ARE SURE THAT YOU KNOW WHAT YOU ARE DOING
+
+import { NotFound } from '../../test_codec';
+import { CreateOrderReq } from './createorderreq';
+import { ListOrdersReq } from './listordersreq';
+import { ListOrdersResp } from './listordersresp';
+import { Order } from './order';
+import { OrderNotFound } from './ordernotfound';
+import { i64ToJSON, listToJSON, mapToJSON } from '../../thrift_runtime';
+import type { IOrderService } from './orderservice';
+import { OrderServiceClient } from './orderserviceclient';
+import { useMutation, useQuery } from '@tanstack/react-query';
+import type { UseMutationOptions, UseQueryOptions } from '@tanstack/react-query';
+
+let client: IOrderService | undefined;
+
+/**
+ * 设置 hooks 使用的客户端，未设置时使用默认配置的 OrderServiceClient
+ */
+export function setOrderServiceHooksClient(c: IOrderService): void {
+  client = c;
+}
+
+function getClient(): IOrderService {
+  if (!client) {
+    client = new OrderServiceClient();
+  }
+  return client;
+}
+
+/**
+ * OrderService 的 query key 工厂，可用于 invalidateQueries、mutate 等缓存操作
+ * 参数以 JSON 值放入 key，i64 转换为字符串，使 key 可以用 JSON.stringify 计算哈希
+ */
+export const orderServiceKeys = {
+  all: ['OrderService'] as const,
+  listOrders: (req: ListOrdersReq) => ['OrderService', 'listOrders', req === undefined || req === null ? req : ListOrdersReq.toJSON(req)] as const,
+  createOrder: () => ['OrderService', 'createOrder'] as const,
+  getOrder: (orderId?: bigint, token?: string) => ['OrderService', 'getOrder', orderId === undefined || orderId === null ? orderId : i64ToJSON(orderId), token] as const,
+  updateRemark: () => ['OrderService', 'updateRemark'] as const,
+  cancelOrder: () => ['OrderService', 'cancelOrder'] as const,
+  ping: () => ['OrderService', 'ping'] as const,
+};
+
+/**
+ * listOrders 的查询 hook
+ */
+export function useListOrders(
+  req: ListOrdersReq,
+  options?: Omit<UseQueryOptions<ListOrdersResp, Error, ListOrdersResp, ReturnType<typeof orderServiceKeys.listOrders>>, 'queryKey' | 'queryFn'>,
+) {
+  return useQuery({
+    queryKey: orderServiceKeys.listOrders(req),
+    queryFn: () => getClient().listOrders(req),
+    ...options,
+  });
+}
+
+/**
+ * createOrder 的变更 hook
+ */
+export function useCreateOrder(
+  options?: Omit<UseMutationOptions<Order, Error, CreateOrderReq>, 'mutationKey' | 'mutationFn'>,
+) {
+  return useMutation({
+    mutationKey: orderServiceKeys.createOrder(),
+    mutationFn: (variables: CreateOrderReq) => getClient().createOrder(variables),
+    ...options,
+  });
+}
+
+/**
+ * getOrder 的查询 hook
+ */
+export function useGetOrder(
+  orderId?: bigint, token?: string,
+  options?: Omit<UseQueryOptions<Order, Error, Order, ReturnType<typeof orderServiceKeys.getOrder>>, 'queryKey' | 'queryFn'>,
+) {
+  return useQuery({
+    queryKey: orderServiceKeys.getOrder(orderId, token),
+    queryFn: () => getClient().getOrder(orderId, token),
+    ...options,
+  });
+}
+
+/**
+ * updateRemark 的变更 hook
+ */
+export function useUpdateRemark(
+  options?: Omit<UseMutationOptions<void, Error, [bigint, string]>, 'mutationKey' | 'mutationFn'>,
+) {
+  return useMutation({
+    mutationKey: orderServiceKeys.updateRemark(),
+    mutationFn: (variables: [bigint, string]) => getClient().updateRemark(...variables),
+    ...options,
+  });
+}
+
+/**
+ * cancelOrder 的变更 hook
+ */
+export function useCancelOrder(
+  options?: Omit<UseMutationOptions<void, Error, [bigint, string]>, 'mutationKey' | 'mutationFn'>,
+) {
+  return useMutation({
+    mutationKey: orderServiceKeys.cancelOrder(),
+    mutationFn: (variables: [bigint, string]) => getClient().cancelOrder(...variables),
+    ...options,
+  });
+}
+
+/**
+ * ping 的变更 hook
+ */
+export function usePing(
+  options?: Omit<UseMutationOptions<void, Error, void>, 'mutationKey' | 'mutationFn'>,
+) {
+  return useMutation({
+    mutationKey: orderServiceKeys.ping(),
+    mutationFn: () => getClient().ping(),
+    ...options,
+  });
+}
\ No newline at end of file
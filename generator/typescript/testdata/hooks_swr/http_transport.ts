@@ -0,0 +1,184 @@
+"use strict";
+
+/* tslint:disable */
+/* eslint-disable */
+
+// Generated by thriftgo ?
+// DO NOT EDIT UNLESS YOU ARE SURE THAT YOU KNOW WHAT YOU ARE DOING
+
+/**
+ * 客户端支持的 HTTP 方法
+ */
+export type HttpMethod = 'GET' | 'POST' | 'PUT' | 'DELETE' | 'PATCH';
+
+/**
+ * 客户端发出的请求，url 中的路径参数已经替换完成，
+ * 带有 api.form 字段的方法以 form 发送 application/x-www-form-urlencoded 请求体，此时没有 body
+ */
+export interface HttpRequest {
+  method: HttpMethod;
+  url: string;
+  query?: { [key: string]: unknown };
+  body?: unknown;
+  form?: { [key: string]: unknown };
+  headers?: { [key: string]: string };
+}
+
+/**
+ * HTTP 传输层，负责发送请求并返回解析后的响应体，非 2xx 响应应抛出 HttpError
+ */
+export interface HttpTransport {
+  request<T = any>(req: HttpRequest): Promise<T>;
+}
+
+/**
+ * 非 2xx 的 HTTP 响应
+ */
+export class HttpError extends Error {
+  constructor(
+    public readonly status: number,
+    public readonly statusText: string,
+    public readonly body?: unknown,
+  ) {
+    super('HTTP ' + status + ': ' + statusText);
+    this.name = 'HttpError';
+  }
+}
+
+/**
+ * 业务错误，响应体中的 code 不为 0 时抛出
+ */
+export class BizException extends Error {
+  constructor(
+    public readonly code: number,
+    public readonly msg: string,
+  ) {
+    super(msg);
+    this.name = 'BizException';
+  }
+}
+
+/**
+ * 把参数编码为 URLSearchParams，数组和 Set 展开为多个同名参数，对象以 JSON 字符串传递，undefined 和 null 会被忽略
+ */
+export function buildParams(values?: { [key: string]: unknown }): URLSearchParams {
+  const params = new URLSearchParams();
+  for (const key of Object.keys(values || {})) {
+    const value = values![key];
+    const items = Array.isArray(value) || value instanceof Set ? Array.from(value) : [value];
+    for (const v of items) {
+      if (v !== undefined && v !== null) {
+        params.append(key, typeof v === 'object' ? JSON.stringify(v) : String(v));
+      }
+    }
+  }
+  return params;
+}
+
+/**
+ * 把 query 参数拼接到 url 上，参数的编码规则见 buildParams
+ */
+export function buildURL(baseURL: string, url: string, query?: { [key: string]: unknown }): string {
+  let full = url;
+  if (baseURL && !/^[a-zA-Z][a-zA-Z\d+\-.]*:/.test(url)) {
+    full = baseURL.replace(/\/+$/, '') + '/' + url.replace(/^\/+/, '');
+  }
+  const search = buildParams(query).toString();
+  if (!search) {
+    return full;
+  }
+  return full + (full.includes('?') ? '&' : '?') + search;
+}
+
+/**
+ * fetch 传输层的配置
+ */
+export interface FetchTransportOptions {
+  // 请求地址的前缀，如 https://api.example.com
+  baseURL?: string;
+  // 每个请求都会携带的请求头
+  headers?: { [key: string]: string };
+  // 自定义 fetch 实现，默认使用全局的 fetch
+  fetch?: typeof fetch;
+  // 自定义请求体的序列化和响应体的解析，如处理 bigint
+  stringify?: (value: unknown) => string;
+  parse?: (text: string) => any;
+}
+
+/**
+ * 基于 fetch 的传输层，可用于浏览器、Node.js 18+ 和 Deno
+ */
+export function createFetchTransport(options: FetchTransportOptions = {}): HttpTransport {
+  return {
+    async request<T = any>(req: HttpRequest): Promise<T> {
+      const doFetch = options.fetch || globalThis.fetch;
+      if (!doFetch) {
+        throw new Error('fetch is not available, pass options.fetch or use another HttpTransport');
+      }
+      const headers: { [key: string]: string } = { ...options.headers, ...req.headers };
+      const init: RequestInit = { method: req.method, headers };
+      if (req.form !== undefined) {
+        headers['Content-Type'] = headers['Content-Type'] || 'application/x-www-form-urlencoded';
+        init.body = buildParams(req.form).toString();
+      } else if (req.body !== undefined) {
+        headers['Content-Type'] = headers['Content-Type'] || 'application/json';
+        init.body = (options.stringify || JSON.stringify)(req.body);
+      }
+      const response = await doFetch(buildURL(options.baseURL || '', req.url, req.query), init);
+      const text = await response.text();
+      if (!response.ok) {
+        throw new HttpError(response.status, response.statusText, text);
+      }
+      return (text ? (options.parse || JSON.parse)(text) : undefined) as T;
+    },
+  };
+}
+
+/**
+ * 传输层需要的 axios 实例方法，axios.create() 返回的实例即满足该接口
+ */
+export interface AxiosLike {
+  request(config: {
+    method: string;
+    url: string;
+    params?: unknown;
+    data?: unknown;
+    headers?: { [key: string]: string };
+    responseType?: string;
+    transformResponse?: Array<(data: any) => any>;
+  }): Promise<{ status: number; statusText: string; data: any }>;
+}
+
+/**
+ * axios 传输层的配置
+ */
+export interface AxiosTransportOptions {
+  // 自定义响应体的解析，如处理 bigint，设置后按文本接收响应体，不再由 axios 解析
+  parse?: (text: string) => any;
+}
+
+/**
+ * 基于 axios 实例的传输层，可以复用项目中已配置拦截器的实例
+ */
+export function createAxiosTransport(instance: AxiosLike, options: AxiosTransportOptions = {}): HttpTransport {
+  return {
+    async request<T = any>(req: HttpRequest): Promise<T> {
+      const parse = options.parse;
+      const response = await instance.request({
+        method: req.method,
+        url: req.url,
+        params: req.query,
+        data: req.form !== undefined ? buildParams(req.form) : req.body,
+        headers: req.headers,
+        ...(parse ? { responseType: 'text', transformResponse: [(data: any) => data] } : {}),
+      });
+      if (response.status < 200 || response.status >= 300) {
+        throw new HttpError(response.status, response.statusText, response.data);
+      }
+      if (parse && typeof response.data === 'string') {
+        return (response.data ? parse(response.data) : undefined) as T;
+      }
+      return response.data as T;
+    },
+  };
+}
\ No newline at end of file
@@ -0,0 +1,204 @@
+"use strict";
+
+/* tslint:disable */
+/* eslint-disable */
+
+// Generated by thriftgo ?
+// DO NOT EDIT UNLESS YOU ARE SURE THAT YOU KNOW WHAT YOU ARE DOING
+
+import type { NotFound } from '../../test_codec';
+import type { CreateOrderReq } from './createorderreq';
+import type { ListOrdersReq } from './listordersreq';
+import type { ListOrdersResp } from './listordersresp';
+import type { Order } from './order';
+import type { OrderNotFound } from './ordernotfound';
+// 导入服务接口
+import type { IOrderService } from './orderservice';
+import { createFetchTransport } from '../../http_transport';
+import type { HttpTransport } from '../../http_transport';
+import { BizException } from '../../http_transport';
+
+/**
+ * OrderService HTTP 客户端实现
+ * 根据 Thrift 服务定义和 API 注解自动生成的 HTTP 请求实现
+ * 请求通过 HttpTransport 发送，未指定时使用 fetch
+ */
+export class OrderServiceClient implements IOrderService {
+  private readonly transport: HttpTransport;
+
+  constructor(transport?: HttpTransport) {
+    this.transport = transport || createFetchTransport();
+  }
+  /**
+   * listOrders
+   * API: GET [/users/:userId/orders /v2/users/:userId/orders]
+   * @param req req
+   * @returns ListOrdersResp
+   */
+  async listOrders(
+    req: ListOrdersReq
+  ): Promise<ListOrdersResp> {
+    try {
+      let url = '/users/:userId/orders';
+      const queryParams: any = {};
+      const headers: { [key: string]: string } = {};
+      if (req.userId !== undefined && req.userId !== null) {
+        url = url.replace(':userId', encodeURIComponent(String(req.userId)));
+      }
+      if (req.page !== undefined && req.page !== null) {
+        queryParams['page'] = req.page;
+      }
+      if (req.statuses !== undefined && req.statuses !== null) {
+        queryParams['status'] = req.statuses;
+      }
+      if (req.withItems !== undefined && req.withItems !== null) {
+        queryParams['withItems'] = req.withItems;
+      }
+      if (req.traceId !== undefined && req.traceId !== null) {
+        headers['X-Trace-Id'] = String(req.traceId);
+      }
+      const data = await this.transport.request({ method: 'GET', url, query: queryParams, headers });
+      if (data !== null && typeof data === 'object' && 'code' in data && data.code !== 0) {
+        throw new BizException(data.code, data.msg);
+      }
+      return data;
+    } catch (error) {
+      console.error('listOrders request failed:', error);
+      throw error;
+    }
+  }
+  /**
+   * createOrder
+   * API: POST [/shops/:shopId/orders]
+   * @param req req
+   * @returns Order
+   */
+  async createOrder(
+    req: CreateOrderReq
+  ): Promise<Order> {
+    try {
+      let url = '/shops/:shopId/orders';
+      const queryParams: any = {};
+      const bodyParam: any = {};
+      if (req.shopId !== undefined && req.shopId !== null) {
+        url = url.replace(':shopId', encodeURIComponent(String(req.shopId)));
+      }
+      if (req.order !== undefined && req.order !== null) {
+        bodyParam['order'] = req.order;
+      }
+      if (req.remark !== undefined && req.remark !== null) {
+        bodyParam['remark'] = req.remark;
+      }
+      const data = await this.transport.request({ method: 'POST', url, query: queryParams, body: bodyParam });
+      if (data !== null && typeof data === 'object' && 'code' in data && data.code !== 0) {
+        throw new BizException(data.code, data.msg);
+      }
+      return data;
+    } catch (error) {
+      console.error('createOrder request failed:', error);
+      throw error;
+    }
+  }
+  /**
+   * getOrder
+   * API: GET [/orders/:orderId]
+   * @param orderId orderId
+   * @param token token
+   * @returns Order
+   */
+  async getOrder(
+    orderId?: number, token?: string
+  ): Promise<Order> {
+    try {
+      let url = '/orders/:orderId';
+      const queryParams: any = {};
+      const headers: { [key: string]: string } = {};
+      if (orderId !== undefined && orderId !== null) {
+        url = url.replace(':orderId', encodeURIComponent(String(orderId)));
+      }
+      if (token !== undefined && token !== null) {
+        headers['X-Token'] = String(token);
+      }
+      const data = await this.transport.request({ method: 'GET', url, query: queryParams, headers });
+      if (data !== null && typeof data === 'object' && 'code' in data && data.code !== 0) {
+        throw new BizException(data.code, data.msg);
+      }
+      return data;
+    } catch (error) {
+      console.error('getOrder request failed:', error);
+      throw error;
+    }
+  }
+  /**
+   * updateRemark
+   * API: POST [/orders/:orderId/remark]
+   * @param orderId orderId
+   * @param remark remark
+   * @returns any
+   */
+  async updateRemark(
+    orderId: number, remark: string
+  ): Promise<any> {
+    try {
+      let url = '/orders/:orderId/remark';
+      const queryParams: any = {};
+      const formParams: any = {};
+      if (orderId !== undefined && orderId !== null) {
+        url = url.replace(':orderId', encodeURIComponent(String(orderId)));
+      }
+      if (remark !== undefined && remark !== null) {
+        formParams['remark'] = remark;
+      }
+      const data = await this.transport.request({ method: 'POST', url, query: queryParams, form: formParams });
+      if (data !== null && typeof data === 'object' && 'code' in data && data.code !== 0) {
+        throw new BizException(data.code, data.msg);
+      }
+      return data;
+    } catch (error) {
+      console.error('updateRemark request failed:', error);
+      throw error;
+    }
+  }
+  /**
+   * cancelOrder
+   * API: DELETE [/orders/:orderId]
+   * @param orderId orderId
+   * @param reason reason
+   * @returns any
+   */
+  async cancelOrder(
+    orderId?: number, reason?: string
+  ): Promise<any> {
+    try {
+      let url = '/orders/:orderId';
+      const queryParams: any = {};
+      if (orderId !== undefined && orderId !== null) {
+        url = url.replace(':orderId', encodeURIComponent(String(orderId)));
+      }
+      if (reason !== undefined && reason !== null) {
+        queryParams['reason'] = reason;
+      }
+      const data = await this.transport.request({ method: 'DELETE', url, query: queryParams });
+      if (data !== null && typeof data === 'object' && 'code' in data && data.code !== 0) {
+        throw new BizException(data.code, data.msg);
+      }
+      return data;
+    } catch (error) {
+      console.error('cancelOrder request failed:', error);
+      throw error;
+    }
+  }
+  /**
+   * ping
+   * @returns any
+   */
+  async ping(
+    
+  ): Promise<any> {
+    try {
+    } catch (error) {
+      console.error('ping request failed:', error);
+      throw error;
+    }
+  }
+}
\ No newline at end of file
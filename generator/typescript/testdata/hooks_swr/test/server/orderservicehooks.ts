@@ -0,0 +1,107 @@
+"use strict";
+
+/* tslint:disable */
+/* eslint-disable */
+
+// Generated by thriftgo ?
+// DO NOT EDIT UNLESS YOU ARE SURE THAT YOU KNOW WHAT YOU ARE DOING
+
+import type { NotFound } from '../../test_codec';
+import type { CreateOrderReq } from './createorderreq';
+import type { ListOrdersReq } from './listordersreq';
+import type { ListOrdersResp } from './listordersresp';
+import type { Order } from './order';
+import type { OrderNotFound } from './ordernotfound';
+import type { IOrderService } from './orderservice';
+import { OrderServiceClient } from './orderserviceclient';
+import useSWR from 'swr';
+import useSWRMutation from 'swr/mutation';
+import type { SWRConfiguration } from 'swr';
+import type { SWRMutationConfiguration } from 'swr/mutation';
+
+let client: IOrderService | undefined;
+
+/**
+ * 设置 hooks 使用的客户端，未设置时使用默认配置的 OrderServiceClient
+ */
+export function setOrderServiceHooksClient(c: IOrderService): void {
+  client = c;
+}
+
+function getClient(): IOrderService {
+  if (!client) {
+    client = new OrderServiceClient();
+  }
+  return client;
+}
+
+/**
+ * OrderService 的 query key 工厂，可用于 invalidateQueries、mutate 等缓存操作
+ */
+export const orderServiceKeys = {
+  all: ['OrderService'] as const,
+  listOrders: (req: ListOrdersReq) => ['OrderService', 'listOrders', req] as const,
+  createOrder: () => ['OrderService', 'createOrder'] as const,
+  getOrder: (orderId?: number, token?: string) => ['OrderService', 'getOrder', orderId, token] as const,
+  updateRemark: () => ['OrderService', 'updateRemark'] as const,
+  cancelOrder: () => ['OrderService', 'cancelOrder'] as const,
+  ping: () => ['OrderService', 'ping'] as const,
+};
+
+/**
+ * listOrders 的查询 hook
+ */
+export function useListOrders(req: ListOrdersReq, config?: SWRConfiguration<ListOrdersResp, Error>) {
+  return useSWR(orderServiceKeys.listOrders(req), () => getClient().listOrders(req), config);
+}
+
+/**
+ * createOrder 的变更 hook
+ */
+export function useCreateOrder(config?: SWRMutationConfiguration<Order, Error, ReturnType<typeof orderServiceKeys.createOrder>, CreateOrderReq>) {
+  return useSWRMutation(
+    orderServiceKeys.createOrder(),
+    (_key: unknown, { arg: variables }: { arg: CreateOrderReq }) => getClient().createOrder(variables),
+    config,
+  );
+}
+
+/**
+ * getOrder 的查询 hook
+ */
+export function useGetOrder(orderId?: number, token?: string, config?: SWRConfiguration<Order, Error>) {
+  return useSWR(orderServiceKeys.getOrder(orderId, token), () => getClient().getOrder(orderId, token), config);
+}
+
+/**
+ * updateRemark 的变更 hook
+ */
+export function useUpdateRemark(config?: SWRMutationConfiguration<void, Error, ReturnType<typeof orderServiceKeys.updateRemark>, [number, string]>) {
+  return useSWRMutation(
+    orderServiceKeys.updateRemark(),
+    (_key: unknown, { arg: variables }: { arg: [number, string] }) => getClient().updateRemark(...variables),
+    config,
+  );
+}
+
+/**
+ * cancelOrder 的变更 hook
+ */
+export function useCancelOrder(config?: SWRMutationConfiguration<void, Error, ReturnType<typeof orderServiceKeys.cancelOrder>, [number, string]>) {
+  return useSWRMutation(
+    orderServiceKeys.cancelOrder(),
+    (_key: unknown, { arg: variables }: { arg: [number, string] }) => getClient().cancelOrder(...variables),
+    config,
+  );
+}
+
+/**
+ * ping 的变更 hook
+ */
+export function usePing(config?: SWRMutationConfiguration<void, Error, ReturnType<typeof orderServiceKeys.ping>, void>) {
+  return useSWRMutation(
+    orderServiceKeys.ping(),
+    () => getClient().ping(),
+    config,
+  );
+}
\ No newline at end of file
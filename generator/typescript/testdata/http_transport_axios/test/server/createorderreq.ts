@@ -0,0 +1,46 @@
+"use strict";
+
+// Generated by thriftgo ?
+// DO NOT EDIT UNLESS YOU ARE SURE THAT YOU KNOW WHAT YOU ARE DOING
+
+import { Order } from './order';
+import { i64FromJSON, i64KeyFromJSON, i64ToJSON, doubleFromJSON, listFromJSON, setFromJSON, mapFromJSON, listToJSON, mapToJSON } from '../../thrift_runtime';
+
+export interface CreateOrderReq {
+  shopId: string;
+  order: Order;
+  remark?: string | undefined;
+}
+
+/**
+ * CreateOrderReq 的编解码方法
+ */
+export const CreateOrderReq = {
+  fromJSON(json: any): CreateOrderReq {
+    const value: any = {};
+    if (json.shopId !== undefined && json.shopId !== null) {
+      value.shopId = json.shopId;
+    }
+    if (json.order !== undefined && json.order !== null) {
+      value.order = Order.fromJSON(json.order);
+    }
+    if (json.remark !== undefined && json.remark !== null) {
+      value.remark = json.remark;
+    }
+    return value;
+  },
+
+  toJSON(value: CreateOrderReq): any {
+    const json: any = {};
+    if (value.shopId !== undefined && value.shopId !== null) {
+      json.shopId = value.shopId;
+    }
+    if (value.order !== undefined && value.order !== null) {
+      json.order = Order.toJSON(value.order);
+    }
+    if (value.remark !== undefined && value.remark !== null) {
+      json.remark = value.remark;
+    }
+    return json;
+  },
+};
\ No newline at end of file
@@ -0,0 +1,11 @@
+"use strict";
+
+// Generated by thriftgo ?
+// DO NOT EDIT UNLESS YOU ARE SURE THAT YOU KNOW WHAT YOU ARE DOING
+export { OrderStatus } from './orderstatus';
+export { ListOrdersReq } from './listordersreq';
+export { Order } from './order';
+export { ListOrdersResp } from './listordersresp';
+export { CreateOrderReq } from './createorderreq';
+export type { IOrderService } from './orderservice';
+export { OrderServiceClient } from './orderserviceclient';
\ No newline at end of file
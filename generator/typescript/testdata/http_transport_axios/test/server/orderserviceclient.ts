@@ -0,0 +1,215 @@
+"use strict";
+
+/* tslint:disable */
+/* eslint-disable */
+
+// Generated by thriftgo ?
+// DO NOT EDIT UNLESS YOU ARE SURE THAT YOU KNOW WHAT YOU ARE DOING
+
+import { NotFound } from '../../test_codec';
+import { CreateOrderReq } from './createorderreq';
+import { ListOrdersReq } from './listordersreq';
+import { ListOrdersResp } from './listordersresp';
+import { Order } from './order';
+import { OrderNotFound } from './ordernotfound';
+// 导入服务接口
+import type { IOrderService } from './orderservice';
+import { createAxiosTransport } from '../../http_transport';
+import axios from '@/utils/request';
+import type { HttpTransport } from '../../http_transport';
+import { BizException } from '../../http_transport';
+import { parseJSON, i64FromJSON, i64KeyFromJSON, i64ToJSON, doubleFromJSON, listFromJSON, setFromJSON, mapFromJSON, listToJSON, mapToJSON } from '../../thrift_runtime';
+
+/**
+ * OrderService HTTP 客户端实现
+ * 根据 Thrift 服务定义和 API 注解自动生成的 HTTP 请求实现
+ * 请求通过 HttpTransport 发送，未指定时使用项目中已实例化的 axios 实例
+ * i64 在请求中以字符串发送，响应需要用 parseJSON 解析以免丢失精度，自定义传输层时应传入 parse: parseJSON
+ */
+export class OrderServiceClient implements IOrderService {
+  private readonly transport: HttpTransport;
+
+  constructor(transport?: HttpTransport) {
+    this.transport = transport || createAxiosTransport(axios, { parse: parseJSON });
+  }
+  /**
+   * listOrders
+   * API: GET [/users/:userId/orders /v2/users/:userId/orders]
+   * @param req req
+   * @returns ListOrdersResp
+   */
+  async listOrders(
+    req: ListOrdersReq
+  ): Promise<ListOrdersResp> {
+    try {
+      const reqJSON: any = req === undefined || req === null ? req : ListOrdersReq.toJSON(req);
+      let url = '/users/:userId/orders';
+      const queryParams: any = {};
+      const headers: { [key: string]: string } = {};
+      if (reqJSON.userId !== undefined && reqJSON.userId !== null) {
+        url = url.replace(':userId', encodeURIComponent(String(reqJSON.userId)));
+      }
+      if (reqJSON.page !== undefined && reqJSON.page !== null) {
+        queryParams['page'] = reqJSON.page;
+      }
+      if (reqJSON.statuses !== undefined && reqJSON.statuses !== null) {
+        queryParams['status'] = reqJSON.statuses;
+      }
+      if (reqJSON.withItems !== undefined && reqJSON.withItems !== null) {
+        queryParams['withItems'] = reqJSON.withItems;
+      }
+      if (reqJSON.traceId !== undefined && reqJSON.traceId !== null) {
+        headers['X-Trace-Id'] = String(reqJSON.traceId);
+      }
+      const data = await this.transport.request({ method: 'GET', url, query: queryParams, headers });
+      if (data !== null && typeof data === 'object' && 'code' in data && data.code !== 0) {
+        throw new BizException(data.code, data.msg);
+      }
+      return data === undefined || data === null ? data : ListOrdersResp.fromJSON(data);
+    } catch (error) {
+      console.error('listOrders request failed:', error);
+      throw error;
+    }
+  }
+  /**
+   * createOrder
+   * API: POST [/shops/:shopId/orders]
+   * @param req req
+   * @returns Order
+   */
+  async createOrder(
+    req: CreateOrderReq
+  ): Promise<Order> {
+    try {
+      const reqJSON: any = req === undefined || req === null ? req : CreateOrderReq.toJSON(req);
+      let url = '/shops/:shopId/orders';
+      const queryParams: any = {};
+      const bodyParam: any = {};
+      if (reqJSON.shopId !== undefined && reqJSON.shopId !== null) {
+        url = url.replace(':shopId', encodeURIComponent(String(reqJSON.shopId)));
+      }
+      if (reqJSON.order !== undefined && reqJSON.order !== null) {
+        bodyParam['order'] = reqJSON.order;
+      }
+      if (reqJSON.remark !== undefined && reqJSON.remark !== null) {
+        bodyParam['remark'] = reqJSON.remark;
+      }
+      const data = await this.transport.request({ method: 'POST', url, query: queryParams, body: bodyParam });
+      if (data !== null && typeof data === 'object' && 'code' in data && data.code !== 0) {
+        throw new BizException(data.code, data.msg);
+      }
+      return data === undefined || data === null ? data : Order.fromJSON(data);
+    } catch (error) {
+      console.error('createOrder request failed:', error);
+      throw error;
+    }
+  }
+  /**
+   * getOrder
+   * API: GET [/orders/:orderId]
+   * @param orderId orderId
+   * @param token token
+   * @returns Order
+   */
+  async getOrder(
+    orderId?: bigint, token?: string
+  ): Promise<Order> {
+    try {
+      const orderIdJSON: any = orderId === undefined || orderId === null ? orderId : i64ToJSON(orderId);
+      const tokenJSON: any = token;
+      let url = '/orders/:orderId';
+      const queryParams: any = {};
+      const headers: { [key: string]: string } = {};
+      if (orderIdJSON !== undefined && orderIdJSON !== null) {
+        url = url.replace(':orderId', encodeURIComponent(String(orderIdJSON)));
+      }
+      if (tokenJSON !== undefined && tokenJSON !== null) {
+        headers['X-Token'] = String(tokenJSON);
+      }
+      const data = await this.transport.request({ method: 'GET', url, query: queryParams, headers });
+      if (data !== null && typeof data === 'object' && 'code' in data && data.code !== 0) {
+        throw new BizException(data.code, data.msg);
+      }
+      return data === undefined || data === null ? data : Order.fromJSON(data);
+    } catch (error) {
+      console.error('getOrder request failed:', error);
+      throw error;
+    }
+  }
+  /**
+   * updateRemark
+   * API: POST [/orders/:orderId/remark]
+   * @param orderId orderId
+   * @param remark remark
+   * @returns any
+   */
+  async updateRemark(
+    orderId: bigint, remark: string
+  ): Promise<any> {
+    try {
+      const orderIdJSON: any = orderId === undefined || orderId === null ? orderId : i64ToJSON(orderId);
+      const remarkJSON: any = remark;
+      let url = '/orders/:orderId/remark';
+      const queryParams: any = {};
+      const formParams: any = {};
+      if (orderIdJSON !== undefined && orderIdJSON !== null) {
+        url = url.replace(':orderId', encodeURIComponent(String(orderIdJSON)));
+      }
+      if (remarkJSON !== undefined && remarkJSON !== null) {
+        formParams['remark'] = remarkJSON;
+      }
+      const data = await this.transport.request({ method: 'POST', url, query: queryParams, form: formParams });
+      if (data !== null && typeof data === 'object' && 'code' in data && data.code !== 0) {
+        throw new BizException(data.code, data.msg);
+      }
+      return data;
+    } catch (error) {
+      console.error('updateRemark request failed:', error);
+      throw error;
+    }
+  }
+  /**
+   * cancelOrder
+   * API: DELETE [/orders/:orderId]
+   * @param orderId orderId
+   * @param reason reason
+   * @returns any
+   */
+  async cancelOrder(
+    orderId?: bigint, reason?: string
+  ): Promise<any> {
+    try {
+      const orderIdJSON: any = orderId === undefined || orderId === null ? orderId : i64ToJSON(orderId);
+      const reasonJSON: any = reason;
+      let url = '/orders/:orderId';
+      const queryParams: any = {};
+      if (orderIdJSON !== undefined && orderIdJSON !== null) {
+        url = url.replace(':orderId', encodeURIComponent(String(orderIdJSON)));
+      }
+      if (reasonJSON !== undefined && reasonJSON !== null) {
+        queryParams['reason'] = reasonJSON;
+      }
+      const data = await this.transport.request({ method: 'DELETE', url, query: queryParams });
+      if (data !== null && typeof data === 'object' && 'code' in data && data.code !== 0) {
+        throw new BizException(data.code, data.msg);
+      }
+      return data;
+    } catch (error) {
+      console.error('cancelOrder request failed:', error);
+      throw error;
+    }
+  }
+  /**
+   * ping
+   * @returns any
+   */
+  async ping(
+    
+  ): Promise<any> {
+    try {
+    } catch (error) {
+      console.error('ping request failed:', error);
+      throw error;
+    }
+  }
+}
\ No newline at end of file
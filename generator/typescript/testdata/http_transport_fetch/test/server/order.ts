@@ -0,0 +1,15 @@
+"use strict";
+
+// Generated by thriftgo ?
+// DO NOT EDIT UNLESS YOU ARE SURE THAT YOU KNOW WHAT YOU ARE DOING
+
+import type { Address } from '../../test_codec';
+import type { OrderStatus } from './orderstatus';
+
+export interface Order {
+  orderId: number;
+  status: OrderStatus;
+  amount?: number | undefined;
+  tags?: Set<string> | undefined;
+  address?: Address | undefined;
+}
\ No newline at end of file
@@ -0,0 +1,903 @@
+"use strict";
+
+
+// Generated by thriftgo ?
+// DO NOT EDIT UNLESS YOU ARE SURE THAT YOU KNOW WHAT YOU ARE DOING
+
+import { PageReq } from './common/base';
+import { TProtocolException, TType } from './thrift_runtime';
+import type { TProtocol } from './thrift_runtime';
+import { i64FromJSON, i64KeyFromJSON, i64ToJSON, doubleFromJSON, listFromJSON, setFromJSON, mapFromJSON, listToJSON, mapToJSON } from './thrift_runtime';
+
+export enum Gender {
+  UNKNOWN = 0,
+  MALE = 1,
+  FEMALE = 2,
+}
+
+export interface Address {
+  city: string;
+  street?: string | undefined;
+}
+
+/**
+ * Address 的编解码方法
+ */
+export const Address = {
+  write(output: TProtocol, value: Address): void {
+    output.writeStructBegin('Address');
+    if (value.city === undefined || value.city === null) {
+      throw new TProtocolException('required field city of Address is unset');
+    }
+    output.writeFieldBegin('city', TType.STRING, 1);
+    output.writeString(value.city);
+    output.writeFieldEnd();
+    if (value.street !== undefined && value.street !== null) {
+      output.writeFieldBegin('street', TType.STRING, 2);
+      output.writeString(value.street);
+      output.writeFieldEnd();
+    }
+    output.writeFieldStop();
+    output.writeStructEnd();
+  },
+
+  read(input: TProtocol): Address {
+    const value: any = {};
+    input.readStructBegin();
+    for (;;) {
+      const field = input.readFieldBegin();
+      if (field.type === TType.STOP) {
+        break;
+      }
+      switch (field.id) {
+        case 1:
+          if (field.type === TType.STRING) {
+            value.city = input.readString();
+          } else {
+            input.skip(field.type);
+          }
+          break;
+        case 2:
+          if (field.type === TType.STRING) {
+            value.street = input.readString();
+          } else {
+            input.skip(field.type);
+          }
+          break;
+        default:
+          input.skip(field.type);
+      }
+      input.readFieldEnd();
+    }
+    input.readStructEnd();
+    if (value.city === undefined) {
+      throw new TProtocolException('required field city of Address is unset');
+    }
+    return value;
+  },
+
+  fromJSON(json: any): Address {
+    const value: any = {};
+    if (json.city !== undefined && json.city !== null) {
+      value.city = json.city;
+    }
+    if (json.street !== undefined && json.street !== null) {
+      value.street = json.street;
+    }
+    return value;
+  },
+
+  toJSON(value: Address): any {
+    const json: any = {};
+    if (value.city !== undefined && value.city !== null) {
+      json.city = value.city;
+    }
+    if (value.street !== undefined && value.street !== null) {
+      json.street = value.street;
+    }
+    return json;
+  },
+};
+
+export interface Tracking {
+  traceId?: string | undefined;
+}
+
+/**
+ * Tracking 的编解码方法
+ */
+export const Tracking = {
+  write(output: TProtocol, value: Tracking): void {
+    output.writeStructBegin('Tracking');
+    if (value.traceId !== undefined && value.traceId !== null) {
+      output.writeFieldBegin('trace_id', TType.STRING, 1);
+      output.writeString(value.traceId);
+      output.writeFieldEnd();
+    }
+    output.writeFieldStop();
+    output.writeStructEnd();
+  },
+
+  read(input: TProtocol): Tracking {
+    const value: any = {};
+    input.readStructBegin();
+    for (;;) {
+      const field = input.readFieldBegin();
+      if (field.type === TType.STOP) {
+        break;
+      }
+      switch (field.id) {
+        case 1:
+          if (field.type === TType.STRING) {
+            value.traceId = input.readString();
+          } else {
+            input.skip(field.type);
+          }
+          break;
+        default:
+          input.skip(field.type);
+      }
+      input.readFieldEnd();
+    }
+    input.readStructEnd();
+    return value;
+  },
+
+  fromJSON(json: any): Tracking {
+    const value: any = {};
+    if (json.traceId !== undefined && json.traceId !== null) {
+      value.traceId = json.traceId;
+    }
+    return value;
+  },
+
+  toJSON(value: Tracking): any {
+    const json: any = {};
+    if (value.traceId !== undefined && value.traceId !== null) {
+      json.traceId = value.traceId;
+    }
+    return json;
+  },
+};
+
+
+/**
+ * 覆盖所有 Thrift 类型的结构体
+ */
+export interface User {
+  id: bigint;
+  name: string;
+  active?: boolean | undefined;
+  level?: number | undefined;
+  rank?: number | undefined;
+  age?: number | undefined;
+  score?: number | undefined;
+  avatar?: Uint8Array | undefined;
+  gender?: Gender | undefined;
+  tags?: Array<string> | undefined;
+  roles?: Set<number> | undefined;
+  counters?: { [key: string]: bigint } | undefined;
+  history?: { [key: number]: Array<Address> } | undefined;
+  address?: Address | undefined;
+  contact?: Contact | undefined;
+  friends?: Array<any> | undefined;
+  location?: Location | undefined;
+  nested?: Array<{ [key: string]: Set<boolean> }>;
+  traceId?: string | undefined;
+
+/**
+ * 页码
+ */
+  pageNum?: number;
+
+/**
+ * 每页大小
+ */
+  pageSize?: number;
+}
+
+/**
+ * User 的编解码方法
+ */
+export const User = {
+  write(output: TProtocol, value: User): void {
+    output.writeStructBegin('User');
+    if (value.id === undefined || value.id === null) {
+      throw new TProtocolException('required field id of User is unset');
+    }
+    output.writeFieldBegin('id', TType.I64, 1);
+    output.writeI64(value.id);
+    output.writeFieldEnd();
+    if (value.name === undefined || value.name === null) {
+      throw new TProtocolException('required field name of User is unset');
+    }
+    output.writeFieldBegin('name', TType.STRING, 2);
+    output.writeString(value.name);
+    output.writeFieldEnd();
+    if (value.active !== undefined && value.active !== null) {
+      output.writeFieldBegin('active', TType.BOOL, 3);
+      output.writeBool(value.active);
+      output.writeFieldEnd();
+    }
+    if (value.level !== undefined && value.level !== null) {
+      output.writeFieldBegin('level', TType.BYTE, 4);
+      output.writeByte(value.level);
+      output.writeFieldEnd();
+    }
+    if (value.rank !== undefined && value.rank !== null) {
+      output.writeFieldBegin('rank', TType.I16, 5);
+      output.writeI16(value.rank);
+      output.writeFieldEnd();
+    }
+    if (value.age !== undefined && value.age !== null) {
+      output.writeFieldBegin('age', TType.I32, 6);
+      output.writeI32(value.age);
+      output.writeFieldEnd();
+    }
+    if (value.score !== undefined && value.score !== null) {
+      output.writeFieldBegin('score', TType.DOUBLE, 7);
+      output.writeDouble(value.score);
+      output.writeFieldEnd();
+    }
+    if (value.avatar !== undefined && value.avatar !== null) {
+      output.writeFieldBegin('avatar', TType.STRING, 8);
+      output.writeBinary(value.avatar);
+      output.writeFieldEnd();
+    }
+    if (value.gender !== undefined && value.gender !== null) {
+      output.writeFieldBegin('gender', TType.I32, 9);
+      output.writeI32(value.gender);
+      output.writeFieldEnd();
+    }
+    if (value.tags !== undefined && value.tags !== null) {
+      output.writeFieldBegin('tags', TType.LIST, 10);
+      output.writeListBegin(TType.STRING, value.tags.length);
+      for (const elem1 of value.tags) {
+        output.writeString(elem1);
+      }
+      output.writeListEnd();
+      output.writeFieldEnd();
+    }
+    if (value.roles !== undefined && value.roles !== null) {
+      output.writeFieldBegin('roles', TType.SET, 11);
+      output.writeSetBegin(TType.I32, value.roles.size);
+      for (const elem6 of value.roles) {
+        output.writeI32(elem6);
+      }
+      output.writeSetEnd();
+      output.writeFieldEnd();
+    }
+    if (value.counters !== undefined && value.counters !== null) {
+      output.writeFieldBegin('counters', TType.MAP, 12);
+      const map11: any = value.counters;
+      const keys12 = Object.keys(map11);
+      output.writeMapBegin(TType.STRING, TType.I64, keys12.length);
+      for (const key13 of keys12) {
+        output.writeString(key13);
+        output.writeI64(map11[key13]);
+      }
+      output.writeMapEnd();
+      output.writeFieldEnd();
+    }
+    if (value.history !== undefined && value.history !== null) {
+      output.writeFieldBegin('history', TType.MAP, 13);
+      const map19: any = value.history;
+      const keys20 = Object.keys(map19);
+      output.writeMapBegin(TType.I32, TType.LIST, keys20.length);
+      for (const key21 of keys20) {
+        output.writeI32(Number(key21));
+        output.writeListBegin(TType.STRUCT, map19[key21].length);
+        for (const elem22 of map19[key21]) {
+          Address.write(output, elem22);
+        }
+        output.writeListEnd();
+      }
+      output.writeMapEnd();
+      output.writeFieldEnd();
+    }
+    if (value.address !== undefined && value.address !== null) {
+      output.writeFieldBegin('address', TType.STRUCT, 14);
+      Address.write(output, value.address);
+      output.writeFieldEnd();
+    }
+    if (value.contact !== undefined && value.contact !== null) {
+      output.writeFieldBegin('contact', TType.STRUCT, 15);
+      Contact.write(output, value.contact);
+      output.writeFieldEnd();
+    }
+    if (value.friends !== undefined && value.friends !== null) {
+      output.writeFieldBegin('friends', TType.LIST, 16);
+      output.writeListBegin(TType.I64, value.friends.length);
+      for (const elem32 of value.friends) {
+        output.writeI64(elem32);
+      }
+      output.writeListEnd();
+      output.writeFieldEnd();
+    }
+    if (value.location !== undefined && value.location !== null) {
+      output.writeFieldBegin('location', TType.STRUCT, 17);
+      Address.write(output, value.location);
+      output.writeFieldEnd();
+    }
+    if ([value.traceId].some((v) => v !== undefined && v !== null)) {
+      output.writeFieldBegin('tracking', TType.STRUCT, 18);
+      Tracking.write(output, value as any);
+      output.writeFieldEnd();
+    }
+    if ([value.pageNum, value.pageSize].some((v) => v !== undefined && v !== null)) {
+      output.writeFieldBegin('page', TType.STRUCT, 19);
+      PageReq.write(output, value as any);
+      output.writeFieldEnd();
+    }
+    if (value.nested !== undefined && value.nested !== null) {
+      output.writeFieldBegin('nested', TType.LIST, 20);
+      output.writeListBegin(TType.MAP, value.nested.length);
+      for (const elem37 of value.nested) {
+        const map38: any = elem37;
+        const keys39 = Object.keys(map38);
+        output.writeMapBegin(TType.STRING, TType.SET, keys39.length);
+        for (const key40 of keys39) {
+          output.writeString(key40);
+          output.writeSetBegin(TType.BOOL, map38[key40].size);
+          for (const elem41 of map38[key40]) {
+            output.writeBool(elem41);
+          }
+          output.writeSetEnd();
+        }
+        output.writeMapEnd();
+      }
+      output.writeListEnd();
+      output.writeFieldEnd();
+    }
+    output.writeFieldStop();
+    output.writeStructEnd();
+  },
+
+  read(input: TProtocol): User {
+    const value: any = {};
+    input.readStructBegin();
+    for (;;) {
+      const field = input.readFieldBegin();
+      if (field.type === TType.STOP) {
+        break;
+      }
+      switch (field.id) {
+        case 1:
+          if (field.type === TType.I64) {
+            value.id = input.readI64();
+          } else {
+            input.skip(field.type);
+          }
+          break;
+        case 2:
+          if (field.type === TType.STRING) {
+            value.name = input.readString();
+          } else {
+            input.skip(field.type);
+          }
+          break;
+        case 3:
+          if (field.type === TType.BOOL) {
+            value.active = input.readBool();
+          } else {
+            input.skip(field.type);
+          }
+          break;
+        case 4:
+          if (field.type === TType.BYTE) {
+            value.level = input.readByte();
+          } else {
+            input.skip(field.type);
+          }
+          break;
+        case 5:
+          if (field.type === TType.I16) {
+            value.rank = input.readI16();
+          } else {
+            input.skip(field.type);
+          }
+          break;
+        case 6:
+          if (field.type === TType.I32) {
+            value.age = input.readI32();
+          } else {
+            input.skip(field.type);
+          }
+          break;
+        case 7:
+          if (field.type === TType.DOUBLE) {
+            value.score = input.readDouble();
+          } else {
+            input.skip(field.type);
+          }
+          break;
+        case 8:
+          if (field.type === TType.STRING) {
+            value.avatar = input.readBinary();
+          } else {
+            input.skip(field.type);
+          }
+          break;
+        case 9:
+          if (field.type === TType.I32) {
+            value.gender = input.readI32();
+          } else {
+            input.skip(field.type);
+          }
+          break;
+        case 10:
+          if (field.type === TType.LIST) {
+            const tlist2 = input.readListBegin();
+            const list3: any = [];
+            for (let i4 = 0; i4 < tlist2.size; i4++) {
+              let elem5: any;
+              elem5 = input.readString();
+              list3.push(elem5);
+            }
+            input.readListEnd();
+            value.tags = list3;
+          } else {
+            input.skip(field.type);
+          }
+          break;
+        case 11:
+          if (field.type === TType.SET) {
+            const tlist7 = input.readSetBegin();
+            const list8: any = new Set<any>();
+            for (let i9 = 0; i9 < tlist7.size; i9++) {
+              let elem10: any;
+              elem10 = input.readI32();
+              list8.add(elem10);
+            }
+            input.readSetEnd();
+            value.roles = list8;
+          } else {
+            input.skip(field.type);
+          }
+          break;
+        case 12:
+          if (field.type === TType.MAP) {
+            const tmap14 = input.readMapBegin();
+            const map15: any = {};
+            for (let i16 = 0; i16 < tmap14.size; i16++) {
+              let key17: any;
+              key17 = input.readString();
+              let val18: any;
+              val18 = input.readI64();
+              map15[key17] = val18;
+            }
+            input.readMapEnd();
+            value.counters = map15;
+          } else {
+            input.skip(field.type);
+          }
+          break;
+        case 13:
+          if (field.type === TType.MAP) {
+            const tmap23 = input.readMapBegin();
+            const map24: any = {};
+            for (let i25 = 0; i25 < tmap23.size; i25++) {
+              let key26: any;
+              key26 = input.readI32();
+              let val27: any;
+              const tlist28 = input.readListBegin();
+              const list29: any = [];
+              for (let i30 = 0; i30 < tlist28.size; i30++) {
+                let elem31: any;
+                elem31 = Address.read(input);
+                list29.push(elem31);
+              }
+              input.readListEnd();
+              val27 = list29;
+              map24[key26] = val27;
+            }
+            input.readMapEnd();
+            value.history = map24;
+          } else {
+            input.skip(field.type);
+          }
+          break;
+        case 14:
+          if (field.type === TType.STRUCT) {
+            value.address = Address.read(input);
+          } else {
+            input.skip(field.type);
+          }
+          break;
+        case 15:
+          if (field.type === TType.STRUCT) {
+            value.contact = Contact.read(input);
+          } else {
+            input.skip(field.type);
+          }
+          break;
+        case 16:
+          if (field.type === TType.LIST) {
+            const tlist33 = input.readListBegin();
+            const list34: any = [];
+            for (let i35 = 0; i35 < tlist33.size; i35++) {
+              let elem36: any;
+              elem36 = input.readI64();
+              list34.push(elem36);
+            }
+            input.readListEnd();
+            value.friends = list34;
+          } else {
+            input.skip(field.type);
+          }
+          break;
+        case 17:
+          if (field.type === TType.STRUCT) {
+            value.location = Address.read(input);
+          } else {
+            input.skip(field.type);
+          }
+          break;
+        case 18:
+          if (field.type === TType.STRUCT) {
+            Object.assign(value, Tracking.read(input));
+          } else {
+            input.skip(field.type);
+          }
+          break;
+        case 19:
+          if (field.type === TType.STRUCT) {
+            Object.assign(value, PageReq.read(input));
+          } else {
+            input.skip(field.type);
+          }
+          break;
+        case 20:
+          if (field.type === TType.LIST) {
+            const tlist42 = input.readListBegin();
+            const list43: any = [];
+            for (let i44 = 0; i44 < tlist42.size; i44++) {
+              let elem45: any;
+              const tmap46 = input.readMapBegin();
+              const map47: any = {};
+              for (let i48 = 0; i48 < tmap46.size; i48++) {
+                let key49: any;
+                key49 = input.readString();
+                let val50: any;
+                const tlist51 = input.readSetBegin();
+                const list52: any = new Set<any>();
+                for (let i53 = 0; i53 < tlist51.size; i53++) {
+                  let elem54: any;
+                  elem54 = input.readBool();
+                  list52.add(elem54);
+                }
+                input.readSetEnd();
+                val50 = list52;
+                map47[key49] = val50;
+              }
+              input.readMapEnd();
+              elem45 = map47;
+              list43.push(elem45);
+            }
+            input.readListEnd();
+            value.nested = list43;
+          } else {
+            input.skip(field.type);
+          }
+          break;
+        default:
+          input.skip(field.type);
+      }
+      input.readFieldEnd();
+    }
+    input.readStructEnd();
+    if (value.id === undefined) {
+      throw new TProtocolException('required field id of User is unset');
+    }
+    if (value.name === undefined) {
+      throw new TProtocolException('required field name of User is unset');
+    }
+    return value;
+  },
+
+  fromJSON(json: any): User {
+    const value: any = {};
+    if (json.id !== undefined && json.id !== null) {
+      value.id = i64FromJSON(json.id);
+    }
+    if (json.name !== undefined && json.name !== null) {
+      value.name = json.name;
+    }
+    if (json.active !== undefined && json.active !== null) {
+      value.active = json.active;
+    }
+    if (json.level !== undefined && json.level !== null) {
+      value.level = json.level;
+    }
+    if (json.rank !== undefined && json.rank !== null) {
+      value.rank = json.rank;
+    }
+    if (json.age !== undefined && json.age !== null) {
+      value.age = json.age;
+    }
+    if (json.score !== undefined && json.score !== null) {
+      value.score = doubleFromJSON(json.score);
+    }
+    if (json.avatar !== undefined && json.avatar !== null) {
+      value.avatar = json.avatar;
+    }
+    if (json.gender !== undefined && json.gender !== null) {
+      value.gender = json.gender;
+    }
+    if (json.tags !== undefined && json.tags !== null) {
+      value.tags = listFromJSON(json.tags);
+    }
+    if (json.roles !== undefined && json.roles !== null) {
+      value.roles = setFromJSON(json.roles);
+    }
+    if (json.counters !== undefined && json.counters !== null) {
+      value.counters = mapFromJSON(json.counters, undefined, (v0: any) => i64FromJSON(v0));
+    }
+    if (json.history !== undefined && json.history !== null) {
+      value.history = mapFromJSON(json.history, undefined, (v0: any) => listFromJSON(v0, (v1: any) => Address.fromJSON(v1)));
+    }
+    if (json.address !== undefined && json.address !== null) {
+      value.address = Address.fromJSON(json.address);
+    }
+    if (json.contact !== undefined && json.contact !== null) {
+      value.contact = Contact.fromJSON(json.contact);
+    }
+    if (json.friends !== undefined && json.friends !== null) {
+      value.friends = listFromJSON(json.friends, (v0: any) => i64FromJSON(v0));
+    }
+    if (json.location !== undefined && json.location !== null) {
+      value.location = Address.fromJSON(json.location);
+    }
+    Object.assign(value, Tracking.fromJSON(json));
+    Object.assign(value, PageReq.fromJSON(json));
+    if (json.nested !== undefined && json.nested !== null) {
+      value.nested = listFromJSON(json.nested, (v0: any) => mapFromJSON(v0, undefined, (v1: any) => setFromJSON(v1)));
+    }
+    return value;
+  },
+
+  toJSON(value: User): any {
+    const json: any = {};
+    if (value.id !== undefined && value.id !== null) {
+      json.id = i64ToJSON(value.id);
+    }
+    if (value.name !== undefined && value.name !== null) {
+      json.name = value.name;
+    }
+    if (value.active !== undefined && value.active !== null) {
+      json.active = value.active;
+    }
+    if (value.level !== undefined && value.level !== null) {
+      json.level = value.level;
+    }
+    if (value.rank !== undefined && value.rank !== null) {
+      json.rank = value.rank;
+    }
+    if (value.age !== undefined && value.age !== null) {
+      json.age = value.age;
+    }
+    if (value.score !== undefined && value.score !== null) {
+      json.score = value.score;
+    }
+    if (value.avatar !== undefined && value.avatar !== null) {
+      json.avatar = value.avatar;
+    }
+    if (value.gender !== undefined && value.gender !== null) {
+      json.gender = value.gender;
+    }
+    if (value.tags !== undefined && value.tags !== null) {
+      json.tags = value.tags;
+    }
+    if (value.roles !== undefined && value.roles !== null) {
+      json.roles = listToJSON(value.roles);
+    }
+    if (value.counters !== undefined && value.counters !== null) {
+      json.counters = mapToJSON(value.counters, (v0: any) => i64ToJSON(v0));
+    }
+    if (value.history !== undefined && value.history !== null) {
+      json.history = mapToJSON(value.history, (v0: any) => listToJSON(v0, (v1: any) => Address.toJSON(v1)));
+    }
+    if (value.address !== undefined && value.address !== null) {
+      json.address = Address.toJSON(value.address);
+    }
+    if (value.contact !== undefined && value.contact !== null) {
+      json.contact = Contact.toJSON(value.contact);
+    }
+    if (value.friends !== undefined && value.friends !== null) {
+      json.friends = listToJSON(value.friends, (v0: any) => i64ToJSON(v0));
+    }
+    if (value.location !== undefined && value.location !== null) {
+      json.location = Address.toJSON(value.location);
+    }
+    Object.assign(json, Tracking.toJSON(value as any));
+    Object.assign(json, PageReq.toJSON(value as any));
+    if (value.nested !== undefined && value.nested !== null) {
+      json.nested = listToJSON(value.nested, (v0: any) => mapToJSON(v0, (v1: any) => listToJSON(v1)));
+    }
+    return json;
+  },
+};
+
+/**
+ * IUserService 异步接口
+ * 用于 Axios 客户端等异步实现
+ */
+export interface IUserService {
+  getUser(id: bigint): Promise<User>;
+}
+export type IdList = Array<bigint>;
+export type Location = Address;
+export interface Contact {
+  email?: string | undefined;
+  phone?: string | undefined;
+}
+
+/**
+ * Contact 的编解码方法
+ */
+export const Contact = {
+  write(output: TProtocol, value: Contact): void {
+    let count = 0;
+    output.writeStructBegin('Contact');
+    if (value.email !== undefined && value.email !== null) {
+      output.writeFieldBegin('email', TType.STRING, 1);
+      output.writeString(value.email);
+      output.writeFieldEnd();
+      count++;
+    }
+    if (value.phone !== undefined && value.phone !== null) {
+      output.writeFieldBegin('phone', TType.STRING, 2);
+      output.writeString(value.phone);
+      output.writeFieldEnd();
+      count++;
+    }
+    if (count !== 1) {
+      throw new TProtocolException('union Contact must have exactly one field set, got ' + count);
+    }
+    output.writeFieldStop();
+    output.writeStructEnd();
+  },
+
+  read(input: TProtocol): Contact {
+    const value: any = {};
+    let count = 0;
+    input.readStructBegin();
+    for (;;) {
+      const field = input.readFieldBegin();
+      if (field.type === TType.STOP) {
+        break;
+      }
+      switch (field.id) {
+        case 1:
+          if (field.type === TType.STRING) {
+            value.email = input.readString();
+            count++;
+          } else {
+            input.skip(field.type);
+          }
+          break;
+        case 2:
+          if (field.type === TType.STRING) {
+            value.phone = input.readString();
+            count++;
+          } else {
+            input.skip(field.type);
+          }
+          break;
+        default:
+          input.skip(field.type);
+      }
+      input.readFieldEnd();
+    }
+    input.readStructEnd();
+    if (count !== 1) {
+      throw new TProtocolException('union Contact must have exactly one field set, got ' + count);
+    }
+    return value;
+  },
+
+  fromJSON(json: any): Contact {
+    const value: any = {};
+    if (json.email !== undefined && json.email !== null) {
+      value.email = json.email;
+    }
+    if (json.phone !== undefined && json.phone !== null) {
+      value.phone = json.phone;
+    }
+    return value;
+  },
+
+  toJSON(value: Contact): any {
+    const json: any = {};
+    if (value.email !== undefined && value.email !== null) {
+      json.email = value.email;
+    }
+    if (value.phone !== undefined && value.phone !== null) {
+      json.phone = value.phone;
+    }
+    return json;
+  },
+};
+export interface NotFound {
+  code: number;
+  message?: string | undefined;
+}
+
+/**
+ * NotFound 的编解码方法
+ */
+export const NotFound = {
+  write(output: TProtocol, value: NotFound): void {
+    output.writeStructBegin('NotFound');
+    if (value.code === undefined || value.code === null) {
+      throw new TProtocolException('required field code of NotFound is unset');
+    }
+    output.writeFieldBegin('code', TType.I32, 1);
+    output.writeI32(value.code);
+    output.writeFieldEnd();
+    if (value.message !== undefined && value.message !== null) {
+      output.writeFieldBegin('message', TType.STRING, 2);
+      output.writeString(value.message);
+      output.writeFieldEnd();
+    }
+    output.writeFieldStop();
+    output.writeStructEnd();
+  },
+
+  read(input: TProtocol): NotFound {
+    const value: any = {};
+    input.readStructBegin();
+    for (;;) {
+      const field = input.readFieldBegin();
+      if (field.type === TType.STOP) {
+        break;
+      }
+      switch (field.id) {
+        case 1:
+          if (field.type === TType.I32) {
+            value.code = input.readI32();
+          } else {
+            input.skip(field.type);
+          }
+          break;
+        case 2:
+          if (field.type === TType.STRING) {
+            value.message = input.readString();
+          } else {
+            input.skip(field.type);
+          }
+          break;
+        default:
+          input.skip(field.type);
+      }
+      input.readFieldEnd();
+    }
+    input.readStructEnd();
+    if (value.code === undefined) {
+      throw new TProtocolException('required field code of NotFound is unset');
+    }
+    return value;
+  },
+
+  fromJSON(json: any): NotFound {
+    const value: any = {};
+    if (json.code !== undefined && json.code !== null) {
+      value.code = json.code;
+    }
+    if (json.message !== undefined && json.message !== null) {
+      value.message = json.message;
+    }
+    return value;
+  },
+
+  toJSON(value: NotFound): any {
+    const json: any = {};
+    if (value.code !== undefined && value.code !== null) {
+      json.code = value.code;
+    }
+    if (value.message !== undefined && value.message !== null) {
+      json.message = value.message;
+    }
+    return json;
+  },
+};
\ No newline at end of file
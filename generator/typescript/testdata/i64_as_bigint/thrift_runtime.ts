@@ -0,0 +1,1095 @@
+"use strict";
+
+/* tslint:disable */
+/* eslint-disable */
+
+// Generated by thriftgo ?
+// DO NOT EDIT UNLESS YOU ARE SURE THAT YOU KNOW WHAT YOU ARE DOING
+
+/**
+ * Thrift 数据类型
+ */
+export enum TType {
+  STOP = 0,
+  VOID = 1,
+  BOOL = 2,
+  BYTE = 3,
+  DOUBLE = 4,
+  I16 = 6,
+  I32 = 8,
+  I64 = 10,
+  STRING = 11,
+  STRUCT = 12,
+  MAP = 13,
+  SET = 14,
+  LIST = 15,
+}
+
+/**
+ * Thrift 消息类型
+ */
+export enum TMessageType {
+  CALL = 1,
+  REPLY = 2,
+  EXCEPTION = 3,
+  ONEWAY = 4,
+}
+
+/**
+ * 编解码过程中的协议错误，如数据截断、类型不匹配或缺少 required 字段
+ */
+export class TProtocolException extends Error {
+  constructor(message: string) {
+    super(message);
+    this.name = 'TProtocolException';
+    Object.setPrototypeOf(this, TProtocolException.prototype);
+  }
+}
+
+export interface TMessage {
+  name: string;
+  type: TMessageType;
+  seqid: number;
+}
+
+export interface TField {
+  name: string;
+  type: TType;
+  id: number;
+}
+
+export interface TMap {
+  keyType: TType;
+  valueType: TType;
+  size: number;
+}
+
+export interface TList {
+  elemType: TType;
+  size: number;
+}
+
+/**
+ * 协议接口，生成代码中的 read/write 方法只依赖该接口
+ */
+export interface TProtocol {
+  writeMessageBegin(name: string, type: TMessageType, seqid: number): void;
+  writeMessageEnd(): void;
+  writeStructBegin(name: string): void;
+  writeStructEnd(): void;
+  writeFieldBegin(name: string, type: TType, id: number): void;
+  writeFieldEnd(): void;
+  writeFieldStop(): void;
+  writeMapBegin(keyType: TType, valueType: TType, size: number): void;
+  writeMapEnd(): void;
+  writeListBegin(elemType: TType, size: number): void;
+  writeListEnd(): void;
+  writeSetBegin(elemType: TType, size: number): void;
+  writeSetEnd(): void;
+  writeBool(value: boolean): void;
+  writeByte(value: number): void;
+  writeI16(value: number): void;
+  writeI32(value: number): void;
+  writeI64(value: number | bigint | string): void;
+  writeDouble(value: number): void;
+  writeString(value: string): void;
+  writeBinary(value: Uint8Array): void;
+
+  readMessageBegin(): TMessage;
+  readMessageEnd(): void;
+  readStructBegin(): void;
+  readStructEnd(): void;
+  readFieldBegin(): TField;
+  readFieldEnd(): void;
+  readMapBegin(): TMap;
+  readMapEnd(): void;
+  readListBegin(): TList;
+  readListEnd(): void;
+  readSetBegin(): TList;
+  readSetEnd(): void;
+  readBool(): boolean;
+  readByte(): number;
+  readI16(): number;
+  readI32(): number;
+  readI64(): bigint;
+  readDouble(): number;
+  readString(): string;
+  readBinary(): Uint8Array;
+
+  skip(type: TType): void;
+}
+
+/**
+ * 结构体、联合体和异常生成的编解码对象
+ */
+export interface TCodec<T> {
+  read(input: TProtocol): T;
+  write(output: TProtocol, value: T): void;
+}
+
+const textEncoder = new TextEncoder();
+const textDecoder = new TextDecoder();
+
+// skip 允许的最大嵌套深度，避免恶意数据导致栈溢出
+const MAX_SKIP_DEPTH = 64;
+
+/**
+ * 跳过一个指定类型的值，用于忽略未知字段
+ */
+export function skipValue(input: TProtocol, type: TType, depth: number = MAX_SKIP_DEPTH): void {
+  if (depth <= 0) {
+    throw new TProtocolException('maximum skip depth exceeded');
+  }
+  switch (type) {
+    case TType.BOOL:
+      input.readBool();
+      break;
+    case TType.BYTE:
+      input.readByte();
+      break;
+    case TType.I16:
+      input.readI16();
+      break;
+    case TType.I32:
+      input.readI32();
+      break;
+    case TType.I64:
+      input.readI64();
+      break;
+    case TType.DOUBLE:
+      input.readDouble();
+      break;
+    case TType.STRING:
+      input.readBinary();
+      break;
+    case TType.STRUCT:
+      input.readStructBegin();
+      for (;;) {
+        const field = input.readFieldBegin();
+        if (field.type === TType.STOP) {
+          break;
+        }
+        skipValue(input, field.type, depth - 1);
+        input.readFieldEnd();
+      }
+      input.readStructEnd();
+      break;
+    case TType.MAP: {
+      const map = input.readMapBegin();
+      for (let i = 0; i < map.size; i++) {
+        skipValue(input, map.keyType, depth - 1);
+        skipValue(input, map.valueType, depth - 1);
+      }
+      input.readMapEnd();
+      break;
+    }
+    case TType.SET: {
+      const set = input.readSetBegin();
+      for (let i = 0; i < set.size; i++) {
+        skipValue(input, set.elemType, depth - 1);
+      }
+      input.readSetEnd();
+      break;
+    }
+    case TType.LIST: {
+      const list = input.readListBegin();
+      for (let i = 0; i < list.size; i++) {
+        skipValue(input, list.elemType, depth - 1);
+      }
+      input.readListEnd();
+      break;
+    }
+    default:
+      throw new TProtocolException('unknown type ' + type);
+  }
+}
+
+/**
+ * 可自动扩容的写缓冲区
+ */
+export class TBufferWriter {
+  private buf: Uint8Array;
+  private view: DataView;
+  private pos = 0;
+
+  constructor(capacity: number = 256) {
+    this.buf = new Uint8Array(Math.max(capacity, 16));
+    this.view = new DataView(this.buf.buffer);
+  }
+
+  // 预留 n 个字节并返回写入位置，扩容会替换 buf 和 view，调用方需先取得位置再访问它们
+  private reserve(n: number): number {
+    const need = this.pos + n;
+    if (need > this.buf.length) {
+      let size = this.buf.length * 2;
+      while (size < need) {
+        size *= 2;
+      }
+      const buf = new Uint8Array(size);
+      buf.set(this.buf.subarray(0, this.pos));
+      this.buf = buf;
+      this.view = new DataView(buf.buffer);
+    }
+    const offset = this.pos;
+    this.pos = need;
+    return offset;
+  }
+
+  writeUint8(value: number): void {
+    const offset = this.reserve(1);
+    this.buf[offset] = value & 0xff;
+  }
+
+  writeInt16(value: number): void {
+    const offset = this.reserve(2);
+    this.view.setInt16(offset, value);
+  }
+
+  writeInt32(value: number): void {
+    const offset = this.reserve(4);
+    this.view.setInt32(offset, value);
+  }
+
+  writeBigInt64(value: bigint): void {
+    const offset = this.reserve(8);
+    this.view.setBigInt64(offset, value);
+  }
+
+  writeFloat64(value: number, littleEndian: boolean = false): void {
+    const offset = this.reserve(8);
+    this.view.setFloat64(offset, value, littleEndian);
+  }
+
+  writeBytes(value: Uint8Array): void {
+    const offset = this.reserve(value.length);
+    this.buf.set(value, offset);
+  }
+
+  bytes(): Uint8Array {
+    return this.buf.slice(0, this.pos);
+  }
+}
+
+/**
+ * 基于 Uint8Array 的读缓冲区
+ */
+export class TBufferReader {
+  private readonly buf: Uint8Array;
+  private readonly view: DataView;
+  private pos = 0;
+
+  constructor(buf: Uint8Array) {
+    this.buf = buf;
+    this.view = new DataView(buf.buffer, buf.byteOffset, buf.byteLength);
+  }
+
+  private advance(n: number): number {
+    if (n < 0 || this.pos + n > this.buf.length) {
+      throw new TProtocolException('unexpected end of buffer');
+    }
+    const offset = this.pos;
+    this.pos += n;
+    return offset;
+  }
+
+  readUint8(): number {
+    return this.buf[this.advance(1)];
+  }
+
+  readInt8(): number {
+    return this.view.getInt8(this.advance(1));
+  }
+
+  readInt16(): number {
+    return this.view.getInt16(this.advance(2));
+  }
+
+  readInt32(): number {
+    return this.view.getInt32(this.advance(4));
+  }
+
+  readBigInt64(): bigint {
+    return this.view.getBigInt64(this.advance(8));
+  }
+
+  readFloat64(littleEndian: boolean = false): number {
+    return this.view.getFloat64(this.advance(8), littleEndian);
+  }
+
+  readBytes(n: number): Uint8Array {
+    const offset = this.advance(n);
+    return this.buf.slice(offset, offset + n);
+  }
+
+  remaining(): number {
+    return this.buf.length - this.pos;
+  }
+}
+
+const BINARY_VERSION_1 = 0x80010000 | 0;
+const BINARY_VERSION_MASK = 0xffff0000 | 0;
+
+/**
+ * Thrift binary 协议，写入时使用 strict 模式，读取时兼容非 strict 的消息头
+ */
+export class TBinaryProtocol implements TProtocol {
+  private readonly reader: TBufferReader;
+  private readonly writer = new TBufferWriter();
+
+  constructor(input: Uint8Array = new Uint8Array(0)) {
+    this.reader = new TBufferReader(input);
+  }
+
+  /**
+   * 返回已写入的数据
+   */
+  getBytes(): Uint8Array {
+    return this.writer.bytes();
+  }
+
+  writeMessageBegin(name: string, type: TMessageType, seqid: number): void {
+    this.writer.writeInt32(BINARY_VERSION_1 | type);
+    this.writeString(name);
+    this.writer.writeInt32(seqid);
+  }
+
+  writeMessageEnd(): void {}
+
+  writeStructBegin(name: string): void {}
+
+  writeStructEnd(): void {}
+
+  writeFieldBegin(name: string, type: TType, id: number): void {
+    this.writer.writeUint8(type);
+    this.writer.writeInt16(id);
+  }
+
+  writeFieldEnd(): void {}
+
+  writeFieldStop(): void {
+    this.writer.writeUint8(TType.STOP);
+  }
+
+  writeMapBegin(keyType: TType, valueType: TType, size: number): void {
+    this.writer.writeUint8(keyType);
+    this.writer.writeUint8(valueType);
+    this.writer.writeInt32(size);
+  }
+
+  writeMapEnd(): void {}
+
+  writeListBegin(elemType: TType, size: number): void {
+    this.writer.writeUint8(elemType);
+    this.writer.writeInt32(size);
+  }
+
+  writeListEnd(): void {}
+
+  writeSetBegin(elemType: TType, size: number): void {
+    this.writeListBegin(elemType, size);
+  }
+
+  writeSetEnd(): void {}
+
+  writeBool(value: boolean): void {
+    this.writer.writeUint8(value ? 1 : 0);
+  }
+
+  writeByte(value: number): void {
+    this.writer.writeUint8(value);
+  }
+
+  writeI16(value: number): void {
+    this.writer.writeInt16(value);
+  }
+
+  writeI32(value: number): void {
+    this.writer.writeInt32(value);
+  }
+
+  writeI64(value: number | bigint | string): void {
+    this.writer.writeBigInt64(BigInt.asIntN(64, BigInt(value)));
+  }
+
+  writeDouble(value: number): void {
+    this.writer.writeFloat64(value);
+  }
+
+  writeString(value: string): void {
+    this.writeBinary(textEncoder.encode(value));
+  }
+
+  writeBinary(value: Uint8Array): void {
+    this.writer.writeInt32(value.length);
+    this.writer.writeBytes(value);
+  }
+
+  readMessageBegin(): TMessage {
+    const size = this.reader.readInt32();
+    if (size < 0) {
+      if ((size & BINARY_VERSION_MASK) !== BINARY_VERSION_1) {
+        throw new TProtocolException('bad version in readMessageBegin');
+      }
+      const type = size & 0xff;
+      const name = this.readString();
+      const seqid = this.reader.readInt32();
+      return { name, type, seqid };
+    }
+    const name = textDecoder.decode(this.reader.readBytes(size));
+    const type = this.reader.readUint8();
+    const seqid = this.reader.readInt32();
+    return { name, type, seqid };
+  }
+
+  readMessageEnd(): void {}
+
+  readStructBegin(): void {}
+
+  readStructEnd(): void {}
+
+  readFieldBegin(): TField {
+    const type = this.reader.readUint8();
+    if (type === TType.STOP) {
+      return { name: '', type, id: 0 };
+    }
+    return { name: '', type, id: this.reader.readInt16() };
+  }
+
+  readFieldEnd(): void {}
+
+  readMapBegin(): TMap {
+    const keyType = this.reader.readUint8();
+    const valueType = this.reader.readUint8();
+    const size = this.readSize();
+    return { keyType, valueType, size };
+  }
+
+  readMapEnd(): void {}
+
+  readListBegin(): TList {
+    const elemType = this.reader.readUint8();
+    const size = this.readSize();
+    return { elemType, size };
+  }
+
+  readListEnd(): void {}
+
+  readSetBegin(): TList {
+    return this.readListBegin();
+  }
+
+  readSetEnd(): void {}
+
+  readBool(): boolean {
+    return this.reader.readUint8() !== 0;
+  }
+
+  readByte(): number {
+    return this.reader.readInt8();
+  }
+
+  readI16(): number {
+    return this.reader.readInt16();
+  }
+
+  readI32(): number {
+    return this.reader.readInt32();
+  }
+
+  readI64(): bigint {
+    return this.reader.readBigInt64();
+  }
+
+  readDouble(): number {
+    return this.reader.readFloat64();
+  }
+
+  readString(): string {
+    return textDecoder.decode(this.readBinary());
+  }
+
+  readBinary(): Uint8Array {
+    return this.reader.readBytes(this.readSize());
+  }
+
+  skip(type: TType): void {
+    skipValue(this, type);
+  }
+
+  private readSize(): number {
+    const size = this.reader.readInt32();
+    if (size < 0) {
+      throw new TProtocolException('negative size ' + size);
+    }
+    return size;
+  }
+}
+
+const COMPACT_PROTOCOL_ID = 0x82;
+const COMPACT_VERSION = 1;
+const COMPACT_VERSION_MASK = 0x1f;
+const COMPACT_TYPE_SHIFT = 5;
+
+// compact 协议中的类型编号
+enum CompactType {
+  STOP = 0,
+  BOOLEAN_TRUE = 1,
+  BOOLEAN_FALSE = 2,
+  BYTE = 3,
+  I16 = 4,
+  I32 = 5,
+  I64 = 6,
+  DOUBLE = 7,
+  BINARY = 8,
+  LIST = 9,
+  SET = 10,
+  MAP = 11,
+  STRUCT = 12,
+}
+
+function toCompactType(type: TType): CompactType {
+  switch (type) {
+    case TType.STOP:
+      return CompactType.STOP;
+    case TType.BOOL:
+      return CompactType.BOOLEAN_TRUE;
+    case TType.BYTE:
+      return CompactType.BYTE;
+    case TType.I16:
+      return CompactType.I16;
+    case TType.I32:
+      return CompactType.I32;
+    case TType.I64:
+      return CompactType.I64;
+    case TType.DOUBLE:
+      return CompactType.DOUBLE;
+    case TType.STRING:
+      return CompactType.BINARY;
+    case TType.LIST:
+      return CompactType.LIST;
+    case TType.SET:
+      return CompactType.SET;
+    case TType.MAP:
+      return CompactType.MAP;
+    case TType.STRUCT:
+      return CompactType.STRUCT;
+  }
+  throw new TProtocolException('unsupported type ' + type);
+}
+
+function fromCompactType(type: number): TType {
+  switch (type) {
+    case CompactType.STOP:
+      return TType.STOP;
+    case CompactType.BOOLEAN_TRUE:
+    case CompactType.BOOLEAN_FALSE:
+      return TType.BOOL;
+    case CompactType.BYTE:
+      return TType.BYTE;
+    case CompactType.I16:
+      return TType.I16;
+    case CompactType.I32:
+      return TType.I32;
+    case CompactType.I64:
+      return TType.I64;
+    case CompactType.DOUBLE:
+      return TType.DOUBLE;
+    case CompactType.BINARY:
+      return TType.STRING;
+    case CompactType.LIST:
+      return TType.LIST;
+    case CompactType.SET:
+      return TType.SET;
+    case CompactType.MAP:
+      return TType.MAP;
+    case CompactType.STRUCT:
+      return TType.STRUCT;
+  }
+  throw new TProtocolException('unknown compact type ' + type);
+}
+
+const BIG_1 = BigInt(1);
+const BIG_7 = BigInt(7);
+const BIG_63 = BigInt(63);
+const BIG_0x7F = BigInt(0x7f);
+
+/**
+ * Thrift compact 协议
+ */
+export class TCompactProtocol implements TProtocol {
+  private readonly reader: TBufferReader;
+  private readonly writer = new TBufferWriter();
+  private lastFieldId = 0;
+  private readonly lastFieldIds: number[] = [];
+  // 写入 bool 字段时字段头与值合并，先记录字段 ID
+  private boolFieldId: number | null = null;
+  // 读取 bool 字段时值已包含在字段头中
+  private boolValue: boolean | null = null;
+
+  constructor(input: Uint8Array = new Uint8Array(0)) {
+    this.reader = new TBufferReader(input);
+  }
+
+  /**
+   * 返回已写入的数据
+   */
+  getBytes(): Uint8Array {
+    return this.writer.bytes();
+  }
+
+  writeMessageBegin(name: string, type: TMessageType, seqid: number): void {
+    this.writer.writeUint8(COMPACT_PROTOCOL_ID);
+    this.writer.writeUint8((COMPACT_VERSION & COMPACT_VERSION_MASK) | ((type << COMPACT_TYPE_SHIFT) & 0xe0));
+    this.writeVarint32(seqid);
+    this.writeString(name);
+  }
+
+  writeMessageEnd(): void {}
+
+  writeStructBegin(name: string): void {
+    this.lastFieldIds.push(this.lastFieldId);
+    this.lastFieldId = 0;
+  }
+
+  writeStructEnd(): void {
+    this.lastFieldId = this.lastFieldIds.pop() || 0;
+  }
+
+  writeFieldBegin(name: string, type: TType, id: number): void {
+    if (type === TType.BOOL) {
+      this.boolFieldId = id;
+      return;
+    }
+    this.writeFieldHeader(toCompactType(type), id);
+  }
+
+  writeFieldEnd(): void {}
+
+  writeFieldStop(): void {
+    this.writer.writeUint8(CompactType.STOP);
+  }
+
+  writeMapBegin(keyType: TType, valueType: TType, size: number): void {
+    if (size === 0) {
+      this.writer.writeUint8(0);
+      return;
+    }
+    this.writeVarint32(size);
+    this.writer.writeUint8((toCompactType(keyType) << 4) | toCompactType(valueType));
+  }
+
+  writeMapEnd(): void {}
+
+  writeListBegin(elemType: TType, size: number): void {
+    if (size <= 14) {
+      this.writer.writeUint8((size << 4) | toCompactType(elemType));
+      return;
+    }
+    this.writer.writeUint8(0xf0 | toCompactType(elemType));
+    this.writeVarint32(size);
+  }
+
+  writeListEnd(): void {}
+
+  writeSetBegin(elemType: TType, size: number): void {
+    this.writeListBegin(elemType, size);
+  }
+
+  writeSetEnd(): void {}
+
+  writeBool(value: boolean): void {
+    const type = value ? CompactType.BOOLEAN_TRUE : CompactType.BOOLEAN_FALSE;
+    if (this.boolFieldId !== null) {
+      this.writeFieldHeader(type, this.boolFieldId);
+      this.boolFieldId = null;
+      return;
+    }
+    this.writer.writeUint8(type);
+  }
+
+  writeByte(value: number): void {
+    this.writer.writeUint8(value);
+  }
+
+  writeI16(value: number): void {
+    this.writeVarint32((value << 1) ^ (value >> 31));
+  }
+
+  writeI32(value: number): void {
+    this.writeVarint32((value << 1) ^ (value >> 31));
+  }
+
+  writeI64(value: number | bigint | string): void {
+    const n = BigInt.asIntN(64, BigInt(value));
+    let v = BigInt.asUintN(64, (n << BIG_1) ^ (n >> BIG_63));
+    while (v > BIG_0x7F) {
+      this.writer.writeUint8(Number(v & BIG_0x7F) | 0x80);
+      v >>= BIG_7;
+    }
+    this.writer.writeUint8(Number(v));
+  }
+
+  writeDouble(value: number): void {
+    this.writer.writeFloat64(value, true);
+  }
+
+  writeString(value: string): void {
+    this.writeBinary(textEncoder.encode(value));
+  }
+
+  writeBinary(value: Uint8Array): void {
+    this.writeVarint32(value.length);
+    this.writer.writeBytes(value);
+  }
+
+  readMessageBegin(): TMessage {
+    const protocolId = this.reader.readUint8();
+    if (protocolId !== COMPACT_PROTOCOL_ID) {
+      throw new TProtocolException('bad protocol id ' + protocolId);
+    }
+    const versionAndType = this.reader.readUint8();
+    if ((versionAndType & COMPACT_VERSION_MASK) !== COMPACT_VERSION) {
+      throw new TProtocolException('bad version in readMessageBegin');
+    }
+    const type = (versionAndType >> COMPACT_TYPE_SHIFT) & 0x07;
+    const seqid = this.readVarint32() | 0;
+    const name = this.readString();
+    return { name, type, seqid };
+  }
+
+  readMessageEnd(): void {}
+
+  readStructBegin(): void {
+    this.lastFieldIds.push(this.lastFieldId);
+    this.lastFieldId = 0;
+  }
+
+  readStructEnd(): void {
+    this.lastFieldId = this.lastFieldIds.pop() || 0;
+  }
+
+  readFieldBegin(): TField {
+    const header = this.reader.readUint8();
+    const compactType = header & 0x0f;
+    if (compactType === CompactType.STOP) {
+      return { name: '', type: TType.STOP, id: 0 };
+    }
+    const delta = (header & 0xf0) >> 4;
+    const id = delta === 0 ? this.readI16() : this.lastFieldId + delta;
+    if (compactType === CompactType.BOOLEAN_TRUE || compactType === CompactType.BOOLEAN_FALSE) {
+      this.boolValue = compactType === CompactType.BOOLEAN_TRUE;
+    }
+    this.lastFieldId = id;
+    return { name: '', type: fromCompactType(compactType), id };
+  }
+
+  readFieldEnd(): void {}
+
+  readMapBegin(): TMap {
+    const size = this.readVarint32();
+    const types = size === 0 ? 0 : this.reader.readUint8();
+    return {
+      keyType: size === 0 ? TType.STOP : fromCompactType(types >> 4),
+      valueType: size === 0 ? TType.STOP : fromCompactType(types & 0x0f),
+      size,
+    };
+  }
+
+  readMapEnd(): void {}
+
+  readListBegin(): TList {
+    const header = this.reader.readUint8();
+    let size = (header >> 4) & 0x0f;
+    if (size === 15) {
+      size = this.readVarint32();
+    }
+    return { elemType: fromCompactType(header & 0x0f), size };
+  }
+
+  readListEnd(): void {}
+
+  readSetBegin(): TList {
+    return this.readListBegin();
+  }
+
+  readSetEnd(): void {}
+
+  readBool(): boolean {
+    if (this.boolValue !== null) {
+      const value = this.boolValue;
+      this.boolValue = null;
+      return value;
+    }
+    return this.reader.readUint8() === CompactType.BOOLEAN_TRUE;
+  }
+
+  readByte(): number {
+    return this.reader.readInt8();
+  }
+
+  readI16(): number {
+    return (this.readI32() << 16) >> 16;
+  }
+
+  readI32(): number {
+    const n = this.readVarint32();
+    return (n >>> 1) ^ -(n & 1);
+  }
+
+  readI64(): bigint {
+    let result = BigInt(0);
+    let shift = BigInt(0);
+    for (let i = 0; ; i++) {
+      if (i >= 10) {
+        throw new TProtocolException('varint64 too long');
+      }
+      const b = this.reader.readUint8();
+      result |= BigInt(b & 0x7f) << shift;
+      if ((b & 0x80) === 0) {
+        break;
+      }
+      shift += BIG_7;
+    }
+    return BigInt.asIntN(64, (result >> BIG_1) ^ -(result & BIG_1));
+  }
+
+  readDouble(): number {
+    return this.reader.readFloat64(true);
+  }
+
+  readString(): string {
+    return textDecoder.decode(this.readBinary());
+  }
+
+  readBinary(): Uint8Array {
+    return this.reader.readBytes(this.readVarint32());
+  }
+
+  skip(type: TType): void {
+    skipValue(this, type);
+  }
+
+  private writeFieldHeader(type: CompactType, id: number): void {
+    const delta = id - this.lastFieldId;
+    if (delta > 0 && delta <= 15) {
+      this.writer.writeUint8((delta << 4) | type);
+    } else {
+      this.writer.writeUint8(type);
+      this.writeI16(id);
+    }
+    this.lastFieldId = id;
+  }
+
+  private writeVarint32(value: number): void {
+    let n = value >>> 0;
+    while (n > 0x7f) {
+      this.writer.writeUint8((n & 0x7f) | 0x80);
+      n >>>= 7;
+    }
+    this.writer.writeUint8(n);
+  }
+
+  private readVarint32(): number {
+    let result = 0;
+    for (let shift = 0; ; shift += 7) {
+      if (shift > 28) {
+        throw new TProtocolException('varint32 too long');
+      }
+      const b = this.reader.readUint8();
+      result |= (b & 0x7f) << shift;
+      if ((b & 0x80) === 0) {
+        break;
+      }
+    }
+    return result >>> 0;
+  }
+}
+
+/**
+ * 支持的协议名称
+ */
+export type TProtocolName = 'binary' | 'compact';
+
+/**
+ * 创建指定协议的实例，data 为需要读取的数据
+ */
+export function createProtocol(protocol: TProtocolName, data?: Uint8Array): TBinaryProtocol | TCompactProtocol {
+  return protocol === 'compact' ? new TCompactProtocol(data) : new TBinaryProtocol(data);
+}
+
+/**
+ * 使用生成的编解码对象把值编码为字节数组
+ */
+export function serialize<T>(codec: TCodec<T>, value: T, protocol: TProtocolName = 'binary'): Uint8Array {
+  const output = createProtocol(protocol);
+  codec.write(output, value);
+  return output.getBytes();
+}
+
+/**
+ * 使用生成的编解码对象从字节数组解码
+ */
+export function deserialize<T>(codec: TCodec<T>, data: Uint8Array, protocol: TProtocolName = 'binary'): T {
+  return codec.read(createProtocol(protocol, data));
+}
+
+/**
+ * i64 在生成代码中的类型
+ */
+export type I64 = bigint;
+
+/**
+ * JSON 转换过程中的错误，如类型不匹配或 i64 越界
+ */
+export class TJSONException extends Error {
+  constructor(message: string) {
+    super(message);
+    this.name = 'TJSONException';
+  }
+}
+
+const I64_MIN = BigInt('-9223372036854775808');
+const I64_MAX = BigInt('9223372036854775807');
+
+/**
+ * 解析 JSON 文本，超出安全整数范围的整数保留为字符串，避免 JSON.parse 丢失精度
+ */
+export function parseJSON(text: string): any {
+  let out = '';
+  let start = 0;
+  let i = 0;
+  while (i < text.length) {
+    const c = text[i];
+    if (c === '"') {
+      for (i++; i < text.length && text[i] !== '"'; i++) {
+        if (text[i] === '\\') {
+          i++;
+        }
+      }
+      i++;
+    } else if (c === '-' || (c >= '0' && c <= '9')) {
+      const begin = i;
+      let integer = true;
+      for (i++; i < text.length; i++) {
+        const d = text[i];
+        if (d === '.' || d === 'e' || d === 'E' || d === '+' || d === '-') {
+          integer = false;
+        } else if (d < '0' || d > '9') {
+          break;
+        }
+      }
+      const literal = text.slice(begin, i);
+      if (integer && !Number.isSafeInteger(Number(literal))) {
+        out += text.slice(start, begin) + '"' + literal + '"';
+        start = i;
+      }
+    } else {
+      i++;
+    }
+  }
+  return JSON.parse(out + text.slice(start));
+}
+
+/**
+ * 把 JSON 中的 number、数字字符串或 bigint 无损地转换为 i64
+ */
+export function i64FromJSON(value: unknown): I64 {
+  let n: bigint;
+  if (typeof value === 'bigint') {
+    n = value;
+  } else if (typeof value === 'number' && Number.isInteger(value)) {
+    n = BigInt(value);
+  } else if (typeof value === 'string' && /^[+-]?\d+$/.test(value)) {
+    n = BigInt(value);
+  } else {
+    throw new TJSONException('invalid i64 value: ' + String(value));
+  }
+  if (n < I64_MIN || n > I64_MAX) {
+    throw new TJSONException('i64 value out of range: ' + String(value));
+  }
+  return n;
+}
+
+/**
+ * 规范化以 i64 为键的 map 的键
+ */
+export function i64KeyFromJSON(key: string): string {
+  return String(i64FromJSON(key));
+}
+
+/**
+ * 把 i64 转换为 JSON 中的十进制字符串
+ */
+export function i64ToJSON(value: number | bigint | string): string {
+  return String(i64FromJSON(value));
+}
+
+/**
+ * 把 JSON 中的 number 或 parseJSON 保留下来的数字字符串转换为 double
+ */
+export function doubleFromJSON(value: unknown): number {
+  if (typeof value === 'number') {
+    return value;
+  }
+  if (typeof value === 'string' && value.trim() !== '') {
+    const n = Number(value);
+    if (!Number.isNaN(n) || value === 'NaN') {
+      return n;
+    }
+  }
+  throw new TJSONException('invalid double value: ' + String(value));
+}
+
+/**
+ * 把 JSON 数组转换为 list，convert 用于转换每个元素
+ */
+export function listFromJSON<T>(value: unknown, convert?: (v: any) => T): T[] {
+  if (!Array.isArray(value)) {
+    throw new TJSONException('expect an array, got ' + typeof value);
+  }
+  return convert ? value.map((v) => convert(v)) : value;
+}
+
+/**
+ * 把 JSON 数组转换为 set，convert 用于转换每个元素
+ */
+export function setFromJSON<T>(value: unknown, convert?: (v: any) => T): Set<T> {
+  return new Set(listFromJSON(value, convert));
+}
+
+/**
+ * 把 JSON 对象转换为 map，convertKey 和 convertValue 分别用于转换键和值
+ */
+export function mapFromJSON<V>(
+  value: unknown,
+  convertKey?: (k: string) => string,
+  convertValue?: (v: any) => V,
+): { [key: string]: V } {
+  if (value === null || typeof value !== 'object' || Array.isArray(value)) {
+    throw new TJSONException('expect an object, got ' + (Array.isArray(value) ? 'array' : typeof value));
+  }
+  const result: { [key: string]: V } = {};
+  for (const k of Object.keys(value)) {
+    const v = (value as any)[k];
+    result[convertKey ? convertKey(k) : k] = convertValue ? convertValue(v) : v;
+  }
+  return result;
+}
+
+/**
+ * 把 list 或 set 转换为 JSON 数组，convert 用于转换每个元素
+ */
+export function listToJSON<T>(value: Iterable<T>, convert?: (v: T) => any): any[] {
+  return Array.from(value, (v) => (convert ? convert(v) : v));
+}
+
+/**
+ * 把 map 转换为 JSON 对象，convert 用于转换每个值
+ */
+export function mapToJSON<V>(value: { [key: string]: V }, convert: (v: V) => any): { [key: string]: any } {
+  const result: { [key: string]: any } = {};
+  for (const k of Object.keys(value)) {
+    result[k] = convert(value[k]);
+  }
+  return result;
+}
\ No newline at end of file
@@ -0,0 +1,306 @@
+"use strict";
+
+
+// Generated by thriftgo ?
+// DO NOT EDIT UNLESS YOU ARE SURE THAT YOU KNOW WHAT YOU ARE DOING
+
+import { PageReq } from './common/base';
+import { i64FromJSON, i64KeyFromJSON, i64ToJSON, doubleFromJSON, listFromJSON, setFromJSON, mapFromJSON, listToJSON, mapToJSON } from './thrift_runtime';
+
+export enum Gender {
+  UNKNOWN = 0,
+  MALE = 1,
+  FEMALE = 2,
+}
+
+export interface Address {
+  city: string;
+  street?: string | undefined;
+}
+
+/**
+ * Address 的编解码方法
+ */
+export const Address = {
+  fromJSON(json: any): Address {
+    const value: any = {};
+    if (json.city !== undefined && json.city !== null) {
+      value.city = json.city;
+    }
+    if (json.street !== undefined && json.street !== null) {
+      value.street = json.street;
+    }
+    return value;
+  },
+
+  toJSON(value: Address): any {
+    const json: any = {};
+    if (value.city !== undefined && value.city !== null) {
+      json.city = value.city;
+    }
+    if (value.street !== undefined && value.street !== null) {
+      json.street = value.street;
+    }
+    return json;
+  },
+};
+
+export interface Tracking {
+  traceId?: string | undefined;
+}
+
+/**
+ * Tracking 的编解码方法
+ */
+export const Tracking = {
+  fromJSON(json: any): Tracking {
+    const value: any = {};
+    if (json.traceId !== undefined && json.traceId !== null) {
+      value.traceId = json.traceId;
+    }
+    return value;
+  },
+
+  toJSON(value: Tracking): any {
+    const json: any = {};
+    if (value.traceId !== undefined && value.traceId !== null) {
+      json.traceId = value.traceId;
+    }
+    return json;
+  },
+};
+
+
+/**
+ * 覆盖所有 Thrift 类型的结构体
+ */
+export interface User {
+  id: string;
+  name: string;
+  active?: boolean | undefined;
+  level?: number | undefined;
+  rank?: number | undefined;
+  age?: number | undefined;
+  score?: number | undefined;
+  avatar?: Uint8Array | undefined;
+  gender?: Gender | undefined;
+  tags?: Array<string> | undefined;
+  roles?: Set<number> | undefined;
+  counters?: { [key: string]: string } | undefined;
+  history?: { [key: number]: Array<Address> } | undefined;
+  address?: Address | undefined;
+  contact?: Contact | undefined;
+  friends?: Array<any> | undefined;
+  location?: Location | undefined;
+  nested?: Array<{ [key: string]: Set<boolean> }>;
+  traceId?: string | undefined;
+
+/**
+ * 页码
+ */
+  pageNum?: number;
+
+/**
+ * 每页大小
+ */
+  pageSize?: number;
+}
+
+/**
+ * User 的编解码方法
+ */
+export const User = {
+  fromJSON(json: any): User {
+    const value: any = {};
+    if (json.id !== undefined && json.id !== null) {
+      value.id = i64FromJSON(json.id);
+    }
+    if (json.name !== undefined && json.name !== null) {
+      value.name = json.name;
+    }
+    if (json.active !== undefined && json.active !== null) {
+      value.active = json.active;
+    }
+    if (json.level !== undefined && json.level !== null) {
+      value.level = json.level;
+    }
+    if (json.rank !== undefined && json.rank !== null) {
+      value.rank = json.rank;
+    }
+    if (json.age !== undefined && json.age !== null) {
+      value.age = json.age;
+    }
+    if (json.score !== undefined && json.score !== null) {
+      value.score = doubleFromJSON(json.score);
+    }
+    if (json.avatar !== undefined && json.avatar !== null) {
+      value.avatar = json.avatar;
+    }
+    if (json.gender !== undefined && json.gender !== null) {
+      value.gender = json.gender;
+    }
+    if (json.tags !== undefined && json.tags !== null) {
+      value.tags = listFromJSON(json.tags);
+    }
+    if (json.roles !== undefined && json.roles !== null) {
+      value.roles = setFromJSON(json.roles);
+    }
+    if (json.counters !== undefined && json.counters !== null) {
+      value.counters = mapFromJSON(json.counters, undefined, (v0: any) => i64FromJSON(v0));
+    }
+    if (json.history !== undefined && json.history !== null) {
+      value.history = mapFromJSON(json.history, undefined, (v0: any) => listFromJSON(v0, (v1: any) => Address.fromJSON(v1)));
+    }
+    if (json.address !== undefined && json.address !== null) {
+      value.address = Address.fromJSON(json.address);
+    }
+    if (json.contact !== undefined && json.contact !== null) {
+      value.contact = Contact.fromJSON(json.contact);
+    }
+    if (json.friends !== undefined && json.friends !== null) {
+      value.friends = listFromJSON(json.friends, (v0: any) => i64FromJSON(v0));
+    }
+    if (json.location !== undefined && json.location !== null) {
+      value.location = Address.fromJSON(json.location);
+    }
+    Object.assign(value, Tracking.fromJSON(json));
+    Object.assign(value, PageReq.fromJSON(json));
+    if (json.nested !== undefined && json.nested !== null) {
+      value.nested = listFromJSON(json.nested, (v0: any) => mapFromJSON(v0, undefined, (v1: any) => setFromJSON(v1)));
+    }
+    return value;
+  },
+
+  toJSON(value: User): any {
+    const json: any = {};
+    if (value.id !== undefined && value.id !== null) {
+      json.id = i64ToJSON(value.id);
+    }
+    if (value.name !== undefined && value.name !== null) {
+      json.name = value.name;
+    }
+    if (value.active !== undefined && value.active !== null) {
+      json.active = value.active;
+    }
+    if (value.level !== undefined && value.level !== null) {
+      json.level = value.level;
+    }
+    if (value.rank !== undefined && value.rank !== null) {
+      json.rank = value.rank;
+    }
+    if (value.age !== undefined && value.age !== null) {
+      json.age = value.age;
+    }
+    if (value.score !== undefined && value.score !== null) {
+      json.score = value.score;
+    }
+    if (value.avatar !== undefined && value.avatar !== null) {
+      json.avatar = value.avatar;
+    }
+    if (value.gender !== undefined && value.gender !== null) {
+      json.gender = value.gender;
+    }
+    if (value.tags !== undefined && value.tags !== null) {
+      json.tags = value.tags;
+    }
+    if (value.roles !== undefined && value.roles !== null) {
+      json.roles = listToJSON(value.roles);
+    }
+    if (value.counters !== undefined && value.counters !== null) {
+      json.counters = mapToJSON(value.counters, (v0: any) => i64ToJSON(v0));
+    }
+    if (value.history !== undefined && value.history !== null) {
+      json.history = mapToJSON(value.history, (v0: any) => listToJSON(v0, (v1: any) => Address.toJSON(v1)));
+    }
+    if (value.address !== undefined && value.address !== null) {
+      json.address = Address.toJSON(value.address);
+    }
+    if (value.contact !== undefined && value.contact !== null) {
+      json.contact = Contact.toJSON(value.contact);
+    }
+    if (value.friends !== undefined && value.friends !== null) {
+      json.friends = listToJSON(value.friends, (v0: any) => i64ToJSON(v0));
+    }
+    if (value.location !== undefined && value.location !== null) {
+      json.location = Address.toJSON(value.location);
+    }
+    Object.assign(json, Tracking.toJSON(value as any));
+    Object.assign(json, PageReq.toJSON(value as any));
+    if (value.nested !== undefined && value.nested !== null) {
+      json.nested = listToJSON(value.nested, (v0: any) => mapToJSON(v0, (v1: any) => listToJSON(v1)));
+    }
+    return json;
+  },
+};
+
+/**
+ * IUserService 异步接口
+ * 用于 Axios 客户端等异步实现
+ */
+export interface IUserService {
+  getUser(id: string): Promise<User>;
+}
+export type IdList = Array<string>;
+export type Location = Address;
+export interface Contact {
+  email?: string | undefined;
+  phone?: string | undefined;
+}
+
+/**
+ * Contact 的编解码方法
+ */
+export const Contact = {
+  fromJSON(json: any): Contact {
+    const value: any = {};
+    if (json.email !== undefined && json.email !== null) {
+      value.email = json.email;
+    }
+    if (json.phone !== undefined && json.phone !== null) {
+      value.phone = json.phone;
+    }
+    return value;
+  },
+
+  toJSON(value: Contact): any {
+    const json: any = {};
+    if (value.email !== undefined && value.email !== null) {
+      json.email = value.email;
+    }
+    if (value.phone !== undefined && value.phone !== null) {
+      json.phone = value.phone;
+    }
+    return json;
+  },
+};
+export interface NotFound {
+  code: number;
+  message?: string | undefined;
+}
+
+/**
+ * NotFound 的编解码方法
+ */
+export const NotFound = {
+  fromJSON(json: any): NotFound {
+    const value: any = {};
+    if (json.code !== undefined && json.code !== null) {
+      value.code = json.code;
+    }
+    if (json.message !== undefined && json.message !== null) {
+      value.message = json.message;
+    }
+    return value;
+  },
+
+  toJSON(value: NotFound): any {
+    const json: any = {};
+    if (value.code !== undefined && value.code !== null) {
+      json.code = value.code;
+    }
+    if (value.message !== undefined && value.message !== null) {
+      json.message = value.message;
+    }
+    return json;
+  },
+};
\ No newline at end of file
@@ -0,0 +1,6 @@
+"use strict";
+
+// Generated by thriftgo ?
+// DO NOT EDIT UNLESS YOU ARE SURE THAT YOU KNOW WHAT YOU ARE DOING
+export type { PageReq } from './pagereq';
+export { mockPageReq } from './pagereq';
\ No newline at end of file
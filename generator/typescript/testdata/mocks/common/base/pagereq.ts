@@ -0,0 +1,34 @@
+"use strict";
+
+// Generated by thriftgo ?
+// DO NOT EDIT UNLESS YOU ARE SURE THAT YOU KNOW WHAT YOU ARE DOING
+import { MockContext } from '../../mock_runtime';
+
+
+/**
+ * 分页请求结构体
+ */
+export interface PageReq {
+
+/**
+ * 页码
+ */
+  pageNum?: number;
+
+/**
+ * 每页大小
+ */
+  pageSize?: number;
+}
+
+/**
+ * 生成 PageReq 的测试数据，overrides 中的属性会覆盖生成的值
+ */
+export function mockPageReq(overrides?: Partial<PageReq>, ctx: MockContext = new MockContext()): PageReq {
+  const value: any = {};
+  ctx.nested(() => {
+    value.pageNum = ctx.int(0, 10000);
+    value.pageSize = ctx.int(0, 10000);
+  });
+  return Object.assign(value, overrides) as PageReq;
+}
\ No newline at end of file
@@ -0,0 +1,191 @@
+"use strict";
+
+
+// Generated by thriftgo ?
+// DO NOT EDIT UNLESS YOU ARE SURE THAT YOU KNOW WHAT YOU ARE DOING
+
+import type { PageReq } from './common/base';
+import { MockContext } from './mock_runtime';
+import { mockPageReq } from './common/base';
+
+export enum Gender {
+  UNKNOWN = 0,
+  MALE = 1,
+  FEMALE = 2,
+}
+
+/**
+ * 生成 Gender 的测试数据，指定 override 时直接返回
+ */
+export function mockGender(override?: Gender, ctx: MockContext = new MockContext()): Gender {
+  return override !== undefined ? override : ctx.pick([Gender.UNKNOWN, Gender.MALE, Gender.FEMALE]);
+}
+
+export interface Address {
+  city: string;
+  street?: string | undefined;
+}
+
+/**
+ * 生成 Address 的测试数据，overrides 中的属性会覆盖生成的值
+ */
+export function mockAddress(overrides?: Partial<Address>, ctx: MockContext = new MockContext()): Address {
+  const value: any = {};
+  ctx.nested(() => {
+    value.city = ctx.string('city');
+    value.street = ctx.string('street');
+  });
+  return Object.assign(value, overrides) as Address;
+}
+
+export interface Tracking {
+  traceId?: string | undefined;
+}
+
+/**
+ * 生成 Tracking 的测试数据，overrides 中的属性会覆盖生成的值
+ */
+export function mockTracking(overrides?: Partial<Tracking>, ctx: MockContext = new MockContext()): Tracking {
+  const value: any = {};
+  ctx.nested(() => {
+    value.traceId = ctx.string('trace_id');
+  });
+  return Object.assign(value, overrides) as Tracking;
+}
+
+
+/**
+ * 覆盖所有 Thrift 类型的结构体
+ */
+export interface User {
+  id: number;
+  name: string;
+  active?: boolean | undefined;
+  level?: number | undefined;
+  rank?: number | undefined;
+  age?: number | undefined;
+  score?: number | undefined;
+  avatar?: Uint8Array | undefined;
+  gender?: Gender | undefined;
+  tags?: Array<string> | undefined;
+  roles?: Set<number> | undefined;
+  counters?: { [key: string]: number } | undefined;
+  history?: { [key: number]: Array<Address> } | undefined;
+  address?: Address | undefined;
+  contact?: Contact | undefined;
+  friends?: Array<any> | undefined;
+  location?: Location | undefined;
+  nested?: Array<{ [key: string]: Set<boolean> }>;
+  traceId?: string | undefined;
+
+/**
+ * 页码
+ */
+  pageNum?: number;
+
+/**
+ * 每页大小
+ */
+  pageSize?: number;
+}
+
+/**
+ * 生成 User 的测试数据，overrides 中的属性会覆盖生成的值
+ */
+export function mockUser(overrides?: Partial<User>, ctx: MockContext = new MockContext()): User {
+  const value: any = {};
+  ctx.nested(() => {
+    value.id = ctx.int(0, 1000000);
+    value.name = ctx.string('name');
+    value.active = ctx.bool();
+    value.level = ctx.int(0, 127);
+    value.rank = ctx.int(0, 10000);
+    value.age = ctx.int(0, 10000);
+    value.score = ctx.double();
+    value.avatar = ctx.bytes();
+    value.gender = mockGender(undefined, ctx);
+    value.tags = ctx.list(() => ctx.string('tags'));
+    value.roles = new Set(ctx.list(() => ctx.int(0, 10000)));
+    value.counters = ctx.record(() => ctx.string('counters'), () => ctx.int(0, 1000000));
+    value.history = ctx.record(() => ctx.int(0, 10000), () => ctx.list(() => mockAddress(undefined, ctx)));
+    if (!ctx.atLimit()) {
+      value.address = mockAddress(undefined, ctx);
+    }
+    if (!ctx.atLimit()) {
+      value.contact = mockContact(undefined, ctx);
+    }
+    value.friends = ctx.list(() => ctx.int(0, 1000000));
+    if (!ctx.atLimit()) {
+      value.location = mockLocation(undefined, ctx);
+    }
+    Object.assign(value, mockTracking(undefined, ctx));
+    Object.assign(value, mockPageReq(undefined, ctx));
+    value.nested = ctx.list(() => ctx.record(() => ctx.string('nested'), () => new Set(ctx.list(() => ctx.bool()))));
+  });
+  return Object.assign(value, overrides) as User;
+}
+
+/**
+ * IUserService 异步接口
+ * 用于 Axios 客户端等异步实现
+ */
+export interface IUserService {
+  getUser(id: number): Promise<User>;
+}
+export type IdList = Array<number>;
+
+/**
+ * 生成 IdList 的测试数据，指定 override 时直接返回
+ */
+export function mockIdList(override?: IdList, ctx: MockContext = new MockContext()): IdList {
+  return override !== undefined ? override : ctx.list(() => ctx.int(0, 1000000));
+}
+export type Location = Address;
+
+/**
+ * 生成 Location 的测试数据，指定 override 时直接返回
+ */
+export function mockLocation(override?: Location, ctx: MockContext = new MockContext()): Location {
+  return override !== undefined ? override : mockAddress(undefined, ctx);
+}
+export interface Contact {
+  email?: string | undefined;
+  phone?: string | undefined;
+}
+
+/**
+ * 生成 Contact 的测试数据，overrides 中的属性会覆盖生成的值
+ */
+export function mockContact(overrides?: Partial<Contact>, ctx: MockContext = new MockContext()): Contact {
+  if (overrides && Object.keys(overrides).length > 0) {
+    return { ...overrides } as Contact;
+  }
+  const value: any = {};
+  ctx.nested(() => {
+    switch (ctx.atLimit() ? 0 : ctx.int(0, 1)) {
+      case 0:
+        value.email = ctx.string('email');
+        break;
+      case 1:
+        value.phone = ctx.string('phone');
+        break;
+    }
+  });
+  return Object.assign(value, overrides) as Contact;
+}
+export interface NotFound {
+  code: number;
+  message?: string | undefined;
+}
+
+/**
+ * 生成 NotFound 的测试数据，overrides 中的属性会覆盖生成的值
+ */
+export function mockNotFound(overrides?: Partial<NotFound>, ctx: MockContext = new MockContext()): NotFound {
+  const value: any = {};
+  ctx.nested(() => {
+    value.code = ctx.int(0, 10000);
+    value.message = ctx.string('message');
+  });
+  return Object.assign(value, overrides) as NotFound;
+}
\ No newline at end of file
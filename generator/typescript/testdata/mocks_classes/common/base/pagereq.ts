@@ -0,0 +1,68 @@
+"use strict";
+
+// Generated by thriftgo ?
+// DO NOT EDIT UNLESS YOU ARE SURE THAT YOU KNOW WHAT YOU ARE DOING
+import { MockContext } from '../../mock_runtime';
+import { i64FromJSON, i64KeyFromJSON, i64ToJSON, doubleFromJSON, listFromJSON, setFromJSON, mapFromJSON, listToJSON, mapToJSON } from '../../thrift_runtime';
+
+
+/**
+ * 分页请求结构体
+ */
+export interface PageReq {
+
+/**
+ * 页码
+ */
+  pageNum?: number;
+
+/**
+ * 每页大小
+ */
+  pageSize?: number;
+}
+
+/**
+ * PageReq 的类，构造时先设置 IDL 中的默认值，再合并传入的属性
+ */
+export class PageReq {
+  constructor(init?: Partial<PageReq>) {
+    if (init) {
+      Object.assign(this, init);
+    }
+  }
+
+  static fromJSON(json: any): PageReq {
+    const value: any = {};
+    if (json.pageNum !== undefined && json.pageNum !== null) {
+      value.pageNum = json.pageNum;
+    }
+    if (json.pageSize !== undefined && json.pageSize !== null) {
+      value.pageSize = json.pageSize;
+    }
+    return new PageReq(value);
+  }
+
+  static toJSON(value: PageReq): any {
+    const json: any = {};
+    if (value.pageNum !== undefined && value.pageNum !== null) {
+      json.pageNum = value.pageNum;
+    }
+    if (value.pageSize !== undefined && value.pageSize !== null) {
+      json.pageSize = value.pageSize;
+    }
+    return json;
+  }
+}
+
+/**
+ * 生成 PageReq 的测试数据，overrides 中的属性会覆盖生成的值
+ */
+export function mockPageReq(overrides?: Partial<PageReq>, ctx: MockContext = new MockContext()): PageReq {
+  const value: any = {};
+  ctx.nested(() => {
+    value.pageNum = ctx.int(0, 10000);
+    value.pageSize = ctx.int(0, 10000);
+  });
+  return new PageReq(Object.assign(value, overrides));
+}
\ No newline at end of file
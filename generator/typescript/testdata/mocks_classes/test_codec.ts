@@ -0,0 +1,451 @@
+"use strict";
+
+
+// Generated by thriftgo ?
+// DO NOT EDIT UNLESS YOU ARE SURE THAT YOU KNOW WHAT YOU ARE DOING
+
+import { PageReq } from './common/base';
+import { i64FromJSON, i64KeyFromJSON, i64ToJSON, doubleFromJSON, listFromJSON, setFromJSON, mapFromJSON, listToJSON, mapToJSON } from './thrift_runtime';
+import { MockContext } from './mock_runtime';
+import { mockPageReq } from './common/base';
+
+export enum Gender {
+  UNKNOWN = 0,
+  MALE = 1,
+  FEMALE = 2,
+}
+
+/**
+ * 生成 Gender 的测试数据，指定 override 时直接返回
+ */
+export function mockGender(override?: Gender, ctx: MockContext = new MockContext()): Gender {
+  return override !== undefined ? override : ctx.pick([Gender.UNKNOWN, Gender.MALE, Gender.FEMALE]);
+}
+
+export interface Address {
+  city: string;
+  street?: string | undefined;
+}
+
+/**
+ * Address 的类，构造时先设置 IDL 中的默认值，再合并传入的属性
+ */
+export class Address {
+  constructor(init?: Partial<Address>) {
+    if (init) {
+      Object.assign(this, init);
+    }
+  }
+
+  static fromJSON(json: any): Address {
+    const value: any = {};
+    if (json.city !== undefined && json.city !== null) {
+      value.city = json.city;
+    }
+    if (json.street !== undefined && json.street !== null) {
+      value.street = json.street;
+    }
+    return new Address(value);
+  }
+
+  static toJSON(value: Address): any {
+    const json: any = {};
+    if (value.city !== undefined && value.city !== null) {
+      json.city = value.city;
+    }
+    if (value.street !== undefined && value.street !== null) {
+      json.street = value.street;
+    }
+    return json;
+  }
+}
+
+/**
+ * 生成 Address 的测试数据，overrides 中的属性会覆盖生成的值
+ */
+export function mockAddress(overrides?: Partial<Address>, ctx: MockContext = new MockContext()): Address {
+  const value: any = {};
+  ctx.nested(() => {
+    value.city = ctx.string('city');
+    value.street = ctx.string('street');
+  });
+  return new Address(Object.assign(value, overrides));
+}
+
+export interface Tracking {
+  traceId?: string | undefined;
+}
+
+/**
+ * Tracking 的类，构造时先设置 IDL 中的默认值，再合并传入的属性
+ */
+export class Tracking {
+  constructor(init?: Partial<Tracking>) {
+    if (init) {
+      Object.assign(this, init);
+    }
+  }
+
+  static fromJSON(json: any): Tracking {
+    const value: any = {};
+    if (json.traceId !== undefined && json.traceId !== null) {
+      value.traceId = json.traceId;
+    }
+    return new Tracking(value);
+  }
+
+  static toJSON(value: Tracking): any {
+    const json: any = {};
+    if (value.traceId !== undefined && value.traceId !== null) {
+      json.traceId = value.traceId;
+    }
+    return json;
+  }
+}
+
+/**
+ * 生成 Tracking 的测试数据，overrides 中的属性会覆盖生成的值
+ */
+export function mockTracking(overrides?: Partial<Tracking>, ctx: MockContext = new MockContext()): Tracking {
+  const value: any = {};
+  ctx.nested(() => {
+    value.traceId = ctx.string('trace_id');
+  });
+  return new Tracking(Object.assign(value, overrides));
+}
+
+
+/**
+ * 覆盖所有 Thrift 类型的结构体
+ */
+export interface User {
+  id: bigint;
+  name: string;
+  active?: boolean | undefined;
+  level?: number | undefined;
+  rank?: number | undefined;
+  age?: number | undefined;
+  score?: number | undefined;
+  avatar?: Uint8Array | undefined;
+  gender?: Gender | undefined;
+  tags?: Array<string> | undefined;
+  roles?: Set<number> | undefined;
+  counters?: { [key: string]: bigint } | undefined;
+  history?: { [key: number]: Array<Address> } | undefined;
+  address?: Address | undefined;
+  contact?: Contact | undefined;
+  friends?: Array<any> | undefined;
+  location?: Location | undefined;
+  nested?: Array<{ [key: string]: Set<boolean> }>;
+  traceId?: string | undefined;
+
+/**
+ * 页码
+ */
+  pageNum?: number;
+
+/**
+ * 每页大小
+ */
+  pageSize?: number;
+}
+
+/**
+ * User 的类，构造时先设置 IDL 中的默认值，再合并传入的属性
+ */
+export class User {
+  constructor(init?: Partial<User>) {
+    if (init) {
+      Object.assign(this, init);
+    }
+  }
+
+  static fromJSON(json: any): User {
+    const value: any = {};
+    if (json.id !== undefined && json.id !== null) {
+      value.id = i64FromJSON(json.id);
+    }
+    if (json.name !== undefined && json.name !== null) {
+      value.name = json.name;
+    }
+    if (json.active !== undefined && json.active !== null) {
+      value.active = json.active;
+    }
+    if (json.level !== undefined && json.level !== null) {
+      value.level = json.level;
+    }
+    if (json.rank !== undefined && json.rank !== null) {
+      value.rank = json.rank;
+    }
+    if (json.age !== undefined && json.age !== null) {
+      value.age = json.age;
+    }
+    if (json.score !== undefined && json.score !== null) {
+      value.score = doubleFromJSON(json.score);
+    }
+    if (json.avatar !== undefined && json.avatar !== null) {
+      value.avatar = json.avatar;
+    }
+    if (json.gender !== undefined && json.gender !== null) {
+      value.gender = json.gender;
+    }
+    if (json.tags !== undefined && json.tags !== null) {
+      value.tags = listFromJSON(json.tags);
+    }
+    if (json.roles !== undefined && json.roles !== null) {
+      value.roles = setFromJSON(json.roles);
+    }
+    if (json.counters !== undefined && json.counters !== null) {
+      value.counters = mapFromJSON(json.counters, undefined, (v0: any) => i64FromJSON(v0));
+    }
+    if (json.history !== undefined && json.history !== null) {
+      value.history = mapFromJSON(json.history, undefined, (v0: any) => listFromJSON(v0, (v1: any) => Address.fromJSON(v1)));
+    }
+    if (json.address !== undefined && json.address !== null) {
+      value.address = Address.fromJSON(json.address);
+    }
+    if (json.contact !== undefined && json.contact !== null) {
+      value.contact = Contact.fromJSON(json.contact);
+    }
+    if (json.friends !== undefined && json.friends !== null) {
+      value.friends = listFromJSON(json.friends, (v0: any) => i64FromJSON(v0));
+    }
+    if (json.location !== undefined && json.location !== null) {
+      value.location = Address.fromJSON(json.location);
+    }
+    Object.assign(value, Tracking.fromJSON(json));
+    Object.assign(value, PageReq.fromJSON(json));
+    if (json.nested !== undefined && json.nested !== null) {
+      value.nested = listFromJSON(json.nested, (v0: any) => mapFromJSON(v0, undefined, (v1: any) => setFromJSON(v1)));
+    }
+    return new User(value);
+  }
+
+  static toJSON(value: User): any {
+    const json: any = {};
+    if (value.id !== undefined && value.id !== null) {
+      json.id = i64ToJSON(value.id);
+    }
+    if (value.name !== undefined && value.name !== null) {
+      json.name = value.name;
+    }
+    if (value.active !== undefined && value.active !== null) {
+      json.active = value.active;
+    }
+    if (value.level !== undefined && value.level !== null) {
+      json.level = value.level;
+    }
+    if (value.rank !== undefined && value.rank !== null) {
+      json.rank = value.rank;
+    }
+    if (value.age !== undefined && value.age !== null) {
+      json.age = value.age;
+    }
+    if (value.score !== undefined && value.score !== null) {
+      json.score = value.score;
+    }
+    if (value.avatar !== undefined && value.avatar !== null) {
+      json.avatar = value.avatar;
+    }
+    if (value.gender !== undefined && value.gender !== null) {
+      json.gender = value.gender;
+    }
+    if (value.tags !== undefined && value.tags !== null) {
+      json.tags = value.tags;
+    }
+    if (value.roles !== undefined && value.roles !== null) {
+      json.roles = listToJSON(value.roles);
+    }
+    if (value.counters !== undefined && value.counters !== null) {
+      json.counters = mapToJSON(value.counters, (v0: any) => i64ToJSON(v0));
+    }
+    if (value.history !== undefined && value.history !== null) {
+      json.history = mapToJSON(value.history, (v0: any) => listToJSON(v0, (v1: any) => Address.toJSON(v1)));
+    }
+    if (value.address !== undefined && value.address !== null) {
+      json.address = Address.toJSON(value.address);
+    }
+    if (value.contact !== undefined && value.contact !== null) {
+      json.contact = Contact.toJSON(value.contact);
+    }
+    if (value.friends !== undefined && value.friends !== null) {
+      json.friends = listToJSON(value.friends, (v0: any) => i64ToJSON(v0));
+    }
+    if (value.location !== undefined && value.location !== null) {
+      json.location = Address.toJSON(value.location);
+    }
+    Object.assign(json, Tracking.toJSON(value as any));
+    Object.assign(json, PageReq.toJSON(value as any));
+    if (value.nested !== undefined && value.nested !== null) {
+      json.nested = listToJSON(value.nested, (v0: any) => mapToJSON(v0, (v1: any) => listToJSON(v1)));
+    }
+    return json;
+  }
+}
+
+/**
+ * 生成 User 的测试数据，overrides 中的属性会覆盖生成的值
+ */
+export function mockUser(overrides?: Partial<User>, ctx: MockContext = new MockContext()): User {
+  const value: any = {};
+  ctx.nested(() => {
+    value.id = BigInt(ctx.int(0, 1000000));
+    value.name = ctx.string('name');
+    value.active = ctx.bool();
+    value.level = ctx.int(0, 127);
+    value.rank = ctx.int(0, 10000);
+    value.age = ctx.int(0, 10000);
+    value.score = ctx.double();
+    value.avatar = ctx.bytes();
+    value.gender = mockGender(undefined, ctx);
+    value.tags = ctx.list(() => ctx.string('tags'));
+    value.roles = new Set(ctx.list(() => ctx.int(0, 10000)));
+    value.counters = ctx.record(() => ctx.string('counters'), () => BigInt(ctx.int(0, 1000000)));
+    value.history = ctx.record(() => ctx.int(0, 10000), () => ctx.list(() => mockAddress(undefined, ctx)));
+    if (!ctx.atLimit()) {
+      value.address = mockAddress(undefined, ctx);
+    }
+    if (!ctx.atLimit()) {
+      value.contact = mockContact(undefined, ctx);
+    }
+    value.friends = ctx.list(() => BigInt(ctx.int(0, 1000000)));
+    if (!ctx.atLimit()) {
+      value.location = mockLocation(undefined, ctx);
+    }
+    Object.assign(value, mockTracking(undefined, ctx));
+    Object.assign(value, mockPageReq(undefined, ctx));
+    value.nested = ctx.list(() => ctx.record(() => ctx.string('nested'), () => new Set(ctx.list(() => ctx.bool()))));
+  });
+  return new User(Object.assign(value, overrides));
+}
+
+/**
+ * IUserService 异步接口
+ * 用于 Axios 客户端等异步实现
+ */
+export interface IUserService {
+  getUser(id: bigint): Promise<User>;
+}
+export type IdList = Array<bigint>;
+
+/**
+ * 生成 IdList 的测试数据，指定 override 时直接返回
+ */
+export function mockIdList(override?: IdList, ctx: MockContext = new MockContext()): IdList {
+  return override !== undefined ? override : ctx.list(() => BigInt(ctx.int(0, 1000000)));
+}
+export type Location = Address;
+
+/**
+ * 生成 Location 的测试数据，指定 override 时直接返回
+ */
+export function mockLocation(override?: Location, ctx: MockContext = new MockContext()): Location {
+  return override !== undefined ? override : mockAddress(undefined, ctx);
+}
+export interface Contact {
+  email?: string | undefined;
+  phone?: string | undefined;
+}
+
+/**
+ * Contact 的类，构造时先设置 IDL 中的默认值，再合并传入的属性
+ */
+export class Contact {
+  constructor(init?: Partial<Contact>) {
+    if (init) {
+      Object.assign(this, init);
+    }
+  }
+
+  static fromJSON(json: any): Contact {
+    const value: any = {};
+    if (json.email !== undefined && json.email !== null) {
+      value.email = json.email;
+    }
+    if (json.phone !== undefined && json.phone !== null) {
+      value.phone = json.phone;
+    }
+    return new Contact(value);
+  }
+
+  static toJSON(value: Contact): any {
+    const json: any = {};
+    if (value.email !== undefined && value.email !== null) {
+      json.email = value.email;
+    }
+    if (value.phone !== undefined && value.phone !== null) {
+      json.phone = value.phone;
+    }
+    return json;
+  }
+}
+
+/**
+ * 生成 Contact 的测试数据，overrides 中的属性会覆盖生成的值
+ */
+export function mockContact(overrides?: Partial<Contact>, ctx: MockContext = new MockContext()): Contact {
+  if (overrides && Object.keys(overrides).length > 0) {
+    return new Contact(overrides);
+  }
+  const value: any = {};
+  ctx.nested(() => {
+    switch (ctx.atLimit() ? 0 : ctx.int(0, 1)) {
+      case 0:
+        value.email = ctx.string('email');
+        break;
+      case 1:
+        value.phone = ctx.string('phone');
+        break;
+    }
+  });
+  return new Contact(Object.assign(value, overrides));
+}
+export interface NotFound {
+  code: number;
+  message?: string | undefined;
+}
+
+/**
+ * NotFound 的类，构造时先设置 IDL 中的默认值，再合并传入的属性
+ */
+export class NotFound {
+  constructor(init?: Partial<NotFound>) {
+    if (init) {
+      Object.assign(this, init);
+    }
+  }
+
+  static fromJSON(json: any): NotFound {
+    const value: any = {};
+    if (json.code !== undefined && json.code !== null) {
+      value.code = json.code;
+    }
+    if (json.message !== undefined && json.message !== null) {
+      value.message = json.message;
+    }
+    return new NotFound(value);
+  }
+
+  static toJSON(value: NotFound): any {
+    const json: any = {};
+    if (value.code !== undefined && value.code !== null) {
+      json.code = value.code;
+    }
+    if (value.message !== undefined && value.message !== null) {
+      json.message = value.message;
+    }
+    return json;
+  }
+}
+
+/**
+ * 生成 NotFound 的测试数据，overrides 中的属性会覆盖生成的值
+ */
+export function mockNotFound(overrides?: Partial<NotFound>, ctx: MockContext = new MockContext()): NotFound {
+  const value: any = {};
+  ctx.nested(() => {
+    value.code = ctx.int(0, 10000);
+    value.message = ctx.string('message');
+  });
+  return new NotFound(Object.assign(value, overrides));
+}
\ No newline at end of file
@@ -0,0 +1,93 @@
+"use strict";
+
+/* tslint:disable */
+/* eslint-disable */
+
+// Generated by thriftgo ?
+// DO NOT EDIT UNLESS YOU ARE SURE THAT YOU KNOW WHAT YOU ARE DOING
+
+/**
+ * mock<Type> 工厂函数使用的随机数据源，相同的 seed 总是生成相同的数据。
+ * 结构体每嵌套一层 depth 加一，达到 maxDepth 后容器为空、可选的结构体字段不再生成，
+ * 联合体选择不会继续嵌套的字段，以此终止递归类型的生成
+ */
+export class MockContext {
+  depth = 0;
+  private state: number;
+
+  constructor(seed: number = 1, readonly maxDepth: number = 3, readonly maxItems: number = 3) {
+    this.state = seed >>> 0;
+  }
+
+  /**
+   * 返回 [0, 1) 之间的伪随机数（mulberry32）
+   */
+  next(): number {
+    this.state = (this.state + 0x6d2b79f5) >>> 0;
+    let t = this.state;
+    t = Math.imul(t ^ (t >>> 15), t | 1);
+    t ^= t + Math.imul(t ^ (t >>> 7), t | 61);
+    return ((t ^ (t >>> 14)) >>> 0) / 4294967296;
+  }
+
+  int(min: number, max: number): number {
+    return min + Math.floor(this.next() * (max - min + 1));
+  }
+
+  double(): number {
+    return this.int(0, 1000000) / 100;
+  }
+
+  bool(): boolean {
+    return this.next() < 0.5;
+  }
+
+  string(prefix: string): string {
+    return prefix + '_' + this.int(0, 0xffff).toString(16);
+  }
+
+  bytes(): Uint8Array {
+    const out = new Uint8Array(this.int(1, 8));
+    for (let i = 0; i < out.length; i++) {
+      out[i] = this.int(0, 255);
+    }
+    return out;
+  }
+
+  pick<T>(values: readonly T[]): T {
+    return values[this.int(0, values.length - 1)];
+  }
+
+  atLimit(): boolean {
+    return this.depth >= this.maxDepth;
+  }
+
+  list<T>(item: () => T): T[] {
+    const out: T[] = [];
+    if (this.atLimit()) {
+      return out;
+    }
+    const n = this.int(1, this.maxItems);
+    for (let i = 0; i < n; i++) {
+      out.push(item());
+    }
+    return out;
+  }
+
+  record<V>(key: () => unknown, value: () => V): { [key: string]: V } {
+    const out: { [key: string]: V } = {};
+    for (const k of this.list(key)) {
+      out[String(k)] = value();
+    }
+    return out;
+  }
+
+  nested(fill: () => void): void {
+    this.depth++;
+    try {
+      fill();
+    } finally {
+      this.depth--;
+    }
+  }
+}
\ No newline at end of file
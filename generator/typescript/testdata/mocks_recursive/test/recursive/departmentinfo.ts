@@ -0,0 +1,24 @@
+"use strict";
+
+// Generated by thriftgo ?
+// DO NOT EDIT UNLESS YOU ARE SURE THAT YOU KNOW WHAT YOU ARE DOING
+import { MockContext } from '../../mock_runtime';
+
+export interface DepartmentInfo {
+  id?: number;
+  deptName?: string;
+  children?: Array<DepartmentInfo>;
+}
+
+/**
+ * 生成 DepartmentInfo 的测试数据，overrides 中的属性会覆盖生成的值
+ */
+export function mockDepartmentInfo(overrides?: Partial<DepartmentInfo>, ctx: MockContext = new MockContext()): DepartmentInfo {
+  const value: any = {};
+  ctx.nested(() => {
+    value.id = ctx.int(0, 10000);
+    value.deptName = ctx.string('deptName');
+    value.children = ctx.list(() => mockDepartmentInfo(undefined, ctx));
+  });
+  return Object.assign(value, overrides) as DepartmentInfo;
+}
\ No newline at end of file
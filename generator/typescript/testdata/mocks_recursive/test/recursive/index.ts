@@ -0,0 +1,8 @@
+"use strict";
+
+// Generated by thriftgo ?
+// DO NOT EDIT UNLESS YOU ARE SURE THAT YOU KNOW WHAT YOU ARE DOING
+export type { DepartmentInfo } from './departmentinfo';
+export { mockDepartmentInfo } from './departmentinfo';
+export type { UserInfo } from './userinfo';
+export { mockUserInfo } from './userinfo';
\ No newline at end of file
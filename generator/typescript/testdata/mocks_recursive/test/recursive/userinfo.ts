@@ -0,0 +1,29 @@
+"use strict";
+
+// Generated by thriftgo ?
+// DO NOT EDIT UNLESS YOU ARE SURE THAT YOU KNOW WHAT YOU ARE DOING
+
+import type { DepartmentInfo } from './departmentinfo';
+import { MockContext } from '../../mock_runtime';
+import { mockDepartmentInfo } from './departmentinfo';
+
+export interface UserInfo {
+  id?: string;
+  name?: string;
+  department?: DepartmentInfo;
+}
+
+/**
+ * 生成 UserInfo 的测试数据，overrides 中的属性会覆盖生成的值
+ */
+export function mockUserInfo(overrides?: Partial<UserInfo>, ctx: MockContext = new MockContext()): UserInfo {
+  const value: any = {};
+  ctx.nested(() => {
+    value.id = ctx.string('id');
+    value.name = ctx.string('name');
+    if (!ctx.atLimit()) {
+      value.department = mockDepartmentInfo(undefined, ctx);
+    }
+  });
+  return Object.assign(value, overrides) as UserInfo;
+}
\ No newline at end of file
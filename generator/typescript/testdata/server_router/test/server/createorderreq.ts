@@ -0,0 +1,12 @@
+"use strict";
+
+// Generated by thriftgo ?
+// DO NOT EDIT UNLESS YOU ARE SURE THAT YOU KNOW WHAT YOU ARE DOING
+
+import type { Order } from './order';
+
+export interface CreateOrderReq {
+  shopId: string;
+  order: Order;
+  remark?: string | undefined;
+}
\ No newline at end of file
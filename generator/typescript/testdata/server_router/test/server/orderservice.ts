@@ -0,0 +1,24 @@
+"use strict";
+
+// Generated by thriftgo ?
+// DO NOT EDIT UNLESS YOU ARE SURE THAT YOU KNOW WHAT YOU ARE DOING
+
+import type { NotFound } from '../../test_codec';
+import type { CreateOrderReq } from './createorderreq';
+import type { ListOrdersReq } from './listordersreq';
+import type { ListOrdersResp } from './listordersresp';
+import type { Order } from './order';
+import type { OrderNotFound } from './ordernotfound';
+
+/**
+ * IOrderService 异步接口
+ * 用于 Axios 客户端等异步实现
+ */
+export interface IOrderService {
+  listOrders(req: ListOrdersReq): Promise<ListOrdersResp>;
+  createOrder(req: CreateOrderReq): Promise<Order>;
+  getOrder(orderId: number, token: string): Promise<Order>;
+  updateRemark(orderId: number, remark: string): Promise<any>;
+  cancelOrder(orderId: number, reason: string): Promise<any>;
+  ping(): Promise<any>;
+}
\ No newline at end of file
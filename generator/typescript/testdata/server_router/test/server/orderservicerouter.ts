@@ -0,0 +1,195 @@
+"use strict";
+
+/* tslint:disable */
+/* eslint-disable */
+
+// Generated by thriftgo ?
+// DO NOT EDIT UNLESS YOU ARE SURE THAT YOU KNOW WHAT YOU ARE DOING
+
+import type { NotFound } from '../../test_codec';
+import type { CreateOrderReq } from './createorderreq';
+import type { ListOrdersReq } from './listordersreq';
+import type { ListOrdersResp } from './listordersresp';
+import type { Order } from './order';
+import type { OrderNotFound } from './ordernotfound';
+import type { IOrderService } from './orderservice';
+import {
+  HttpStatusError,
+  defaultMapError,
+  parseBoolParam,
+  parseIntParam,
+  parseBigIntParam,
+  parseI64StringParam,
+  parseNumberParam,
+  parseStringParam,
+  parseListParam,
+  parseJSONParam,
+  splitHeaderParam,
+} from '../../http_server';
+import type { ExpressRouterLike, ExpressResponseLike, ServerRequest, ServerRouterOptions } from '../../http_server';
+
+/**
+ * 在 express Router 上注册 OrderService 的路由，根据 API 注解把请求转发给 impl
+ * 用法：app.use(express.json(), createOrderServiceRouter(express.Router(), impl))
+ */
+export function createOrderServiceRouter<R extends ExpressRouterLike>(router: R, impl: IOrderService, options: ServerRouterOptions = {}): R {
+  const mapError = options.mapError || defaultMapError;
+
+  // listOrders
+  router.get('/users/:userId/orders', async (req: ServerRequest, res: ExpressResponseLike) => {
+    try {
+      const params: any = req.params || {};
+      const query: any = req.query || {};
+      const headers: any = req.headers || {};
+      const arg0: any = {};
+      if (params['userId'] !== undefined) {
+        arg0.userId = parseIntParam(params['userId'], 'userId');
+      } else {
+        throw new HttpStatusError(400, 'missing required parameter userId');
+      }
+      if (query['page'] !== undefined) {
+        arg0.page = parseIntParam(query['page'], 'page');
+      }
+      if (query['status'] !== undefined) {
+        arg0.statuses = parseListParam(query['status'], (v: unknown) => parseIntParam(v, 'status'));
+      }
+      if (query['withItems'] !== undefined) {
+        arg0.withItems = parseBoolParam(query['withItems'], 'withItems');
+      }
+      if (headers['x-trace-id'] !== undefined) {
+        arg0.traceId = parseStringParam(headers['x-trace-id'], 'x-trace-id');
+      }
+      const result = await impl.listOrders(arg0);
+      res.status(200).json(result);
+    } catch (error) {
+      const { status, body } = mapError(error);
+      res.status(status).json(body);
+    }
+  });
+
+  // listOrders
+  router.get('/v2/users/:userId/orders', async (req: ServerRequest, res: ExpressResponseLike) => {
+    try {
+      const params: any = req.params || {};
+      const query: any = req.query || {};
+      const headers: any = req.headers || {};
+      const arg0: any = {};
+      if (params['userId'] !== undefined) {
+        arg0.userId = parseIntParam(params['userId'], 'userId');
+      } else {
+        throw new HttpStatusError(400, 'missing required parameter userId');
+      }
+      if (query['page'] !== undefined) {
+        arg0.page = parseIntParam(query['page'], 'page');
+      }
+      if (query['status'] !== undefined) {
+        arg0.statuses = parseListParam(query['status'], (v: unknown) => parseIntParam(v, 'status'));
+      }
+      if (query['withItems'] !== undefined) {
+        arg0.withItems = parseBoolParam(query['withItems'], 'withItems');
+      }
+      if (headers['x-trace-id'] !== undefined) {
+        arg0.traceId = parseStringParam(headers['x-trace-id'], 'x-trace-id');
+      }
+      const result = await impl.listOrders(arg0);
+      res.status(200).json(result);
+    } catch (error) {
+      const { status, body } = mapError(error);
+      res.status(status).json(body);
+    }
+  });
+
+  // createOrder
+  router.post('/shops/:shopId/orders', async (req: ServerRequest, res: ExpressResponseLike) => {
+    try {
+      const params: any = req.params || {};
+      const body: any = req.body || {};
+      const arg0: any = {};
+      if (params['shopId'] !== undefined) {
+        arg0.shopId = parseStringParam(params['shopId'], 'shopId');
+      } else {
+        throw new HttpStatusError(400, 'missing required parameter shopId');
+      }
+      if (body['order'] !== undefined && body['order'] !== null) {
+        arg0.order = body['order'];
+      } else {
+        throw new HttpStatusError(400, 'missing required parameter order');
+      }
+      if (body['remark'] !== undefined && body['remark'] !== null) {
+        arg0.remark = body['remark'];
+      }
+      const result = await impl.createOrder(arg0);
+      res.status(200).json(result);
+    } catch (error) {
+      const { status, body } = mapError(error);
+      res.status(status).json(body);
+    }
+  });
+
+  // getOrder
+  router.get('/orders/:orderId', async (req: ServerRequest, res: ExpressResponseLike) => {
+    try {
+      const params: any = req.params || {};
+      const headers: any = req.headers || {};
+      let arg0: any;
+      if (params['orderId'] !== undefined) {
+        arg0 = parseIntParam(params['orderId'], 'orderId');
+      }
+      let arg1: any;
+      if (headers['x-token'] !== undefined) {
+        arg1 = parseStringParam(headers['x-token'], 'x-token');
+      }
+      const result = await impl.getOrder(arg0, arg1);
+      res.status(200).json(result);
+    } catch (error) {
+      const { status, body } = mapError(error, [{ name: 'OrderNotFound', status: 404 }, { name: 'NotFound', status: 410 }]);
+      res.status(status).json(body);
+    }
+  });
+
+  // updateRemark
+  router.post('/orders/:orderId/remark', async (req: ServerRequest, res: ExpressResponseLike) => {
+    try {
+      const params: any = req.params || {};
+      const form: any = req.body || {};
+      let arg0: any;
+      if (params['orderId'] !== undefined) {
+        arg0 = parseIntParam(params['orderId'], 'orderId');
+      }
+      let arg1: any;
+      if (form['remark'] !== undefined) {
+        arg1 = parseStringParam(form['remark'], 'remark');
+      } else {
+        throw new HttpStatusError(400, 'missing required parameter remark');
+      }
+      await impl.updateRemark(arg0, arg1);
+      res.status(200).json(null);
+    } catch (error) {
+      const { status, body } = mapError(error, [{ name: 'OrderNotFound', status: 404 }]);
+      res.status(status).json(body);
+    }
+  });
+
+  // cancelOrder
+  router.delete('/orders/:orderId', async (req: ServerRequest, res: ExpressResponseLike) => {
+    try {
+      const params: any = req.params || {};
+      const query: any = req.query || {};
+      let arg0: any;
+      if (params['orderId'] !== undefined) {
+        arg0 = parseIntParam(params['orderId'], 'orderId');
+      }
+      let arg1: any;
+      if (query['reason'] !== undefined) {
+        arg1 = parseStringParam(query['reason'], 'reason');
+      }
+      await impl.cancelOrder(arg0, arg1);
+      res.status(200).json(null);
+    } catch (error) {
+      const { status, body } = mapError(error);
+      res.status(status).json(body);
+    }
+  });
+
+  return router;
+}
\ No newline at end of file
@@ -0,0 +1,9 @@
+"use strict";
+
+// Generated by thriftgo ?
+// DO NOT EDIT UNLESS YOU ARE SURE THAT YOU KNOW WHAT YOU ARE DOING
+
+export enum OrderStatus {
+  PENDING = 1,
+  PAID = 2,
+}
\ No newline at end of file
@@ -0,0 +1,52 @@
+"use strict";
+
+// Generated by thriftgo ?
+// DO NOT EDIT UNLESS YOU ARE SURE THAT YOU KNOW WHAT YOU ARE DOING
+
+import { Order } from './order';
+import { i64FromJSON, i64KeyFromJSON, i64ToJSON, doubleFromJSON, listFromJSON, setFromJSON, mapFromJSON, listToJSON, mapToJSON } from '../../thrift_runtime';
+
+export interface CreateOrderReq {
+  shopId: string;
+  order: Order;
+  remark?: string | undefined;
+}
+
+/**
+ * CreateOrderReq 的类，构造时先设置 IDL 中的默认值，再合并传入的属性
+ */
+export class CreateOrderReq {
+  constructor(init?: Partial<CreateOrderReq>) {
+    if (init) {
+      Object.assign(this, init);
+    }
+  }
+
+  static fromJSON(json: any): CreateOrderReq {
+    const value: any = {};
+    if (json.shopId !== undefined && json.shopId !== null) {
+      value.shopId = json.shopId;
+    }
+    if (json.order !== undefined && json.order !== null) {
+      value.order = Order.fromJSON(json.order);
+    }
+    if (json.remark !== undefined && json.remark !== null) {
+      value.remark = json.remark;
+    }
+    return new CreateOrderReq(value);
+  }
+
+  static toJSON(value: CreateOrderReq): any {
+    const json: any = {};
+    if (value.shopId !== undefined && value.shopId !== null) {
+      json.shopId = value.shopId;
+    }
+    if (value.order !== undefined && value.order !== null) {
+      json.order = Order.toJSON(value.order);
+    }
+    if (value.remark !== undefined && value.remark !== null) {
+      json.remark = value.remark;
+    }
+    return json;
+  }
+}
\ No newline at end of file
@@ -0,0 +1,66 @@
+"use strict";
+
+// Generated by thriftgo ?
+// DO NOT EDIT UNLESS YOU ARE SURE THAT YOU KNOW WHAT YOU ARE DOING
+
+import { OrderStatus } from './orderstatus';
+import { i64FromJSON, i64KeyFromJSON, i64ToJSON, doubleFromJSON, listFromJSON, setFromJSON, mapFromJSON, listToJSON, mapToJSON } from '../../thrift_runtime';
+
+export interface ListOrdersReq {
+  userId: bigint;
+  page?: number | undefined;
+  statuses?: Array<OrderStatus> | undefined;
+  withItems?: boolean | undefined;
+  traceId?: string | undefined;
+}
+
+/**
+ * ListOrdersReq 的类，构造时先设置 IDL 中的默认值，再合并传入的属性
+ */
+export class ListOrdersReq {
+  constructor(init?: Partial<ListOrdersReq>) {
+    if (init) {
+      Object.assign(this, init);
+    }
+  }
+
+  static fromJSON(json: any): ListOrdersReq {
+    const value: any = {};
+    if (json.userId !== undefined && json.userId !== null) {
+      value.userId = i64FromJSON(json.userId);
+    }
+    if (json.page !== undefined && json.page !== null) {
+      value.page = json.page;
+    }
+    if (json.statuses !== undefined && json.statuses !== null) {
+      value.statuses = listFromJSON(json.statuses);
+    }
+    if (json.withItems !== undefined && json.withItems !== null) {
+      value.withItems = json.withItems;
+    }
+    if (json.traceId !== undefined && json.traceId !== null) {
+      value.traceId = json.traceId;
+    }
+    return new ListOrdersReq(value);
+  }
+
+  static toJSON(value: ListOrdersReq): any {
+    const json: any = {};
+    if (value.userId !== undefined && value.userId !== null) {
+      json.userId = i64ToJSON(value.userId);
+    }
+    if (value.page !== undefined && value.page !== null) {
+      json.page = value.page;
+    }
+    if (value.statuses !== undefined && value.statuses !== null) {
+      json.statuses = value.statuses;
+    }
+    if (value.withItems !== undefined && value.withItems !== null) {
+      json.withItems = value.withItems;
+    }
+    if (value.traceId !== undefined && value.traceId !== null) {
+      json.traceId = value.traceId;
+    }
+    return json;
+  }
+}
\ No newline at end of file
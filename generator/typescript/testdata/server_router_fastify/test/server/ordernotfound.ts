@@ -0,0 +1,43 @@
+"use strict";
+
+// Generated by thriftgo ?
+// DO NOT EDIT UNLESS YOU ARE SURE THAT YOU KNOW WHAT YOU ARE DOING
+import { i64FromJSON, i64KeyFromJSON, i64ToJSON, doubleFromJSON, listFromJSON, setFromJSON, mapFromJSON, listToJSON, mapToJSON } from '../../thrift_runtime';
+
+export interface OrderNotFound {
+  orderId: bigint;
+  message?: string | undefined;
+}
+
+/**
+ * OrderNotFound 的类，构造时先设置 IDL 中的默认值，再合并传入的属性
+ */
+export class OrderNotFound {
+  constructor(init?: Partial<OrderNotFound>) {
+    if (init) {
+      Object.assign(this, init);
+    }
+  }
+
+  static fromJSON(json: any): OrderNotFound {
+    const value: any = {};
+    if (json.orderId !== undefined && json.orderId !== null) {
+      value.orderId = i64FromJSON(json.orderId);
+    }
+    if (json.message !== undefined && json.message !== null) {
+      value.message = json.message;
+    }
+    return new OrderNotFound(value);
+  }
+
+  static toJSON(value: OrderNotFound): any {
+    const json: any = {};
+    if (value.orderId !== undefined && value.orderId !== null) {
+      json.orderId = i64ToJSON(value.orderId);
+    }
+    if (value.message !== undefined && value.message !== null) {
+      json.message = value.message;
+    }
+    return json;
+  }
+}
\ No newline at end of file
@@ -0,0 +1,24 @@
+"use strict";
+
+// Generated by thriftgo ?
+// DO NOT EDIT UNLESS YOU ARE SURE THAT YOU KNOW WHAT YOU ARE DOING
+
+import { NotFound } from '../../test_codec';
+import { CreateOrderReq } from './createorderreq';
+import { ListOrdersReq } from './listordersreq';
+import { ListOrdersResp } from './listordersresp';
+import { Order } from './order';
+import { OrderNotFound } from './ordernotfound';
+
+/**
+ * IOrderService 异步接口
+ * 用于 Axios 客户端等异步实现
+ */
+export interface IOrderService {
+  listOrders(req: ListOrdersReq): Promise<ListOrdersResp>;
+  createOrder(req: CreateOrderReq): Promise<Order>;
+  getOrder(orderId: bigint, token: string): Promise<Order>;
+  updateRemark(orderId: bigint, remark: string): Promise<any>;
+  cancelOrder(orderId: bigint, reason: string): Promise<any>;
+  ping(): Promise<any>;
+}
\ No newline at end of file
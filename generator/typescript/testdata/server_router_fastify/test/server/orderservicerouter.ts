@@ -0,0 +1,214 @@
+"use strict";
+
+/* tslint:disable */
+/* eslint-disable */
+
+// Generated by thriftgo ?
+// DO NOT EDIT UNLESS YOU ARE SURE THAT YOU KNOW WHAT YOU ARE DOING
+
+import { NotFound } from '../../test_codec';
+import { CreateOrderReq } from './createorderreq';
+import { ListOrdersReq } from './listordersreq';
+import { ListOrdersResp } from './listordersresp';
+import { Order } from './order';
+import { OrderNotFound } from './ordernotfound';
+import { i64FromJSON, i64KeyFromJSON, i64ToJSON, doubleFromJSON, listFromJSON, setFromJSON, mapFromJSON, listToJSON, mapToJSON } from '../../thrift_runtime';
+import type { IOrderService } from './orderservice';
+import {
+  HttpStatusError,
+  defaultMapError,
+  parseBoolParam,
+  parseIntParam,
+  parseBigIntParam,
+  parseI64StringParam,
+  parseNumberParam,
+  parseStringParam,
+  parseListParam,
+  parseJSONParam,
+  splitHeaderParam,
+} from '../../http_server';
+import type { FastifyInstanceLike, FastifyReplyLike, ServerRequest, ServerRouterOptions } from '../../http_server';
+
+/**
+ * OrderService 的 fastify 路由插件，根据 API 注解把请求转发给 impl
+ * 用法：app.register(createOrderServiceRouter(impl))
+ */
+export function createOrderServiceRouter(impl: IOrderService, options: ServerRouterOptions = {}): (fastify: FastifyInstanceLike) => Promise<void> {
+  const mapError = options.mapError || defaultMapError;
+  return async (fastify: FastifyInstanceLike): Promise<void> => {
+    // listOrders
+    fastify.route({
+      method: 'GET',
+      url: '/users/:userId/orders',
+      handler: async (req: ServerRequest, reply: FastifyReplyLike) => {
+        try {
+          const params: any = req.params || {};
+          const query: any = req.query || {};
+          const headers: any = req.headers || {};
+          const arg0: any = {};
+          if (params['userId'] !== undefined) {
+            arg0.userId = parseBigIntParam(params['userId'], 'userId');
+          } else {
+            throw new HttpStatusError(400, 'missing required parameter userId');
+          }
+          if (query['page'] !== undefined) {
+            arg0.page = parseIntParam(query['page'], 'page');
+          }
+          if (query['status'] !== undefined) {
+            arg0.statuses = parseListParam(query['status'], (v: unknown) => parseIntParam(v, 'status'));
+          }
+          if (query['withItems'] !== undefined) {
+            arg0.withItems = parseBoolParam(query['withItems'], 'withItems');
+          }
+          if (headers['x-trace-id'] !== undefined) {
+            arg0.traceId = parseStringParam(headers['x-trace-id'], 'x-trace-id');
+          }
+          const result = await impl.listOrders(arg0);
+          return reply.code(200).send(ListOrdersResp.toJSON(result));
+        } catch (error) {
+          const { status, body } = mapError(error);
+          return reply.code(status).send(body);
+        }
+      },
+    });
+    // listOrders
+    fastify.route({
+      method: 'GET',
+      url: '/v2/users/:userId/orders',
+      handler: async (req: ServerRequest, reply: FastifyReplyLike) => {
+        try {
+          const params: any = req.params || {};
+          const query: any = req.query || {};
+          const headers: any = req.headers || {};
+          const arg0: any = {};
+          if (params['userId'] !== undefined) {
+            arg0.userId = parseBigIntParam(params['userId'], 'userId');
+          } else {
+            throw new HttpStatusError(400, 'missing required parameter userId');
+          }
+          if (query['page'] !== undefined) {
+            arg0.page = parseIntParam(query['page'], 'page');
+          }
+          if (query['status'] !== undefined) {
+            arg0.statuses = parseListParam(query['status'], (v: unknown) => parseIntParam(v, 'status'));
+          }
+          if (query['withItems'] !== undefined) {
+            arg0.withItems = parseBoolParam(query['withItems'], 'withItems');
+          }
+          if (headers['x-trace-id'] !== undefined) {
+            arg0.traceId = parseStringParam(headers['x-trace-id'], 'x-trace-id');
+          }
+          const result = await impl.listOrders(arg0);
+          return reply.code(200).send(ListOrdersResp.toJSON(result));
+        } catch (error) {
+          const { status, body } = mapError(error);
+          return reply.code(status).send(body);
+        }
+      },
+    });
+    // createOrder
+    fastify.route({
+      method: 'POST',
+      url: '/shops/:shopId/orders',
+      handler: async (req: ServerRequest, reply: FastifyReplyLike) => {
+        try {
+          const params: any = req.params || {};
+          const body: any = req.body || {};
+          const arg0: any = {};
+          if (params['shopId'] !== undefined) {
+            arg0.shopId = parseStringParam(params['shopId'], 'shopId');
+          } else {
+            throw new HttpStatusError(400, 'missing required parameter shopId');
+          }
+          if (body['order'] !== undefined && body['order'] !== null) {
+            arg0.order = Order.fromJSON(body['order']);
+          } else {
+            throw new HttpStatusError(400, 'missing required parameter order');
+          }
+          if (body['remark'] !== undefined && body['remark'] !== null) {
+            arg0.remark = body['remark'];
+          }
+          const result = await impl.createOrder(arg0);
+          return reply.code(200).send(Order.toJSON(result));
+        } catch (error) {
+          const { status, body } = mapError(error);
+          return reply.code(status).send(body);
+        }
+      },
+    });
+    // getOrder
+    fastify.route({
+      method: 'GET',
+      url: '/orders/:orderId',
+      handler: async (req: ServerRequest, reply: FastifyReplyLike) => {
+        try {
+          const params: any = req.params || {};
+          const headers: any = req.headers || {};
+          let arg0: any;
+          if (params['orderId'] !== undefined) {
+            arg0 = parseBigIntParam(params['orderId'], 'orderId');
+          }
+          let arg1: any;
+          if (headers['x-token'] !== undefined) {
+            arg1 = parseStringParam(headers['x-token'], 'x-token');
+          }
+          const result = await impl.getOrder(arg0, arg1);
+          return reply.code(200).send(Order.toJSON(result));
+        } catch (error) {
+          const { status, body } = mapError(error, [{ name: 'OrderNotFound', status: 404, type: OrderNotFound, toJSON: OrderNotFound.toJSON }, { name: 'NotFound', status: 410, type: NotFound, toJSON: NotFound.toJSON }]);
+          return reply.code(status).send(body);
+        }
+      },
+    });
+    // updateRemark
+    fastify.route({
+      method: 'POST',
+      url: '/orders/:orderId/remark',
+      handler: async (req: ServerRequest, reply: FastifyReplyLike) => {
+        try {
+          const params: any = req.params || {};
+          const form: any = req.body || {};
+          let arg0: any;
+          if (params['orderId'] !== undefined) {
+            arg0 = parseBigIntParam(params['orderId'], 'orderId');
+          }
+          let arg1: any;
+          if (form['remark'] !== undefined) {
+            arg1 = parseStringParam(form['remark'], 'remark');
+          } else {
+            throw new HttpStatusError(400, 'missing required parameter remark');
+          }
+          await impl.updateRemark(arg0, arg1);
+          return reply.code(200).send(null);
+        } catch (error) {
+          const { status, body } = mapError(error, [{ name: 'OrderNotFound', status: 404, type: OrderNotFound, toJSON: OrderNotFound.toJSON }]);
+          return reply.code(status).send(body);
+        }
+      },
+    });
+    // cancelOrder
+    fastify.route({
+      method: 'DELETE',
+      url: '/orders/:orderId',
+      handler: async (req: ServerRequest, reply: FastifyReplyLike) => {
+        try {
+          const params: any = req.params || {};
+          const query: any = req.query || {};
+          let arg0: any;
+          if (params['orderId'] !== undefined) {
+            arg0 = parseBigIntParam(params['orderId'], 'orderId');
+          }
+          let arg1: any;
+          if (query['reason'] !== undefined) {
+            arg1 = parseStringParam(query['reason'], 'reason');
+          }
+          await impl.cancelOrder(arg0, arg1);
+          return reply.code(200).send(null);
+        } catch (error) {
+          const { status, body } = mapError(error);
+          return reply.code(status).send(body);
+        }
+      },
+    });
+  };
+}
\ No newline at end of file
@@ -0,0 +1,168 @@
+"use strict";
+
+/* tslint:disable */
+/* eslint-disable */
+
+// Generated by thriftgo ?
+// DO NOT EDIT UNLESS YOU ARE SURE THAT YOU KNOW WHAT YOU ARE DOING
+
+/**
+ * i64 在生成代码中的类型
+ */
+export type I64 = bigint;
+
+/**
+ * JSON 转换过程中的错误，如类型不匹配或 i64 越界
+ */
+export class TJSONException extends Error {
+  constructor(message: string) {
+    super(message);
+    this.name = 'TJSONException';
+  }
+}
+
+const I64_MIN = BigInt('-9223372036854775808');
+const I64_MAX = BigInt('9223372036854775807');
+
+/**
+ * 解析 JSON 文本，超出安全整数范围的整数保留为字符串，避免 JSON.parse 丢失精度
+ */
+export function parseJSON(text: string): any {
+  let out = '';
+  let start = 0;
+  let i = 0;
+  while (i < text.length) {
+    const c = text[i];
+    if (c === '"') {
+      for (i++; i < text.length && text[i] !== '"'; i++) {
+        if (text[i] === '\\') {
+          i++;
+        }
+      }
+      i++;
+    } else if (c === '-' || (c >= '0' && c <= '9')) {
+      const begin = i;
+      let integer = true;
+      for (i++; i < text.length; i++) {
+        const d = text[i];
+        if (d === '.' || d === 'e' || d === 'E' || d === '+' || d === '-') {
+          integer = false;
+        } else if (d < '0' || d > '9') {
+          break;
+        }
+      }
+      const literal = text.slice(begin, i);
+      if (integer && !Number.isSafeInteger(Number(literal))) {
+        out += text.slice(start, begin) + '"' + literal + '"';
+        start = i;
+      }
+    } else {
+      i++;
+    }
+  }
+  return JSON.parse(out + text.slice(start));
+}
+
+/**
+ * 把 JSON 中的 number、数字字符串或 bigint 无损地转换为 i64
+ */
+export function i64FromJSON(value: unknown): I64 {
+  let n: bigint;
+  if (typeof value === 'bigint') {
+    n = value;
+  } else if (typeof value === 'number' && Number.isInteger(value)) {
+    n = BigInt(value);
+  } else if (typeof value === 'string' && /^[+-]?\d+$/.test(value)) {
+    n = BigInt(value);
+  } else {
+    throw new TJSONException('invalid i64 value: ' + String(value));
+  }
+  if (n < I64_MIN || n > I64_MAX) {
+    throw new TJSONException('i64 value out of range: ' + String(value));
+  }
+  return n;
+}
+
+/**
+ * 规范化以 i64 为键的 map 的键
+ */
+export function i64KeyFromJSON(key: string): string {
+  return String(i64FromJSON(key));
+}
+
+/**
+ * 把 i64 转换为 JSON 中的十进制字符串
+ */
+export function i64ToJSON(value: number | bigint | string): string {
+  return String(i64FromJSON(value));
+}
+
+/**
+ * 把 JSON 中的 number 或 parseJSON 保留下来的数字字符串转换为 double
+ */
+export function doubleFromJSON(value: unknown): number {
+  if (typeof value === 'number') {
+    return value;
+  }
+  if (typeof value === 'string' && value.trim() !== '') {
+    const n = Number(value);
+    if (!Number.isNaN(n) || value === 'NaN') {
+      return n;
+    }
+  }
+  throw new TJSONException('invalid double value: ' + String(value));
+}
+
+/**
+ * 把 JSON 数组转换为 list，convert 用于转换每个元素
+ */
+export function listFromJSON<T>(value: unknown, convert?: (v: any) => T): T[] {
+  if (!Array.isArray(value)) {
+    throw new TJSONException('expect an array, got ' + typeof value);
+  }
+  return convert ? value.map((v) => convert(v)) : value;
+}
+
+/**
+ * 把 JSON 数组转换为 set，convert 用于转换每个元素
+ */
+export function setFromJSON<T>(value: unknown, convert?: (v: any) => T): Set<T> {
+  return new Set(listFromJSON(value, convert));
+}
+
+/**
+ * 把 JSON 对象转换为 map，convertKey 和 convertValue 分别用于转换键和值
+ */
+export function mapFromJSON<V>(
+  value: unknown,
+  convertKey?: (k: string) => string,
+  convertValue?: (v: any) => V,
+): { [key: string]: V } {
+  if (value === null || typeof value !== 'object' || Array.isArray(value)) {
+    throw new TJSONException('expect an object, got ' + (Array.isArray(value) ? 'array' : typeof value));
+  }
+  const result: { [key: string]: V } = {};
+  for (const k of Object.keys(value)) {
+    const v = (value as any)[k];
+    result[convertKey ? convertKey(k) : k] = convertValue ? convertValue(v) : v;
+  }
+  return result;
+}
+
+/**
+ * 把 list 或 set 转换为 JSON 数组，convert 用于转换每个元素
+ */
+export function listToJSON<T>(value: Iterable<T>, convert?: (v: T) => any): any[] {
+  return Array.from(value, (v) => (convert ? convert(v) : v));
+}
+
+/**
+ * 把 map 转换为 JSON 对象，convert 用于转换每个值
+ */
+export function mapToJSON<V>(value: { [key: string]: V }, convert: (v: V) => any): { [key: string]: any } {
+  const result: { [key: string]: any } = {};
+  for (const k of Object.keys(value)) {
+    result[k] = convert(value[k]);
+  }
+  return result;
+}
\ No newline at end of file
@@ -0,0 +1,8 @@
+namespace ts test.duplicate
+
+include "base.thrift"
+
+// 与 base.thrift 中的 PageReq 同名，bundle=tree 时无法合并到同一个模块
+struct PageReq {
+  1: base.PageReq page
+}
@@ -0,0 +1,57 @@
+include "base.thrift"
+
+typedef list<i64> IdList
+typedef Address Location
+
+enum Gender {
+  UNKNOWN = 0
+  MALE = 1
+  FEMALE = 2
+}
+
+struct Address {
+  1: required string city
+  2: optional string street
+}
+
+union Contact {
+  1: string email
+  2: string phone
+}
+
+struct Tracking {
+  1: optional string trace_id
+} (expandable = "true")
+
+// 覆盖所有 Thrift 类型的结构体
+struct User {
+  1: required i64 id
+  2: required string name
+  3: optional bool active
+  4: optional byte level
+  5: optional i16 rank
+  6: optional i32 age
+  7: optional double score
+  8: optional binary avatar
+  9: optional Gender gender
+  10: optional list<string> tags
+  11: optional set<i32> roles
+  12: optional map<string, i64> counters
+  13: optional map<i32, list<Address>> history
+  14: optional Address address
+  15: optional Contact contact
+  16: optional IdList friends
+  17: optional Location location
+  18: optional Tracking tracking
+  19: optional base.PageReq page
+  20: list<map<string, set<bool>>> nested
+}
+
+exception NotFound {
+  1: required i32 code
+  2: optional string message
+}
+
+service UserService {
+  User GetUser(1: i64 id) throws (1: NotFound err)
+}
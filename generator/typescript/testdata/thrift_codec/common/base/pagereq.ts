@@ -0,0 +1,76 @@
+"use strict";
+
+// Generated by thriftgo ?
+// DO NOT EDIT UNLESS YOU ARE SURE THAT YOU KNOW WHAT YOU ARE DOING
+import { TProtocolException, TType } from '../../thrift_runtime';
+import type { TProtocol } from '../../thrift_runtime';
+
+
+/**
+ * 分页请求结构体
+ */
+export interface PageReq {
+
+/**
+ * 页码
+ */
+  pageNum?: number;
+
+/**
+ * 每页大小
+ */
+  pageSize?: number;
+}
+
+/**
+ * PageReq 的编解码方法
+ */
+export const PageReq = {
+  write(output: TProtocol, value: PageReq): void {
+    output.writeStructBegin('PageReq');
+    if (value.pageNum !== undefined && value.pageNum !== null) {
+      output.writeFieldBegin('page_num', TType.I32, 1);
+      output.writeI32(value.pageNum);
+      output.writeFieldEnd();
+    }
+    if (value.pageSize !== undefined && value.pageSize !== null) {
+      output.writeFieldBegin('page_size', TType.I32, 2);
+      output.writeI32(value.pageSize);
+      output.writeFieldEnd();
+    }
+    output.writeFieldStop();
+    output.writeStructEnd();
+  },
+
+  read(input: TProtocol): PageReq {
+    const value: any = {};
+    input.readStructBegin();
+    for (;;) {
+      const field = input.readFieldBegin();
+      if (field.type === TType.STOP) {
+        break;
+      }
+      switch (field.id) {
+        case 1:
+          if (field.type === TType.I32) {
+            value.pageNum = input.readI32();
+          } else {
+            input.skip(field.type);
+          }
+          break;
+        case 2:
+          if (field.type === TType.I32) {
+            value.pageSize = input.readI32();
+          } else {
+            input.skip(field.type);
+          }
+          break;
+        default:
+          input.skip(field.type);
+      }
+      input.readFieldEnd();
+    }
+    input.readStructEnd();
+    return value;
+  },
+};
\ No newline at end of file
@@ -0,0 +1,11 @@
+"use strict";
+
+// Generated by thriftgo ?
+// DO NOT EDIT UNLESS YOU ARE SURE THAT YOU KNOW WHAT YOU ARE DOING
+export { OrderStatus } from './orderstatus';
+export type { ListOrdersReq } from './listordersreq';
+export type { Order } from './order';
+export type { ListOrdersResp } from './listordersresp';
+export type { CreateOrderReq } from './createorderreq';
+export type { IOrderService } from './orderservice';
+export { OrderServiceClient } from './orderserviceclient';
\ No newline at end of file
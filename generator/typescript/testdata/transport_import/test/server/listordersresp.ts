@@ -0,0 +1,11 @@
+"use strict";
+
+// Generated by thriftgo ?
+// DO NOT EDIT UNLESS YOU ARE SURE THAT YOU KNOW WHAT YOU ARE DOING
+
+import type { Order } from './order';
+
+export interface ListOrdersResp {
+  orders?: Array<Order>;
+  total?: number;
+}
\ No newline at end of file
@@ -0,0 +1,42 @@
+"use strict";
+
+// Generated by thriftgo ?
+// DO NOT EDIT UNLESS YOU ARE SURE THAT YOU KNOW WHAT YOU ARE DOING
+
+
+/**
+ * 分页请求结构体
+ */
+export interface PageReq {
+
+/**
+ * 页码
+ */
+  pageNum?: number;
+
+/**
+ * 每页大小
+ */
+  pageSize?: number;
+}
+
+/**
+ * 校验 value 是否为合法的 PageReq
+ */
+export function validatePageReq(value: unknown): value is PageReq {
+  if (typeof value !== 'object' || value === null || Array.isArray(value)) {
+    return false;
+  }
+  const v: any = value;
+  if (v.pageNum !== undefined && v.pageNum !== null) {
+    if (!(Number.isInteger(v.pageNum) && v.pageNum >= -2147483648 && v.pageNum <= 2147483647)) {
+      return false;
+    }
+  }
+  if (v.pageSize !== undefined && v.pageSize !== null) {
+    if (!(Number.isInteger(v.pageSize) && v.pageSize >= -2147483648 && v.pageSize <= 2147483647)) {
+      return false;
+    }
+  }
+  return true;
+}
\ No newline at end of file
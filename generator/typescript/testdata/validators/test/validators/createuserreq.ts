@@ -0,0 +1,80 @@
+"use strict";
+
+// Generated by thriftgo ?
+// DO NOT EDIT UNLESS YOU ARE SURE THAT YOU KNOW WHAT YOU ARE DOING
+
+import type { Address } from '../../test_codec';
+import type { Status } from './status';
+import { validateAddress } from '../../test_codec';
+import { validateStatus } from './status';
+
+
+/**
+ * 带有 api.vd 校验规则的请求
+ */
+export interface CreateUserReq {
+  name: string;
+  email?: string | undefined;
+  age?: number | undefined;
+  role?: string | undefined;
+  tags?: Array<string> | undefined;
+  status?: Status | undefined;
+  quota?: { [key: Status]: number } | undefined;
+  address?: Address | undefined;
+  nickname?: string | undefined;
+}
+
+/**
+ * 校验 value 是否为合法的 CreateUserReq
+ */
+export function validateCreateUserReq(value: unknown): value is CreateUserReq {
+  if (typeof value !== 'object' || value === null || Array.isArray(value)) {
+    return false;
+  }
+  const v: any = value;
+  if (v.name === undefined || v.name === null || !(typeof v.name === 'string' && v.name.length > 0 && v.name.length <= 32)) {
+    return false;
+  }
+  if (v.email !== undefined && v.email !== null) {
+    if (!(typeof v.email === 'string' && new RegExp("^[^@]+@[^@]+$").test(v.email))) {
+      return false;
+    }
+  }
+  if (v.age !== undefined && v.age !== null) {
+    if (!(Number.isInteger(v.age) && v.age >= -2147483648 && v.age <= 2147483647 && v.age >= 0 && v.age < 150)) {
+      return false;
+    }
+  }
+  if (v.role !== undefined && v.role !== null) {
+    if (!(typeof v.role === 'string' && (["admin", "user"] as unknown[]).includes(v.role))) {
+      return false;
+    }
+  }
+  if (v.tags !== undefined && v.tags !== null) {
+    if (!(Array.isArray(v.tags) && v.tags.every((v0: any) => typeof v0 === 'string') && v.tags.length <= 3)) {
+      return false;
+    }
+  }
+  if (v.status !== undefined && v.status !== null) {
+    if (!(validateStatus(v.status))) {
+      return false;
+    }
+  }
+  if (v.quota !== undefined && v.quota !== null) {
+    if (!(typeof v.quota === 'object' && v.quota !== null && !Array.isArray(v.quota) && Object.keys(v.quota).every((k0) => validateStatus(Number(k0)) && Number.isInteger(v.quota[k0])))) {
+      return false;
+    }
+  }
+  if (v.address !== undefined && v.address !== null) {
+    if (!(validateAddress(v.address))) {
+      return false;
+    }
+  }
+  // 无法在前端校验的 api.vd 条件：mblen($) < 10
+  if (v.nickname !== undefined && v.nickname !== null) {
+    if (!(typeof v.nickname === 'string')) {
+      return false;
+    }
+  }
+  return true;
+}
\ No newline at end of file
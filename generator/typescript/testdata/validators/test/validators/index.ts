@@ -0,0 +1,7 @@
+"use strict";
+
+// Generated by thriftgo ?
+// DO NOT EDIT UNLESS YOU ARE SURE THAT YOU KNOW WHAT YOU ARE DOING
+export { Status, validateStatus } from './status';
+export type { CreateUserReq } from './createuserreq';
+export { validateCreateUserReq } from './createuserreq';
\ No newline at end of file
@@ -0,0 +1,16 @@
+"use strict";
+
+// Generated by thriftgo ?
+// DO NOT EDIT UNLESS YOU ARE SURE THAT YOU KNOW WHAT YOU ARE DOING
+
+export enum Status {
+  ACTIVE = 1,
+  DISABLED = 2,
+}
+
+/**
+ * 校验 value 是否为合法的 Status
+ */
+export function validateStatus(value: unknown): value is Status {
+  return typeof value === 'number' && Status[value] !== undefined;
+}
\ No newline at end of file
@@ -0,0 +1,279 @@
+"use strict";
+
+
+// Generated by thriftgo ?
+// DO NOT EDIT UNLESS YOU ARE SURE THAT YOU KNOW WHAT YOU ARE DOING
+
+import type { PageReq } from './common/base';
+import { validatePageReq } from './common/base';
+
+export enum Gender {
+  UNKNOWN = 0,
+  MALE = 1,
+  FEMALE = 2,
+}
+
+/**
+ * 校验 value 是否为合法的 Gender
+ */
+export function validateGender(value: unknown): value is Gender {
+  return typeof value === 'number' && Gender[value] !== undefined;
+}
+
+export interface Address {
+  city: string;
+  street?: string | undefined;
+}
+
+/**
+ * 校验 value 是否为合法的 Address
+ */
+export function validateAddress(value: unknown): value is Address {
+  if (typeof value !== 'object' || value === null || Array.isArray(value)) {
+    return false;
+  }
+  const v: any = value;
+  if (v.city === undefined || v.city === null || !(typeof v.city === 'string')) {
+    return false;
+  }
+  if (v.street !== undefined && v.street !== null) {
+    if (!(typeof v.street === 'string')) {
+      return false;
+    }
+  }
+  return true;
+}
+
+export interface Tracking {
+  traceId?: string | undefined;
+}
+
+/**
+ * 校验 value 是否为合法的 Tracking
+ */
+export function validateTracking(value: unknown): value is Tracking {
+  if (typeof value !== 'object' || value === null || Array.isArray(value)) {
+    return false;
+  }
+  const v: any = value;
+  if (v.traceId !== undefined && v.traceId !== null) {
+    if (!(typeof v.traceId === 'string')) {
+      return false;
+    }
+  }
+  return true;
+}
+
+
+/**
+ * 覆盖所有 Thrift 类型的结构体
+ */
+export interface User {
+  id: number;
+  name: string;
+  active?: boolean | undefined;
+  level?: number | undefined;
+  rank?: number | undefined;
+  age?: number | undefined;
+  score?: number | undefined;
+  avatar?: Uint8Array | undefined;
+  gender?: Gender | undefined;
+  tags?: Array<string> | undefined;
+  roles?: Set<number> | undefined;
+  counters?: { [key: string]: number } | undefined;
+  history?: { [key: number]: Array<Address> } | undefined;
+  address?: Address | undefined;
+  contact?: Contact | undefined;
+  friends?: Array<any> | undefined;
+  location?: Location | undefined;
+  nested?: Array<{ [key: string]: Set<boolean> }>;
+  traceId?: string | undefined;
+
+/**
+ * 页码
+ */
+  pageNum?: number;
+
+/**
+ * 每页大小
+ */
+  pageSize?: number;
+}
+
+/**
+ * 校验 value 是否为合法的 User
+ */
+export function validateUser(value: unknown): value is User {
+  if (typeof value !== 'object' || value === null || Array.isArray(value)) {
+    return false;
+  }
+  const v: any = value;
+  if (v.id === undefined || v.id === null || !(Number.isInteger(v.id))) {
+    return false;
+  }
+  if (v.name === undefined || v.name === null || !(typeof v.name === 'string')) {
+    return false;
+  }
+  if (v.active !== undefined && v.active !== null) {
+    if (!(typeof v.active === 'boolean')) {
+      return false;
+    }
+  }
+  if (v.level !== undefined && v.level !== null) {
+    if (!(Number.isInteger(v.level) && v.level >= -128 && v.level <= 127)) {
+      return false;
+    }
+  }
+  if (v.rank !== undefined && v.rank !== null) {
+    if (!(Number.isInteger(v.rank) && v.rank >= -32768 && v.rank <= 32767)) {
+      return false;
+    }
+  }
+  if (v.age !== undefined && v.age !== null) {
+    if (!(Number.isInteger(v.age) && v.age >= -2147483648 && v.age <= 2147483647)) {
+      return false;
+    }
+  }
+  if (v.score !== undefined && v.score !== null) {
+    if (!(typeof v.score === 'number')) {
+      return false;
+    }
+  }
+  if (v.avatar !== undefined && v.avatar !== null) {
+    if (!(v.avatar instanceof Uint8Array)) {
+      return false;
+    }
+  }
+  if (v.gender !== undefined && v.gender !== null) {
+    if (!(validateGender(v.gender))) {
+      return false;
+    }
+  }
+  if (v.tags !== undefined && v.tags !== null) {
+    if (!(Array.isArray(v.tags) && v.tags.every((v0: any) => typeof v0 === 'string'))) {
+      return false;
+    }
+  }
+  if (v.roles !== undefined && v.roles !== null) {
+    if (!((Array.isArray(v.roles) || v.roles instanceof Set) && Array.from(v.roles).every((v0: any) => Number.isInteger(v0) && v0 >= -2147483648 && v0 <= 2147483647))) {
+      return false;
+    }
+  }
+  if (v.counters !== undefined && v.counters !== null) {
+    if (!(typeof v.counters === 'object' && v.counters !== null && !Array.isArray(v.counters) && Object.keys(v.counters).every((k0) => Number.isInteger(v.counters[k0])))) {
+      return false;
+    }
+  }
+  if (v.history !== undefined && v.history !== null) {
+    if (!(typeof v.history === 'object' && v.history !== null && !Array.isArray(v.history) && Object.keys(v.history).every((k0) => /^-?\d+$/.test(k0) && Array.isArray(v.history[k0]) && v.history[k0].every((v1: any) => validateAddress(v1))))) {
+      return false;
+    }
+  }
+  if (v.address !== undefined && v.address !== null) {
+    if (!(validateAddress(v.address))) {
+      return false;
+    }
+  }
+  if (v.contact !== undefined && v.contact !== null) {
+    if (!(validateContact(v.contact))) {
+      return false;
+    }
+  }
+  if (v.friends !== undefined && v.friends !== null) {
+    if (!(Array.isArray(v.friends) && v.friends.every((v0: any) => Number.isInteger(v0)))) {
+      return false;
+    }
+  }
+  if (v.location !== undefined && v.location !== null) {
+    if (!(validateLocation(v.location))) {
+      return false;
+    }
+  }
+  if (!validateTracking(value)) {
+    return false;
+  }
+  if (!validatePageReq(value)) {
+    return false;
+  }
+  if (v.nested !== undefined && v.nested !== null) {
+    if (!(Array.isArray(v.nested) && v.nested.every((v0: any) => typeof v0 === 'object' && v0 !== null && !Array.isArray(v0) && Object.keys(v0).every((k1) => (Array.isArray(v0[k1]) || v0[k1] instanceof Set) && Array.from(v0[k1]).every((v2: any) => typeof v2 === 'boolean'))))) {
+      return false;
+    }
+  }
+  return true;
+}
+
+/**
+ * IUserService 异步接口
+ * 用于 Axios 客户端等异步实现
+ */
+export interface IUserService {
+  getUser(id: number): Promise<User>;
+}
+export type IdList = Array<number>;
+
+/**
+ * 校验 value 是否为合法的 IdList
+ */
+export function validateIdList(value: unknown): value is IdList {
+  return Array.isArray(value) && value.every((v0: any) => Number.isInteger(v0));
+}
+export type Location = Address;
+
+/**
+ * 校验 value 是否为合法的 Location
+ */
+export function validateLocation(value: unknown): value is Location {
+  return validateAddress(value);
+}
+export interface Contact {
+  email?: string | undefined;
+  phone?: string | undefined;
+}
+
+/**
+ * 校验 value 是否为合法的 Contact
+ */
+export function validateContact(value: unknown): value is Contact {
+  if (typeof value !== 'object' || value === null || Array.isArray(value)) {
+    return false;
+  }
+  const v: any = value;
+  let count = 0;
+  if (v.email !== undefined && v.email !== null) {
+    if (!(typeof v.email === 'string')) {
+      return false;
+    }
+    count++;
+  }
+  if (v.phone !== undefined && v.phone !== null) {
+    if (!(typeof v.phone === 'string')) {
+      return false;
+    }
+    count++;
+  }
+  return count === 1;
+}
+export interface NotFound {
+  code: number;
+  message?: string | undefined;
+}
+
+/**
+ * 校验 value 是否为合法的 NotFound
+ */
+export function validateNotFound(value: unknown): value is NotFound {
+  if (typeof value !== 'object' || value === null || Array.isArray(value)) {
+    return false;
+  }
+  const v: any = value;
+  if (v.code === undefined || v.code === null || !(Number.isInteger(v.code) && v.code >= -2147483648 && v.code <= 2147483647)) {
+    return false;
+  }
+  if (v.message !== undefined && v.message !== null) {
+    if (!(typeof v.message === 'string')) {
+      return false;
+    }
+  }
+  return true;
+}
\ No newline at end of file
@@ -0,0 +1,150 @@
+"use strict";
+
+// Generated by thriftgo ?
+// DO NOT EDIT UNLESS YOU ARE SURE THAT YOU KNOW WHAT YOU ARE DOING
+
+import { Address } from '../../test_codec';
+import { Status } from './status';
+import { validateAddress } from '../../test_codec';
+import { validateStatus } from './status';
+import { i64FromJSON, i64KeyFromJSON, i64ToJSON, doubleFromJSON, listFromJSON, setFromJSON, mapFromJSON, listToJSON, mapToJSON } from '../../thrift_runtime';
+
+
+/**
+ * 带有 api.vd 校验规则的请求
+ */
+export interface CreateUserReq {
+  name: string;
+  email?: string | undefined;
+  age?: number | undefined;
+  role?: string | undefined;
+  tags?: Array<string> | undefined;
+  status?: Status | undefined;
+  quota?: { [key: Status]: bigint } | undefined;
+  address?: Address | undefined;
+  nickname?: string | undefined;
+}
+
+/**
+ * CreateUserReq 的编解码方法
+ */
+export const CreateUserReq = {
+  fromJSON(json: any): CreateUserReq {
+    const value: any = {};
+    if (json.name !== undefined && json.name !== null) {
+      value.name = json.name;
+    }
+    if (json.email !== undefined && json.email !== null) {
+      value.email = json.email;
+    }
+    if (json.age !== undefined && json.age !== null) {
+      value.age = json.age;
+    }
+    if (json.role !== undefined && json.role !== null) {
+      value.role = json.role;
+    }
+    if (json.tags !== undefined && json.tags !== null) {
+      value.tags = listFromJSON(json.tags);
+    }
+    if (json.status !== undefined && json.status !== null) {
+      value.status = json.status;
+    }
+    if (json.quota !== undefined && json.quota !== null) {
+      value.quota = mapFromJSON(json.quota, undefined, (v0: any) => i64FromJSON(v0));
+    }
+    if (json.address !== undefined && json.address !== null) {
+      value.address = Address.fromJSON(json.address);
+    }
+    if (json.nickname !== undefined && json.nickname !== null) {
+      value.nickname = json.nickname;
+    }
+    return value;
+  },
+
+  toJSON(value: CreateUserReq): any {
+    const json: any = {};
+    if (value.name !== undefined && value.name !== null) {
+      json.name = value.name;
+    }
+    if (value.email !== undefined && value.email !== null) {
+      json.email = value.email;
+    }
+    if (value.age !== undefined && value.age !== null) {
+      json.age = value.age;
+    }
+    if (value.role !== undefined && value.role !== null) {
+      json.role = value.role;
+    }
+    if (value.tags !== undefined && value.tags !== null) {
+      json.tags = value.tags;
+    }
+    if (value.status !== undefined && value.status !== null) {
+      json.status = value.status;
+    }
+    if (value.quota !== undefined && value.quota !== null) {
+      json.quota = mapToJSON(value.quota, (v0: any) => i64ToJSON(v0));
+    }
+    if (value.address !== undefined && value.address !== null) {
+      json.address = Address.toJSON(value.address);
+    }
+    if (value.nickname !== undefined && value.nickname !== null) {
+      json.nickname = value.nickname;
+    }
+    return json;
+  },
+};
+
+/**
+ * 校验 value 是否为合法的 CreateUserReq
+ */
+export function validateCreateUserReq(value: unknown): value is CreateUserReq {
+  if (typeof value !== 'object' || value === null || Array.isArray(value)) {
+    return false;
+  }
+  const v: any = value;
+  if (v.name === undefined || v.name === null || !(typeof v.name === 'string' && v.name.length > 0 && v.name.length <= 32)) {
+    return false;
+  }
+  if (v.email !== undefined && v.email !== null) {
+    if (!(typeof v.email === 'string' && new RegExp("^[^@]+@[^@]+$").test(v.email))) {
+      return false;
+    }
+  }
+  if (v.age !== undefined && v.age !== null) {
+    if (!(Number.isInteger(v.age) && v.age >= -2147483648 && v.age <= 2147483647 && v.age >= 0 && v.age < 150)) {
+      return false;
+    }
+  }
+  if (v.role !== undefined && v.role !== null) {
+    if (!(typeof v.role === 'string' && (["admin", "user"] as unknown[]).includes(v.role))) {
+      return false;
+    }
+  }
+  if (v.tags !== undefined && v.tags !== null) {
+    if (!(Array.isArray(v.tags) && v.tags.every((v0: any) => typeof v0 === 'string') && v.tags.length <= 3)) {
+      return false;
+    }
+  }
+  if (v.status !== undefined && v.status !== null) {
+    if (!(validateStatus(v.status))) {
+      return false;
+    }
+  }
+  if (v.quota !== undefined && v.quota !== null) {
+    if (!(typeof v.quota === 'object' && v.quota !== null && !Array.isArray(v.quota) && Object.keys(v.quota).every((k0) => validateStatus(Number(k0)) && typeof v.quota[k0] === 'bigint'))) {
+      return false;
+    }
+  }
+  if (v.address !== undefined && v.address !== null) {
+    if (!(validateAddress(v.address))) {
+      return false;
+    }
+  }
+  // 无法在前端校验的 api.vd 条件：mblen($) < 10
+  if (v.nickname !== undefined && v.nickname !== null) {
+    if (!(typeof v.nickname === 'string')) {
+      return false;
+    }
+  }
+  return true;
+}
\ No newline at end of file
@@ -0,0 +1,31 @@
+"use strict";
+
+// Generated by thriftgo ?
+// DO NOT EDIT UNLESS YOU ARE SURE THAT YOU KNOW WHAT YOU ARE DOING
+import { z } from 'zod';
+
+
+/**
+ * 分页请求结构体
+ */
+export interface PageReq {
+
+/**
+ * 页码
+ */
+  pageNum?: number;
+
+/**
+ * 每页大小
+ */
+  pageSize?: number;
+}
+
+/**
+ * PageReq 的 Zod schema
+ */
+export const PageReqSchema = z.object({
+  pageNum: z.number().int().min(-2147483648).max(2147483647).optional(),
+  pageSize: z.number().int().min(-2147483648).max(2147483647).optional(),
+});
+export type PageReqInferred = z.infer<typeof PageReqSchema>;
\ No newline at end of file
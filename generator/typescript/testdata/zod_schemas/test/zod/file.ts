@@ -0,0 +1,26 @@
+"use strict";
+
+// Generated by thriftgo ?
+// DO NOT EDIT UNLESS YOU ARE SURE THAT YOU KNOW WHAT YOU ARE DOING
+
+import type { Address } from '../../test_codec';
+import type { Folder } from './folder';
+import { z } from 'zod';
+import { AddressSchema } from '../../test_codec';
+import { FolderSchema } from './folder';
+
+export interface File {
+  name: string;
+  parent?: Folder | undefined;
+  location?: Address | undefined;
+}
+
+/**
+ * File 的 Zod schema
+ */
+export const FileSchema: z.ZodTypeAny = z.object({
+  name: z.string(),
+  parent: z.lazy(() => FolderSchema).optional(),
+  location: z.lazy(() => AddressSchema).optional(),
+});
+export type FileInferred = File;
\ No newline at end of file
@@ -0,0 +1,26 @@
+"use strict";
+
+// Generated by thriftgo ?
+// DO NOT EDIT UNLESS YOU ARE SURE THAT YOU KNOW WHAT YOU ARE DOING
+
+import type { File } from './file';
+import { z } from 'zod';
+import { FileSchema } from './file';
+
+
+/**
+ * 相互引用的结构体
+ */
+export interface Folder {
+  path: string;
+  files?: Array<File> | undefined;
+}
+
+/**
+ * Folder 的 Zod schema
+ */
+export const FolderSchema: z.ZodTypeAny = z.object({
+  path: z.string(),
+  files: z.array(z.lazy(() => FileSchema)).optional(),
+});
+export type FolderInferred = Folder;
\ No newline at end of file
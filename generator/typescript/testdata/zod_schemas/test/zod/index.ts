@@ -0,0 +1,18 @@
+"use strict";
+
+// Generated by thriftgo ?
+// DO NOT EDIT UNLESS YOU ARE SURE THAT YOU KNOW WHAT YOU ARE DOING
+export { Priority, PrioritySchema } from './priority';
+export type { PriorityInferred } from './priority';
+export type { TaskOptions } from './taskoptions';
+export { TaskOptionsSchema } from './taskoptions';
+export type { TaskOptionsInferred } from './taskoptions';
+export type { TreeNode } from './treenode';
+export { TreeNodeSchema } from './treenode';
+export type { TreeNodeInferred } from './treenode';
+export type { Folder } from './folder';
+export { FolderSchema } from './folder';
+export type { FolderInferred } from './folder';
+export type { File } from './file';
+export { FileSchema } from './file';
+export type { FileInferred } from './file';
\ No newline at end of file
@@ -0,0 +1,34 @@
+"use strict";
+
+// Generated by thriftgo ?
+// DO NOT EDIT UNLESS YOU ARE SURE THAT YOU KNOW WHAT YOU ARE DOING
+
+import type { Priority } from './priority';
+import { z } from 'zod';
+import { PrioritySchema } from './priority';
+
+
+/**
+ * 带有默认值的结构体
+ */
+export interface TaskOptions {
+  retryCount?: number | undefined;
+  queueName?: string | undefined;
+  dryRun?: boolean | undefined;
+  timeout?: number | undefined;
+  deadline?: number | undefined;
+  priority: Priority;
+}
+
+/**
+ * TaskOptions 的 Zod schema
+ */
+export const TaskOptionsSchema = z.object({
+  retryCount: z.number().int().min(-2147483648).max(2147483647).default(3),
+  queueName: z.string().default("default"),
+  dryRun: z.boolean().default(false),
+  timeout: z.number().default(1.5),
+  deadline: z.number().int().default(1000),
+  priority: PrioritySchema,
+});
+export type TaskOptionsInferred = z.infer<typeof TaskOptionsSchema>;
\ No newline at end of file
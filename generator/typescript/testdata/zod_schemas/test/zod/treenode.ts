@@ -0,0 +1,23 @@
+"use strict";
+
+// Generated by thriftgo ?
+// DO NOT EDIT UNLESS YOU ARE SURE THAT YOU KNOW WHAT YOU ARE DOING
+import { z } from 'zod';
+
+
+/**
+ * 递归引用自身的结构体
+ */
+export interface TreeNode {
+  name: string;
+  children?: Array<TreeNode> | undefined;
+}
+
+/**
+ * TreeNode 的 Zod schema
+ */
+export const TreeNodeSchema: z.ZodTypeAny = z.object({
+  name: z.string(),
+  children: z.array(z.lazy(() => TreeNodeSchema)).optional(),
+});
+export type TreeNodeInferred = TreeNode;
\ No newline at end of file
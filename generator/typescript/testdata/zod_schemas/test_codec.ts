@@ -0,0 +1,160 @@
+"use strict";
+
+
+// Generated by thriftgo ?
+// DO NOT EDIT UNLESS YOU ARE SURE THAT YOU KNOW WHAT YOU ARE DOING
+import { z } from 'zod';
+
+export enum Gender {
+  UNKNOWN = 0,
+  MALE = 1,
+  FEMALE = 2,
+}
+
+/**
+ * Gender 的 Zod schema
+ */
+export const GenderSchema = z.nativeEnum(Gender);
+export type GenderInferred = z.infer<typeof GenderSchema>;
+
+export interface Address {
+  city: string;
+  street?: string | undefined;
+}
+
+/**
+ * Address 的 Zod schema
+ */
+export const AddressSchema = z.object({
+  city: z.string(),
+  street: z.string().optional(),
+});
+export type AddressInferred = z.infer<typeof AddressSchema>;
+
+export interface Tracking {
+  traceId?: string | undefined;
+}
+
+/**
+ * Tracking 的 Zod schema
+ */
+export const TrackingSchema = z.object({
+  traceId: z.string().optional(),
+});
+export type TrackingInferred = z.infer<typeof TrackingSchema>;
+
+
+/**
+ * 覆盖所有 Thrift 类型的结构体
+ */
+export interface User {
+  id: number;
+  name: string;
+  active?: boolean | undefined;
+  level?: number | undefined;
+  rank?: number | undefined;
+  age?: number | undefined;
+  score?: number | undefined;
+  avatar?: Uint8Array | undefined;
+  gender?: Gender | undefined;
+  tags?: Array<string> | undefined;
+  roles?: Set<number> | undefined;
+  counters?: { [key: string]: number } | undefined;
+  history?: { [key: number]: Array<Address> } | undefined;
+  address?: Address | undefined;
+  contact?: Contact | undefined;
+  friends?: Array<any> | undefined;
+  location?: Location | undefined;
+  nested?: Array<{ [key: string]: Set<boolean> }>;
+  traceId?: string | undefined;
+
+/**
+ * 页码
+ */
+  pageNum?: number;
+
+/**
+ * 每页大小
+ */
+  pageSize?: number;
+}
+
+/**
+ * User 的 Zod schema
+ */
+export const UserSchema = z.object({
+  id: z.number().int(),
+  name: z.string(),
+  active: z.boolean().optional(),
+  level: z.number().int().min(-128).max(127).optional(),
+  rank: z.number().int().min(-32768).max(32767).optional(),
+  age: z.number().int().min(-2147483648).max(2147483647).optional(),
+  score: z.number().optional(),
+  avatar: z.instanceof(Uint8Array).optional(),
+  gender: GenderSchema.optional(),
+  tags: z.array(z.string()).optional(),
+  roles: z.union([z.set(z.number().int().min(-2147483648).max(2147483647)), z.array(z.number().int().min(-2147483648).max(2147483647)).transform((items) => new Set(items))]).optional(),
+  counters: z.record(z.string(), z.number().int()).optional(),
+  history: z.record(z.string(), z.array(z.lazy(() => AddressSchema))).optional(),
+  address: z.lazy(() => AddressSchema).optional(),
+  contact: z.lazy(() => ContactSchema).optional(),
+  friends: z.array(z.number().int()).optional(),
+  location: z.lazy(() => LocationSchema).optional(),
+  nested: z.array(z.record(z.string(), z.union([z.set(z.boolean()), z.array(z.boolean()).transform((items) => new Set(items))]))).optional(),
+  traceId: z.string().optional(),
+  pageNum: z.number().int().min(-2147483648).max(2147483647).optional(),
+  pageSize: z.number().int().min(-2147483648).max(2147483647).optional(),
+});
+export type UserInferred = z.infer<typeof UserSchema>;
+
+/**
+ * IUserService 异步接口
+ * 用于 Axios 客户端等异步实现
+ */
+export interface IUserService {
+  getUser(id: number): Promise<User>;
+}
+export type IdList = Array<number>;
+
+/**
+ * IdList 的 Zod schema
+ */
+export const IdListSchema = z.array(z.number().int());
+export type IdListInferred = z.infer<typeof IdListSchema>;
+export type Location = Address;
+
+/**
+ * Location 的 Zod schema
+ */
+export const LocationSchema = z.lazy(() => AddressSchema);
+export type LocationInferred = z.infer<typeof LocationSchema>;
+export interface Contact {
+  email?: string | undefined;
+  phone?: string | undefined;
+}
+
+/**
+ * Contact 的 Zod schema
+ */
+export const ContactSchema = z.union([
+  z.object({
+    email: z.string(),
+  }).strict(),
+  z.object({
+    phone: z.string(),
+  }).strict(),
+]);
+export type ContactInferred = z.infer<typeof ContactSchema>;
+export interface NotFound {
+  code: number;
+  message?: string | undefined;
+}
+
+/**
+ * NotFound 的 Zod schema
+ */
+export const NotFoundSchema = z.object({
+  code: z.number().int().min(-2147483648).max(2147483647),
+  message: z.string().optional(),
+});
+export type NotFoundInferred = z.infer<typeof NotFoundSchema>;
\ No newline at end of file
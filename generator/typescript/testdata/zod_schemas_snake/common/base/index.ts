@@ -0,0 +1,7 @@
+"use strict";
+
+// Generated by thriftgo ?
+// DO NOT EDIT UNLESS YOU ARE SURE THAT YOU KNOW WHAT YOU ARE DOING
+export { PageReq } from './pagereq';
+export { PageReqSchema } from './pagereq';
+export type { PageReqInferred } from './pagereq';
\ No newline at end of file
@@ -0,0 +1,59 @@
+"use strict";
+
+// Generated by thriftgo ?
+// DO NOT EDIT UNLESS YOU ARE SURE THAT YOU KNOW WHAT YOU ARE DOING
+import { z } from 'zod/v4';
+import { i64FromJSON, i64KeyFromJSON, i64ToJSON, doubleFromJSON, listFromJSON, setFromJSON, mapFromJSON, listToJSON, mapToJSON } from '../../thrift_runtime';
+
+
+/**
+ * 分页请求结构体
+ */
+export interface PageReq {
+
+/**
+ * 页码
+ */
+  page_num?: number;
+
+/**
+ * 每页大小
+ */
+  page_size?: number;
+}
+
+/**
+ * PageReq 的编解码方法
+ */
+export const PageReq = {
+  fromJSON(json: any): PageReq {
+    const value: any = {};
+    if (json.page_num !== undefined && json.page_num !== null) {
+      value.page_num = json.page_num;
+    }
+    if (json.page_size !== undefined && json.page_size !== null) {
+      value.page_size = json.page_size;
+    }
+    return value;
+  },
+
+  toJSON(value: PageReq): any {
+    const json: any = {};
+    if (value.page_num !== undefined && value.page_num !== null) {
+      json.page_num = value.page_num;
+    }
+    if (value.page_size !== undefined && value.page_size !== null) {
+      json.page_size = value.page_size;
+    }
+    return json;
+  },
+};
+
+/**
+ * PageReq 的 Zod schema
+ */
+export const PageReqSchema = z.object({
+  page_num: z.number().int().min(-2147483648).max(2147483647).optional(),
+  page_size: z.number().int().min(-2147483648).max(2147483647).optional(),
+});
+export type PageReqInferred = z.infer<typeof PageReqSchema>;
\ No newline at end of file
@@ -0,0 +1,60 @@
+"use strict";
+
+// Generated by thriftgo ?
+// DO NOT EDIT UNLESS YOU ARE SURE THAT YOU KNOW WHAT YOU ARE DOING
+
+import { Address } from '../../test_codec';
+import { Folder } from './folder';
+import { z } from 'zod/v4';
+import { AddressSchema } from '../../test_codec';
+import { FolderSchema } from './folder';
+import { i64FromJSON, i64KeyFromJSON, i64ToJSON, doubleFromJSON, listFromJSON, setFromJSON, mapFromJSON, listToJSON, mapToJSON } from '../../thrift_runtime';
+
+export interface File {
+  name: string;
+  parent?: Folder | undefined;
+  location?: Address | undefined;
+}
+
+/**
+ * File 的编解码方法
+ */
+export const File = {
+  fromJSON(json: any): File {
+    const value: any = {};
+    if (json.name !== undefined && json.name !== null) {
+      value.name = json.name;
+    }
+    if (json.parent !== undefined && json.parent !== null) {
+      value.parent = Folder.fromJSON(json.parent);
+    }
+    if (json.location !== undefined && json.location !== null) {
+      value.location = Address.fromJSON(json.location);
+    }
+    return value;
+  },
+
+  toJSON(value: File): any {
+    const json: any = {};
+    if (value.name !== undefined && value.name !== null) {
+      json.name = value.name;
+    }
+    if (value.parent !== undefined && value.parent !== null) {
+      json.parent = Folder.toJSON(value.parent);
+    }
+    if (value.location !== undefined && value.location !== null) {
+      json.location = Address.toJSON(value.location);
+    }
+    return json;
+  },
+};
+
+/**
+ * File 的 Zod schema
+ */
+export const FileSchema: z.ZodTypeAny = z.object({
+  name: z.string(),
+  parent: z.lazy(() => FolderSchema).optional(),
+  location: z.lazy(() => AddressSchema).optional(),
+});
+export type FileInferred = File;
\ No newline at end of file
@@ -0,0 +1,54 @@
+"use strict";
+
+// Generated by thriftgo ?
+// DO NOT EDIT UNLESS YOU ARE SURE THAT YOU KNOW WHAT YOU ARE DOING
+
+import { File } from './file';
+import { z } from 'zod/v4';
+import { FileSchema } from './file';
+import { i64FromJSON, i64KeyFromJSON, i64ToJSON, doubleFromJSON, listFromJSON, setFromJSON, mapFromJSON, listToJSON, mapToJSON } from '../../thrift_runtime';
+
+
+/**
+ * 相互引用的结构体
+ */
+export interface Folder {
+  path: string;
+  files?: Array<File> | undefined;
+}
+
+/**
+ * Folder 的编解码方法
+ */
+export const Folder = {
+  fromJSON(json: any): Folder {
+    const value: any = {};
+    if (json.path !== undefined && json.path !== null) {
+      value.path = json.path;
+    }
+    if (json.files !== undefined && json.files !== null) {
+      value.files = listFromJSON(json.files, (v0: any) => File.fromJSON(v0));
+    }
+    return value;
+  },
+
+  toJSON(value: Folder): any {
+    const json: any = {};
+    if (value.path !== undefined && value.path !== null) {
+      json.path = value.path;
+    }
+    if (value.files !== undefined && value.files !== null) {
+      json.files = listToJSON(value.files, (v0: any) => File.toJSON(v0));
+    }
+    return json;
+  },
+};
+
+/**
+ * Folder 的 Zod schema
+ */
+export const FolderSchema: z.ZodTypeAny = z.object({
+  path: z.string(),
+  files: z.array(z.lazy(() => FileSchema)).optional(),
+});
+export type FolderInferred = Folder;
\ No newline at end of file
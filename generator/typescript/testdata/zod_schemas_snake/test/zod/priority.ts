@@ -0,0 +1,16 @@
+"use strict";
+
+// Generated by thriftgo ?
+// DO NOT EDIT UNLESS YOU ARE SURE THAT YOU KNOW WHAT YOU ARE DOING
+import { z } from 'zod/v4';
+
+export enum Priority {
+  LOW = 1,
+  HIGH = 2,
+}
+
+/**
+ * Priority 的 Zod schema
+ */
+export const PrioritySchema = z.nativeEnum(Priority);
+export type PriorityInferred = z.infer<typeof PrioritySchema>;
\ No newline at end of file
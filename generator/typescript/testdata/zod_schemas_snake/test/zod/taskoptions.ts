@@ -0,0 +1,86 @@
+"use strict";
+
+// Generated by thriftgo ?
+// DO NOT EDIT UNLESS YOU ARE SURE THAT YOU KNOW WHAT YOU ARE DOING
+
+import { Priority } from './priority';
+import { z } from 'zod/v4';
+import { PrioritySchema } from './priority';
+import { i64FromJSON, i64KeyFromJSON, i64ToJSON, doubleFromJSON, listFromJSON, setFromJSON, mapFromJSON, listToJSON, mapToJSON } from '../../thrift_runtime';
+
+
+/**
+ * 带有默认值的结构体
+ */
+export interface TaskOptions {
+  retry_count?: number | undefined;
+  queue_name?: string | undefined;
+  dry_run?: boolean | undefined;
+  timeout?: number | undefined;
+  deadline?: string | undefined;
+  priority: Priority;
+}
+
+/**
+ * TaskOptions 的编解码方法
+ */
+export const TaskOptions = {
+  fromJSON(json: any): TaskOptions {
+    const value: any = {};
+    if (json.retry_count !== undefined && json.retry_count !== null) {
+      value.retry_count = json.retry_count;
+    }
+    if (json.queue_name !== undefined && json.queue_name !== null) {
+      value.queue_name = json.queue_name;
+    }
+    if (json.dry_run !== undefined && json.dry_run !== null) {
+      value.dry_run = json.dry_run;
+    }
+    if (json.timeout !== undefined && json.timeout !== null) {
+      value.timeout = doubleFromJSON(json.timeout);
+    }
+    if (json.deadline !== undefined && json.deadline !== null) {
+      value.deadline = i64FromJSON(json.deadline);
+    }
+    if (json.priority !== undefined && json.priority !== null) {
+      value.priority = json.priority;
+    }
+    return value;
+  },
+
+  toJSON(value: TaskOptions): any {
+    const json: any = {};
+    if (value.retry_count !== undefined && value.retry_count !== null) {
+      json.retry_count = value.retry_count;
+    }
+    if (value.queue_name !== undefined && value.queue_name !== null) {
+      json.queue_name = value.queue_name;
+    }
+    if (value.dry_run !== undefined && value.dry_run !== null) {
+      json.dry_run = value.dry_run;
+    }
+    if (value.timeout !== undefined && value.timeout !== null) {
+      json.timeout = value.timeout;
+    }
+    if (value.deadline !== undefined && value.deadline !== null) {
+      json.deadline = i64ToJSON(value.deadline);
+    }
+    if (value.priority !== undefined && value.priority !== null) {
+      json.priority = value.priority;
+    }
+    return json;
+  },
+};
+
+/**
+ * TaskOptions 的 Zod schema
+ */
+export const TaskOptionsSchema = z.object({
+  retry_count: z.number().int().min(-2147483648).max(2147483647).default(3),
+  queue_name: z.string().default("default"),
+  dry_run: z.boolean().default(false),
+  timeout: z.number().default(1.5),
+  deadline: z.string().regex(/^-?\d+$/).default('1000'),
+  priority: PrioritySchema,
+});
+export type TaskOptionsInferred = z.infer<typeof TaskOptionsSchema>;
\ No newline at end of file
@@ -0,0 +1,51 @@
+"use strict";
+
+// Generated by thriftgo ?
+// DO NOT EDIT UNLESS YOU ARE SURE THAT YOU KNOW WHAT YOU ARE DOING
+import { z } from 'zod/v4';
+import { i64FromJSON, i64KeyFromJSON, i64ToJSON, doubleFromJSON, listFromJSON, setFromJSON, mapFromJSON, listToJSON, mapToJSON } from '../../thrift_runtime';
+
+
+/**
+ * 递归引用自身的结构体
+ */
+export interface TreeNode {
+  name: string;
+  children?: Array<TreeNode> | undefined;
+}
+
+/**
+ * TreeNode 的编解码方法
+ */
+export const TreeNode = {
+  fromJSON(json: any): TreeNode {
+    const value: any = {};
+    if (json.name !== undefined && json.name !== null) {
+      value.name = json.name;
+    }
+    if (json.children !== undefined && json.children !== null) {
+      value.children = listFromJSON(json.children, (v0: any) => TreeNode.fromJSON(v0));
+    }
+    return value;
+  },
+
+  toJSON(value: TreeNode): any {
+    const json: any = {};
+    if (value.name !== undefined && value.name !== null) {
+      json.name = value.name;
+    }
+    if (value.children !== undefined && value.children !== null) {
+      json.children = listToJSON(value.children, (v0: any) => TreeNode.toJSON(v0));
+    }
+    return json;
+  },
+};
+
+/**
+ * TreeNode 的 Zod schema
+ */
+export const TreeNodeSchema: z.ZodTypeAny = z.object({
+  name: z.string(),
+  children: z.array(z.lazy(() => TreeNodeSchema)).optional(),
+});
+export type TreeNodeInferred = TreeNode;
\ No newline at end of file
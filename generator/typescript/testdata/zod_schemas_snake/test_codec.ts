@@ -0,0 +1,393 @@
+"use strict";
+
+
+// Generated by thriftgo ?
+// DO NOT EDIT UNLESS YOU ARE SURE THAT YOU KNOW WHAT YOU ARE DOING
+
+import { PageReq } from './common/base';
+import { i64FromJSON, i64KeyFromJSON, i64ToJSON, doubleFromJSON, listFromJSON, setFromJSON, mapFromJSON, listToJSON, mapToJSON } from './thrift_runtime';
+import { z } from 'zod/v4';
+import { PageReqSchema } from './common/base';
+
+export enum Gender {
+  UNKNOWN = 0,
+  MALE = 1,
+  FEMALE = 2,
+}
+
+/**
+ * Gender 的 Zod schema
+ */
+export const GenderSchema = z.nativeEnum(Gender);
+export type GenderInferred = z.infer<typeof GenderSchema>;
+
+export interface Address {
+  city: string;
+  street?: string | undefined;
+}
+
+/**
+ * Address 的编解码方法
+ */
+export const Address = {
+  fromJSON(json: any): Address {
+    const value: any = {};
+    if (json.city !== undefined && json.city !== null) {
+      value.city = json.city;
+    }
+    if (json.street !== undefined && json.street !== null) {
+      value.street = json.street;
+    }
+    return value;
+  },
+
+  toJSON(value: Address): any {
+    const json: any = {};
+    if (value.city !== undefined && value.city !== null) {
+      json.city = value.city;
+    }
+    if (value.street !== undefined && value.street !== null) {
+      json.street = value.street;
+    }
+    return json;
+  },
+};
+
+/**
+ * Address 的 Zod schema
+ */
+export const AddressSchema = z.object({
+  city: z.string(),
+  street: z.string().optional(),
+});
+export type AddressInferred = z.infer<typeof AddressSchema>;
+
+export interface Tracking {
+  trace_id?: string | undefined;
+}
+
+/**
+ * Tracking 的编解码方法
+ */
+export const Tracking = {
+  fromJSON(json: any): Tracking {
+    const value: any = {};
+    if (json.trace_id !== undefined && json.trace_id !== null) {
+      value.trace_id = json.trace_id;
+    }
+    return value;
+  },
+
+  toJSON(value: Tracking): any {
+    const json: any = {};
+    if (value.trace_id !== undefined && value.trace_id !== null) {
+      json.trace_id = value.trace_id;
+    }
+    return json;
+  },
+};
+
+/**
+ * Tracking 的 Zod schema
+ */
+export const TrackingSchema = z.object({
+  trace_id: z.string().optional(),
+});
+export type TrackingInferred = z.infer<typeof TrackingSchema>;
+
+
+/**
+ * 覆盖所有 Thrift 类型的结构体
+ */
+export interface User {
+  id: string;
+  name: string;
+  active?: boolean | undefined;
+  level?: number | undefined;
+  rank?: number | undefined;
+  age?: number | undefined;
+  score?: number | undefined;
+  avatar?: Uint8Array | undefined;
+  gender?: Gender | undefined;
+  tags?: Array<string> | undefined;
+  roles?: Set<number> | undefined;
+  counters?: { [key: string]: string } | undefined;
+  history?: { [key: number]: Array<Address> } | undefined;
+  address?: Address | undefined;
+  contact?: Contact | undefined;
+  friends?: Array<any> | undefined;
+  location?: Location | undefined;
+  nested?: Array<{ [key: string]: Set<boolean> }>;
+  trace_id?: string | undefined;
+
+/**
+ * 页码
+ */
+  page_num?: number;
+
+/**
+ * 每页大小
+ */
+  page_size?: number;
+}
+
+/**
+ * User 的编解码方法
+ */
+export const User = {
+  fromJSON(json: any): User {
+    const value: any = {};
+    if (json.id !== undefined && json.id !== null) {
+      value.id = i64FromJSON(json.id);
+    }
+    if (json.name !== undefined && json.name !== null) {
+      value.name = json.name;
+    }
+    if (json.active !== undefined && json.active !== null) {
+      value.active = json.active;
+    }
+    if (json.level !== undefined && json.level !== null) {
+      value.level = json.level;
+    }
+    if (json.rank !== undefined && json.rank !== null) {
+      value.rank = json.rank;
+    }
+    if (json.age !== undefined && json.age !== null) {
+      value.age = json.age;
+    }
+    if (json.score !== undefined && json.score !== null) {
+      value.score = doubleFromJSON(json.score);
+    }
+    if (json.avatar !== undefined && json.avatar !== null) {
+      value.avatar = json.avatar;
+    }
+    if (json.gender !== undefined && json.gender !== null) {
+      value.gender = json.gender;
+    }
+    if (json.tags !== undefined && json.tags !== null) {
+      value.tags = listFromJSON(json.tags);
+    }
+    if (json.roles !== undefined && json.roles !== null) {
+      value.roles = setFromJSON(json.roles);
+    }
+    if (json.counters !== undefined && json.counters !== null) {
+      value.counters = mapFromJSON(json.counters, undefined, (v0: any) => i64FromJSON(v0));
+    }
+    if (json.history !== undefined && json.history !== null) {
+      value.history = mapFromJSON(json.history, undefined, (v0: any) => listFromJSON(v0, (v1: any) => Address.fromJSON(v1)));
+    }
+    if (json.address !== undefined && json.address !== null) {
+      value.address = Address.fromJSON(json.address);
+    }
+    if (json.contact !== undefined && json.contact !== null) {
+      value.contact = Contact.fromJSON(json.contact);
+    }
+    if (json.friends !== undefined && json.friends !== null) {
+      value.friends = listFromJSON(json.friends, (v0: any) => i64FromJSON(v0));
+    }
+    if (json.location !== undefined && json.location !== null) {
+      value.location = Address.fromJSON(json.location);
+    }
+    Object.assign(value, Tracking.fromJSON(json));
+    Object.assign(value, PageReq.fromJSON(json));
+    if (json.nested !== undefined && json.nested !== null) {
+      value.nested = listFromJSON(json.nested, (v0: any) => mapFromJSON(v0, undefined, (v1: any) => setFromJSON(v1)));
+    }
+    return value;
+  },
+
+  toJSON(value: User): any {
+    const json: any = {};
+    if (value.id !== undefined && value.id !== null) {
+      json.id = i64ToJSON(value.id);
+    }
+    if (value.name !== undefined && value.name !== null) {
+      json.name = value.name;
+    }
+    if (value.active !== undefined && value.active !== null) {
+      json.active = value.active;
+    }
+    if (value.level !== undefined && value.level !== null) {
+      json.level = value.level;
+    }
+    if (value.rank !== undefined && value.rank !== null) {
+      json.rank = value.rank;
+    }
+    if (value.age !== undefined && value.age !== null) {
+      json.age = value.age;
+    }
+    if (value.score !== undefined && value.score !== null) {
+      json.score = value.score;
+    }
+    if (value.avatar !== undefined && value.avatar !== null) {
+      json.avatar = value.avatar;
+    }
+    if (value.gender !== undefined && value.gender !== null) {
+      json.gender = value.gender;
+    }
+    if (value.tags !== undefined && value.tags !== null) {
+      json.tags = value.tags;
+    }
+    if (value.roles !== undefined && value.roles !== null) {
+      json.roles = listToJSON(value.roles);
+    }
+    if (value.counters !== undefined && value.counters !== null) {
+      json.counters = mapToJSON(value.counters, (v0: any) => i64ToJSON(v0));
+    }
+    if (value.history !== undefined && value.history !== null) {
+      json.history = mapToJSON(value.history, (v0: any) => listToJSON(v0, (v1: any) => Address.toJSON(v1)));
+    }
+    if (value.address !== undefined && value.address !== null) {
+      json.address = Address.toJSON(value.address);
+    }
+    if (value.contact !== undefined && value.contact !== null) {
+      json.contact = Contact.toJSON(value.contact);
+    }
+    if (value.friends !== undefined && value.friends !== null) {
+      json.friends = listToJSON(value.friends, (v0: any) => i64ToJSON(v0));
+    }
+    if (value.location !== undefined && value.location !== null) {
+      json.location = Address.toJSON(value.location);
+    }
+    Object.assign(json, Tracking.toJSON(value as any));
+    Object.assign(json, PageReq.toJSON(value as any));
+    if (value.nested !== undefined && value.nested !== null) {
+      json.nested = listToJSON(value.nested, (v0: any) => mapToJSON(v0, (v1: any) => listToJSON(v1)));
+    }
+    return json;
+  },
+};
+
+/**
+ * User 的 Zod schema
+ */
+export const UserSchema = z.object({
+  id: z.string().regex(/^-?\d+$/),
+  name: z.string(),
+  active: z.boolean().optional(),
+  level: z.number().int().min(-128).max(127).optional(),
+  rank: z.number().int().min(-32768).max(32767).optional(),
+  age: z.number().int().min(-2147483648).max(2147483647).optional(),
+  score: z.number().optional(),
+  avatar: z.instanceof(Uint8Array).optional(),
+  gender: GenderSchema.optional(),
+  tags: z.array(z.string()).optional(),
+  roles: z.union([z.set(z.number().int().min(-2147483648).max(2147483647)), z.array(z.number().int().min(-2147483648).max(2147483647)).transform((items) => new Set(items))]).optional(),
+  counters: z.record(z.string(), z.string().regex(/^-?\d+$/)).optional(),
+  history: z.record(z.string(), z.array(z.lazy(() => AddressSchema))).optional(),
+  address: z.lazy(() => AddressSchema).optional(),
+  contact: z.lazy(() => ContactSchema).optional(),
+  friends: z.array(z.string().regex(/^-?\d+$/)).optional(),
+  location: z.lazy(() => LocationSchema).optional(),
+  nested: z.array(z.record(z.string(), z.union([z.set(z.boolean()), z.array(z.boolean()).transform((items) => new Set(items))]))).optional(),
+  trace_id: z.string().optional(),
+  page_num: z.number().int().min(-2147483648).max(2147483647).optional(),
+  page_size: z.number().int().min(-2147483648).max(2147483647).optional(),
+});
+export type UserInferred = z.infer<typeof UserSchema>;
+
+/**
+ * IUserService 异步接口
+ * 用于 Axios 客户端等异步实现
+ */
+export interface IUserService {
+  get_user(id: string): Promise<User>;
+}
+export type IdList = Array<string>;
+
+/**
+ * IdList 的 Zod schema
+ */
+export const IdListSchema = z.array(z.string().regex(/^-?\d+$/));
+export type IdListInferred = z.infer<typeof IdListSchema>;
+export type Location = Address;
+
+/**
+ * Location 的 Zod schema
+ */
+export const LocationSchema = z.lazy(() => AddressSchema);
+export type LocationInferred = z.infer<typeof LocationSchema>;
+export interface Contact {
+  email?: string | undefined;
+  phone?: string | undefined;
+}
+
+/**
+ * Contact 的编解码方法
+ */
+export const Contact = {
+  fromJSON(json: any): Contact {
+    const value: any = {};
+    if (json.email !== undefined && json.email !== null) {
+      value.email = json.email;
+    }
+    if (json.phone !== undefined && json.phone !== null) {
+      value.phone = json.phone;
+    }
+    return value;
+  },
+
+  toJSON(value: Contact): any {
+    const json: any = {};
+    if (value.email !== undefined && value.email !== null) {
+      json.email = value.email;
+    }
+    if (value.phone !== undefined && value.phone !== null) {
+      json.phone = value.phone;
+    }
+    return json;
+  },
+};
+
+/**
+ * Contact 的 Zod schema
+ */
+export const ContactSchema = z.union([
+  z.object({
+    email: z.string(),
+  }).strict(),
+  z.object({
+    phone: z.string(),
+  }).strict(),
+]);
+export type ContactInferred = z.infer<typeof ContactSchema>;
+export interface NotFound {
+  code: number;
+  message?: string | undefined;
+}
+
+/**
+ * NotFound 的编解码方法
+ */
+export const NotFound = {
+  fromJSON(json: any): NotFound {
+    const value: any = {};
+    if (json.code !== undefined && json.code !== null) {
+      value.code = json.code;
+    }
+    if (json.message !== undefined && json.message !== null) {
+      value.message = json.message;
+    }
+    return value;
+  },
+
+  toJSON(value: NotFound): any {
+    const json: any = {};
+    if (value.code !== undefined && value.code !== null) {
+      json.code = value.code;
+    }
+    if (value.message !== undefined && value.message !== null) {
+      json.message = value.message;
+    }
+    return json;
+  },
+};
+
+/**
+ * NotFound 的 Zod schema
+ */
+export const NotFoundSchema = z.object({
+  code: z.number().int().min(-2147483648).max(2147483647),
+  message: z.string().optional(),
+});
+export type NotFoundInferred = z.infer<typeof NotFoundSchema>;
\ No newline at end of file
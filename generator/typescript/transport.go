@@ -0,0 +1,47 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package typescript
+
+import "strings"
+
+// TransportFileName 是 HTTP 传输层生成的文件名
+const TransportFileName = "http_transport.ts"
+
+// 客户端默认使用的 HTTP 传输层
+const (
+	HttpTransportFetch = "fetch"
+	HttpTransportAxios = "axios"
+)
+
+// GetTransportImportPath 获取传输层的导入路径，未配置 transport_import 时导入生成的 http_transport.ts
+func (u *CodeUtils) GetTransportImportPath() string {
+	if u.features.TransportImport != "" {
+		return u.features.TransportImport
+	}
+	return u.rootImportPath + "/" + strings.TrimSuffix(TransportFileName, ".ts")
+}
+
+// GetBizExceptionImportPath 获取 BizException 的导入路径，默认与传输层相同
+func (u *CodeUtils) GetBizExceptionImportPath() string {
+	if u.features.BizExceptionImport != "" {
+		return u.features.BizExceptionImport
+	}
+	return u.GetTransportImportPath()
+}
+
+// ShouldGenerateTransport 检查是否需要生成 http_transport.ts
+func (u *CodeUtils) ShouldGenerateTransport() bool {
+	return u.features.TransportImport == ""
+}
@@ -72,12 +72,36 @@ var typeMappings = []TypeMapping{
 	{"map", "Map", false},
 }
 
+// i64 在 TypeScript 中的映射方式
+const (
+	I64AsNumber = "number"
+	I64AsBigInt = "bigint"
+	I64AsString = "string"
+)
+
+// typeMapper 将 Thrift 类型转换为 TypeScript 类型，i64 按 i64Type 映射
+type typeMapper struct {
+	i64Type string
+}
+
+// defaultTypeMapper 把 i64 映射为 number
+var defaultTypeMapper = &typeMapper{i64Type: I64AsNumber}
+
 // GetTypeScriptType 将 Thrift 类型转换为 TypeScript 类型
 func GetTypeScriptType(thriftType *parser.Type) string {
+	return defaultTypeMapper.typeOf(thriftType)
+}
+
+func (m *typeMapper) typeOf(thriftType *parser.Type) string {
 	if thriftType == nil {
 		return "any"
 	}
 
+	// typedef 后的 i64 保留了别名，按类别映射
+	if thriftType.Category == parser.Category_I64 {
+		return m.i64Type
+	}
+
 	// 处理基本类型
 	if isPrimitiveType(thriftType.Category) {
 		for _, mapping := range typeMappings {
@@ -91,14 +115,18 @@ func GetTypeScriptType(thriftType *parser.Type) string {
 	// 处理容器类型
 	switch thriftType.Category {
 	case parser.Category_List:
-		elementType := GetTypeScriptType(thriftType.ValueType)
+		elementType := m.typeOf(thriftType.ValueType)
 		return fmt.Sprintf("Array<%s>", elementType)
 	case parser.Category_Set:
-		elementType := GetTypeScriptType(thriftType.ValueType)
+		elementType := m.typeOf(thriftType.ValueType)
 		return fmt.Sprintf("Set<%s>", elementType)
 	case parser.Category_Map:
-		keyType := GetTypeScriptType(thriftType.KeyType)
-		valueType := GetTypeScriptType(thriftType.ValueType)
+		keyType := m.typeOf(thriftType.KeyType)
+		valueType := m.typeOf(thriftType.ValueType)
+		// bigint 不能作为对象的键，JSON 中的键本身也是字符串
+		if keyType == I64AsBigInt {
+			keyType = "string"
+		}
 		// 在 TypeScript 中，Map 类型应该使用对象类型语法
 		return fmt.Sprintf("{ [key: %s]: %s }", keyType, valueType)
 	case parser.Category_Enum:
@@ -106,7 +134,7 @@ func GetTypeScriptType(thriftType *parser.Type) string {
 	case parser.Category_Struct, parser.Category_Union, parser.Category_Exception:
 		return getSimpleTypeName(thriftType.Name)
 	case parser.Category_Typedef:
-		return GetTypeScriptType(thriftType.ValueType)
+		return m.typeOf(thriftType.ValueType)
 	default:
 		return "any"
 	}
@@ -158,7 +186,11 @@ func isPrimitiveType(category parser.Category) bool {
 
 // GetFieldType 获取字段的 TypeScript 类型
 func GetFieldType(field *parser.Field) string {
-	tsType := GetTypeScriptType(field.Type)
+	return defaultTypeMapper.fieldType(field)
+}
+
+func (m *typeMapper) fieldType(field *parser.Field) string {
+	tsType := m.typeOf(field.Type)
 
 	// 处理可选字段
 	if field.Requiredness == parser.FieldType_Optional {
@@ -170,12 +202,16 @@ func GetFieldType(field *parser.Field) string {
 
 // GetMethodSignature 获取方法的 TypeScript 签名
 func GetMethodSignature(method *parser.Function) string {
+	return defaultTypeMapper.methodSignature(method)
+}
+
+func (m *typeMapper) methodSignature(method *parser.Function) string {
 	var params []string
 	var returnType string
 
 	// 处理参数
 	for _, param := range method.Arguments {
-		paramType := GetFieldType(param)
+		paramType := m.fieldType(param)
 		paramName := param.Name
 		if param.Requiredness == parser.FieldType_Optional {
 			paramName += "?"
@@ -185,7 +221,7 @@ func GetMethodSignature(method *parser.Function) string {
 
 	// 处理返回值
 	if method.FunctionType != nil {
-		returnType = GetTypeScriptType(method.FunctionType)
+		returnType = m.typeOf(method.FunctionType)
 	} else {
 		returnType = "void"
 	}
@@ -195,12 +231,16 @@ func GetMethodSignature(method *parser.Function) string {
 
 // GetAsyncMethodSignature 获取异步方法的 TypeScript 签名
 func GetAsyncMethodSignature(method *parser.Function) string {
+	return defaultTypeMapper.asyncMethodSignature(method)
+}
+
+func (m *typeMapper) asyncMethodSignature(method *parser.Function) string {
 	var params []string
 	var returnType string
 
 	// 处理参数
 	for _, param := range method.Arguments {
-		paramType := GetFieldType(param)
+		paramType := m.fieldType(param)
 		paramName := param.Name
 		if param.Requiredness == parser.FieldType_Optional {
 			paramName += "?"
@@ -210,7 +250,7 @@ func GetAsyncMethodSignature(method *parser.Function) string {
 
 	// 处理返回值 - 异步方法返回 Promise
 	if method.FunctionType != nil {
-		returnType = fmt.Sprintf("Promise<%s>", GetTypeScriptType(method.FunctionType))
+		returnType = fmt.Sprintf("Promise<%s>", m.typeOf(method.FunctionType))
 	} else {
 		returnType = "Promise<void>"
 	}
@@ -0,0 +1,169 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package typescript
+
+import (
+	"flag"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/cloudwego/thriftgo/generator/backend"
+	"github.com/cloudwego/thriftgo/parser"
+	"github.com/cloudwego/thriftgo/pkg/test"
+	"github.com/cloudwego/thriftgo/plugin"
+	"github.com/cloudwego/thriftgo/semantic"
+)
+
+// 使用 go test ./generator/typescript -update 重新生成 testdata 下的期望输出
+var update = flag.Bool("update", false, "update golden files in testdata")
+
+const goldenOutput = "gen-ts"
+
+func runTypeScript(t *testing.T, idl string, params []string) (*plugin.Response, []string) {
+	ast, err := parser.ParseFile(idl, nil, true)
+	test.Assert(t, err == nil, err)
+	checker := semantic.NewChecker(semantic.Options{FixWarnings: true})
+	_, err = checker.CheckAll(ast)
+	test.Assert(t, err == nil, err)
+	err = semantic.ResolveSymbols(ast)
+	test.Assert(t, err == nil, err)
+
+	req := &plugin.Request{
+		Language:            "typescript",
+		Version:             "?",
+		OutputPath:          goldenOutput,
+		Recursive:           true,
+		AST:                 ast,
+		GeneratorParameters: params,
+	}
+	var warnings []string
+	log := backend.LogFunc{
+		Info:      func(v ...interface{}) {},
+		Warn:      func(v ...interface{}) {},
+		MultiWarn: func(warns []string) { warnings = append(warnings, warns...) },
+	}
+	return new(TypeScriptBackend).Generate(req, log), warnings
+}
+
+func generateTypeScript(t *testing.T, idl string, params []string) map[string]string {
+	res, warnings := runTypeScript(t, idl, params)
+	test.Assert(t, res.GetError() == "", res.GetError())
+	test.Assert(t, len(warnings) == 0, warnings)
+
+	files := make(map[string]string)
+	for _, c := range res.Contents {
+		name, err := filepath.Rel(goldenOutput, c.GetName())
+		test.Assert(t, err == nil, err)
+		files[filepath.ToSlash(name)] = c.Content
+	}
+	return files
+}
+
+func readGolden(t *testing.T, dir string) map[string]string {
+	files := make(map[string]string)
+	err := filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil || d.IsDir() {
+			return err
+		}
+		content, err := os.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		name, _ := filepath.Rel(dir, path)
+		files[filepath.ToSlash(name)] = string(content)
+		return nil
+	})
+	test.Assert(t, err == nil, err)
+	return files
+}
+
+func writeGolden(t *testing.T, dir string, files map[string]string) {
+	test.Assert(t, os.RemoveAll(dir) == nil)
+	for name, content := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		test.Assert(t, os.MkdirAll(filepath.Dir(path), 0o755) == nil)
+		test.Assert(t, os.WriteFile(path, []byte(content), 0o644) == nil)
+	}
+}
+
+func sortedNames(files map[string]string) []string {
+	names := make([]string, 0, len(files))
+	for name := range files {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+func TestGolden(t *testing.T) {
+	cases := []struct {
+		name   string
+		idl    string
+		params []string
+	}{
+		{"thrift_codec", "test_codec.thrift", []string{"thrift_codec=true"}},
+		{"i64_as_bigint", "test_codec.thrift", []string{"thrift_codec=true", "i64_as=bigint"}},
+		{"i64_as_string", "test_codec.thrift", []string{"i64_as=string"}},
+		{"http_transport_fetch", "test_server.thrift", nil},
+		{"http_transport_axios", "test_server.thrift", []string{"http_transport=axios", "axios_import=@/utils/request", "i64_as=bigint"}},
+		{"validators", "test_validators.thrift", []string{"validators=true"}},
+		{"validators_bigint", "test_validators.thrift", []string{"validators=true", "i64_as=bigint"}},
+		{"zod_schemas", "test_zod.thrift", []string{"zod_schemas=true"}},
+		{"zod_schemas_snake", "test_zod.thrift", []string{"zod_schemas=true", "snake_style_property_name=true", "zod_import=zod/v4", "i64_as=string"}},
+		{"server_router", "test_server.thrift", []string{"server_router=express"}},
+		{"server_router_fastify", "test_server.thrift", []string{"server_router=fastify", "i64_as=bigint", "generate_classes=true"}},
+		{"hooks_react_query", "test_server.thrift", []string{"hooks=react-query", "i64_as=bigint"}},
+		{"hooks_swr", "test_server.thrift", []string{"hooks=swr"}},
+		{"generate_classes", "test_classes.thrift", []string{"generate_classes=true"}},
+		{"generate_classes_bigint", "test_classes.thrift", []string{"generate_classes=true", "i64_as=bigint"}},
+		{"declarations", "test_server.thrift", []string{"declarations=true"}},
+		{"bundle_file", "test_server.thrift", []string{"bundle=file"}},
+		{"bundle_tree", "test_server.thrift", []string{"bundle=tree", "i64_as=bigint"}},
+		{"bundle_tree_declarations", "test_classes.thrift", []string{"bundle=tree", "declarations=true"}},
+		{"mocks", "test_codec.thrift", []string{"mocks=true"}},
+		{"mocks_classes", "test_codec.thrift", []string{"mocks=true", "generate_classes=true", "i64_as=bigint"}},
+		{"mocks_recursive", "test_recursive.thrift", []string{"mocks=true"}},
+		{"transport_import", "test_server.thrift", []string{"transport_import=@/api/transport", "biz_exception_import=@/api/errors"}},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			got := generateTypeScript(t, filepath.Join("testdata", c.idl), c.params)
+			dir := filepath.Join("testdata", c.name)
+			if *update {
+				writeGolden(t, dir, got)
+				return
+			}
+			want := readGolden(t, dir)
+			test.Assert(t, strings.Join(sortedNames(got), "\n") == strings.Join(sortedNames(want), "\n"),
+				sortedNames(got), sortedNames(want))
+			for name, content := range want {
+				test.Assert(t, got[name] == content, "generated "+name+" differs from the golden file, run go test -update to regenerate")
+			}
+		})
+	}
+}
+
+func TestBundleTreeDuplicateName(t *testing.T) {
+	res, _ := runTypeScript(t, filepath.Join("testdata", "test_bundle_duplicate.thrift"), []string{"bundle=tree"})
+	test.Assert(t, strings.Contains(res.GetError(), `bundle=tree: "PageReq" is defined in both`), res.GetError())
+
+	// 每个 IDL 单独合并时同名定义在不同的模块中，不会冲突
+	res, _ = runTypeScript(t, filepath.Join("testdata", "test_bundle_duplicate.thrift"), []string{"bundle=file"})
+	test.Assert(t, res.GetError() == "", res.GetError())
+}
@@ -0,0 +1,238 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package typescript
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cloudwego/thriftgo/internal/apiutil"
+	"github.com/cloudwego/thriftgo/parser"
+)
+
+// 整数类型的取值范围
+var intRanges = map[parser.Category][2]int64{
+	parser.Category_Byte: {-1 << 7, 1<<7 - 1},
+	parser.Category_I16:  {-1 << 15, 1<<15 - 1},
+	parser.Category_I32:  {-1 << 31, 1<<31 - 1},
+}
+
+// GetValidatorName 获取类型的校验函数名
+func GetValidatorName(name string) string {
+	return "validate" + GetInterfaceName(getSimpleTypeName(name))
+}
+
+// GetValidatorBody 生成结构体校验函数的函数体：检查 required 字段、字段类型、容器元素和 api.vd 中的简单规则
+func (u *CodeUtils) GetValidatorBody(structLike *parser.StructLike) string {
+	expanded := u.getExpandedFieldNames(structLike)
+	w := &codecWriter{ast: u.currentAST, i64As: u.features.I64As}
+	isUnion := structLike.Category == "union"
+
+	w.line(1, "if (typeof value !== 'object' || value === null || Array.isArray(value)) {")
+	w.line(2, "return false;")
+	w.line(1, "}")
+	w.line(1, "const v: any = value;")
+	if isUnion {
+		w.line(1, "let count = 0;")
+	}
+	for _, f := range structLike.Fields {
+		if expanded[f.Name] {
+			w.line(1, "if (!%s(value)) {", GetValidatorName(f.Type.Name))
+			w.line(2, "return false;")
+			w.line(1, "}")
+			continue
+		}
+		ast, typ := w.deref(w.ast, f.Type)
+		value := "v." + GetPropertyNameWithStyle(f.Name, u.features)
+		check := w.validate(ast, f.Type, typ, value, 0)
+		var skipped []string
+		for _, clause := range apiutil.ParseVd(apiutil.VdExpr(f)) {
+			if c := w.vdCheck(typ, value, clause); c != "" {
+				check += " && " + c
+			} else {
+				skipped = append(skipped, clause.Expr)
+			}
+		}
+		if len(skipped) > 0 {
+			w.line(1, "// 无法在前端校验的 api.vd 条件：%s", strings.Join(skipped, " && "))
+		}
+		if f.Requiredness == parser.FieldType_Required {
+			w.line(1, "if (%s === undefined || %s === null || !(%s)) {", value, value, check)
+			w.line(2, "return false;")
+			w.line(1, "}")
+		} else {
+			w.line(1, "if (%s !== undefined && %s !== null) {", value, value)
+			w.line(2, "if (!(%s)) {", check)
+			w.line(3, "return false;")
+			w.line(2, "}")
+			if isUnion {
+				w.line(2, "count++;")
+			}
+			w.line(1, "}")
+		}
+	}
+	if isUnion {
+		w.line(1, "return count === 1;")
+	} else {
+		w.line(1, "return true;")
+	}
+	return w.flush()
+}
+
+// GetTypedefValidatorCheck 生成类型别名校验函数中对 value 的检查表达式
+func (u *CodeUtils) GetTypedefValidatorCheck(typedef *parser.Typedef) string {
+	w := &codecWriter{ast: u.currentAST, i64As: u.features.I64As}
+	ast, typ := w.deref(w.ast, typedef.Type)
+	return w.validate(ast, typedef.Type, typ, "value", 0)
+}
+
+// validate 返回检查 value 是否为 t 类型的表达式。named 是字段上声明的类型，
+// 枚举和结构体按其名称调用对应的校验函数，与接口中使用的类型名一致
+func (w *codecWriter) validate(ast *parser.Thrift, named, t *parser.Type, value string, depth int) string {
+	switch t.Category {
+	case parser.Category_Bool:
+		return fmt.Sprintf("typeof %s === 'boolean'", value)
+	case parser.Category_Byte, parser.Category_I16, parser.Category_I32:
+		r := intRanges[t.Category]
+		return fmt.Sprintf("Number.isInteger(%s) && %s >= %d && %s <= %d", value, value, r[0], value, r[1])
+	case parser.Category_I64:
+		switch w.i64As {
+		case I64AsBigInt:
+			return fmt.Sprintf("typeof %s === 'bigint'", value)
+		case I64AsString:
+			return fmt.Sprintf("typeof %s === 'string' && /^-?\\d+$/.test(%s)", value, value)
+		default:
+			return fmt.Sprintf("Number.isInteger(%s)", value)
+		}
+	case parser.Category_Double:
+		return fmt.Sprintf("typeof %s === 'number'", value)
+	case parser.Category_String:
+		return fmt.Sprintf("typeof %s === 'string'", value)
+	case parser.Category_Binary:
+		return fmt.Sprintf("%s instanceof Uint8Array", value)
+	case parser.Category_List, parser.Category_Set:
+		elemAST, elem := w.deref(ast, t.ValueType)
+		e := fmt.Sprintf("v%d", depth)
+		check := w.validate(elemAST, t.ValueType, elem, e, depth+1)
+		if t.Category == parser.Category_Set {
+			// JSON 中的 set 以数组表示，两者都接受
+			return fmt.Sprintf("(Array.isArray(%s) || %s instanceof Set) && Array.from(%s).every((%s: any) => %s)",
+				value, value, value, e, check)
+		}
+		return fmt.Sprintf("Array.isArray(%s) && %s.every((%s: any) => %s)", value, value, e, check)
+	case parser.Category_Map:
+		_, key := w.deref(ast, t.KeyType)
+		valAST, val := w.deref(ast, t.ValueType)
+		k := fmt.Sprintf("k%d", depth)
+		check := w.validate(valAST, t.ValueType, val, value+"["+k+"]", depth+1)
+		if kc := mapKeyCheck(t.KeyType, key, k); kc != "" {
+			check = kc + " && " + check
+		}
+		return fmt.Sprintf("typeof %s === 'object' && %s !== null && !Array.isArray(%s) && Object.keys(%s).every((%s) => %s)",
+			value, value, value, value, k, check)
+	case parser.Category_Enum, parser.Category_Struct, parser.Category_Union, parser.Category_Exception:
+		return fmt.Sprintf("%s(%s)", GetValidatorName(named.Name), value)
+	default:
+		return "true"
+	}
+}
+
+// mapKeyCheck 返回检查对象的字符串键能否转换为 map 键类型的表达式，字符串键无需检查
+func mapKeyCheck(named, t *parser.Type, key string) string {
+	switch t.Category {
+	case parser.Category_Bool:
+		return fmt.Sprintf("(%s === 'true' || %s === 'false')", key, key)
+	case parser.Category_Byte, parser.Category_I16, parser.Category_I32, parser.Category_I64:
+		return fmt.Sprintf("/^-?\\d+$/.test(%s)", key)
+	case parser.Category_Double:
+		return fmt.Sprintf("!Number.isNaN(Number(%s))", key)
+	case parser.Category_Enum:
+		return fmt.Sprintf("%s(Number(%s))", GetValidatorName(named.Name), key)
+	default:
+		return ""
+	}
+}
+
+// vdCheck 把单个 api.vd 条件转换为对 value 的检查表达式，无法转换时返回空字符串
+func (w *codecWriter) vdCheck(t *parser.Type, value string, clause *apiutil.VdClause) string {
+	switch clause.Kind {
+	case apiutil.VdLen:
+		var length string
+		switch t.Category {
+		case parser.Category_String, parser.Category_Binary, parser.Category_List:
+			length = value + ".length"
+		case parser.Category_Set:
+			length = "Array.from(" + value + ").length"
+		case parser.Category_Map:
+			length = "Object.keys(" + value + ").length"
+		default:
+			return ""
+		}
+		return fmt.Sprintf("%s %s %s", length, jsOperator(clause.Op), clause.Bound)
+
+	case apiutil.VdRange:
+		switch t.Category {
+		case parser.Category_Byte, parser.Category_I16, parser.Category_I32, parser.Category_Double:
+			return fmt.Sprintf("%s %s %s", value, jsOperator(clause.Op), clause.Bound)
+		case parser.Category_I64:
+			if w.i64As == I64AsNumber {
+				return fmt.Sprintf("%s %s %s", value, jsOperator(clause.Op), clause.Bound)
+			}
+			if strings.Contains(clause.Bound, ".") {
+				return ""
+			}
+			return fmt.Sprintf("BigInt(%s) %s BigInt('%s')", value, jsOperator(clause.Op), clause.Bound)
+		}
+		return ""
+
+	case apiutil.VdRegexp:
+		if t.Category != parser.Category_String {
+			return ""
+		}
+		return fmt.Sprintf("new RegExp(%s).test(%s)", strconv.Quote(clause.Pattern), value)
+
+	case apiutil.VdIn:
+		var values []string
+		for _, v := range clause.Values {
+			values = append(values, vdLiteral(v))
+		}
+		if t.Category == parser.Category_I64 && w.i64As != I64AsNumber {
+			return fmt.Sprintf("[%s].map(String).includes(String(%s))", strings.Join(values, ", "), value)
+		}
+		return fmt.Sprintf("([%s] as unknown[]).includes(%s)", strings.Join(values, ", "), value)
+	}
+	return ""
+}
+
+// jsOperator 把 api.vd 中的比较运算符转换为 TypeScript 的严格比较运算符
+func jsOperator(op string) string {
+	switch op {
+	case "==":
+		return "==="
+	case "!=":
+		return "!=="
+	default:
+		return op
+	}
+}
+
+// vdLiteral 把 api.vd 中的字符串或数字字面量转换为 TypeScript 字面量
+func vdLiteral(v apiutil.VdLiteral) string {
+	if s, ok := v.Value.(string); ok {
+		return strconv.Quote(s)
+	}
+	return v.Text
+}
@@ -0,0 +1,218 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package typescript
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cloudwego/thriftgo/parser"
+)
+
+// zodField 表示 z.object 中的一个属性
+type zodField struct {
+	property string
+	schema   string
+}
+
+// GetZodSchemaName 获取类型对应的 Zod schema 常量名
+func GetZodSchemaName(name string) string {
+	return GetInterfaceName(getSimpleTypeName(name)) + "Schema"
+}
+
+// GetZodSchema 获取结构体、联合体或异常的 Zod schema，被展开的字段按原结构体的字段平铺，与接口保持一致
+func (u *CodeUtils) GetZodSchema(structLike *parser.StructLike) string {
+	w := &codecWriter{ast: u.currentAST, i64As: u.features.I64As}
+	if structLike.Category == "union" {
+		// 联合体有且只有一个字段被设置
+		var variants []string
+		for _, f := range structLike.Fields {
+			ast, typ := w.deref(w.ast, f.Type)
+			variant := &zodField{
+				property: GetPropertyNameWithStyle(f.Name, u.features),
+				schema:   w.zodType(ast, f.Type, typ),
+			}
+			variants = append(variants, "z.object("+zodObject([]*zodField{variant}, 1)+").strict()")
+		}
+		switch len(variants) {
+		case 0:
+			return "z.object({}).strict()"
+		case 1:
+			return variants[0]
+		}
+		return "z.union([\n  " + strings.Join(variants, ",\n  ") + ",\n])"
+	}
+
+	expanded := u.getExpandedFieldNames(structLike)
+	var fields []*zodField
+	for _, f := range structLike.Fields {
+		if !expanded[f.Name] {
+			fields = append(fields, u.zodField(w, f))
+		}
+	}
+	for _, f := range u.getExpandedFields(structLike) {
+		fields = append(fields, u.zodField(w, f))
+	}
+	return "z.object(" + zodObject(fields, 0) + ")"
+}
+
+// GetTypedefZodSchema 获取类型别名的 Zod schema
+func (u *CodeUtils) GetTypedefZodSchema(typedef *parser.Typedef) string {
+	w := &codecWriter{ast: u.currentAST, i64As: u.features.I64As}
+	ast, typ := w.deref(w.ast, typedef.Type)
+	return w.zodType(ast, typedef.Type, typ)
+}
+
+// IsRecursiveStruct 检查结构体是否直接或间接引用了自身，这类 schema 无法推导出类型
+func (u *CodeUtils) IsRecursiveStruct(structLike *parser.StructLike) bool {
+	ast := u.currentAST
+	if ast == nil {
+		ast = GetGlobalAST()
+	}
+	w := &codecWriter{ast: ast}
+	visited := make(map[*parser.StructLike]bool)
+	var reaches func(ast *parser.Thrift, t *parser.Type) bool
+	reaches = func(ast *parser.Thrift, t *parser.Type) bool {
+		if t == nil {
+			return false
+		}
+		a, typ := w.deref(ast, t)
+		switch {
+		case typ.Category.IsContainerType():
+			return reaches(a, typ.KeyType) || reaches(a, typ.ValueType)
+		case typ.Category.IsStructLike():
+			s := findStructLikeByName(typ.Name, a)
+			if s == structLike {
+				return true
+			}
+			if s == nil || visited[s] {
+				return false
+			}
+			visited[s] = true
+			for _, f := range s.Fields {
+				if reaches(a, f.Type) {
+					return true
+				}
+			}
+		}
+		return false
+	}
+	for _, f := range structLike.Fields {
+		if reaches(ast, f.Type) {
+			return true
+		}
+	}
+	return false
+}
+
+func (u *CodeUtils) zodField(w *codecWriter, f *parser.Field) *zodField {
+	ast, typ := w.deref(w.ast, f.Type)
+	schema := w.zodType(ast, f.Type, typ)
+	if f.Requiredness != parser.FieldType_Required {
+		if def := u.zodDefault(f, typ); def != "" {
+			schema += ".default(" + def + ")"
+		} else {
+			schema += ".optional()"
+		}
+	}
+	return &zodField{
+		property: GetPropertyNameWithStyle(f.Name, u.features),
+		schema:   schema,
+	}
+}
+
+// zodDefault 返回字段默认值对应的字面量，只支持基本类型，无法表示时返回空字符串
+func (u *CodeUtils) zodDefault(f *parser.Field, t *parser.Type) string {
+	if f.Default == nil || !isPrimitiveType(t.Category) || t.Category == parser.Category_Binary {
+		return ""
+	}
+	if t.Category == parser.Category_Double && f.Default.TypedValue != nil && f.Default.TypedValue.Double != nil {
+		return strconv.FormatFloat(*f.Default.TypedValue.Double, 'g', -1, 64)
+	}
+	value := GetDefaultValue(&parser.Field{Type: t, Default: f.Default})
+	if value == "" || value == "null" {
+		return ""
+	}
+	if t.Category == parser.Category_I64 {
+		switch u.features.I64As {
+		case I64AsBigInt:
+			return fmt.Sprintf("BigInt('%s')", value)
+		case I64AsString:
+			return fmt.Sprintf("'%s'", value)
+		}
+	}
+	return value
+}
+
+// zodType 返回 t 类型的 Zod schema。named 是声明时使用的类型，
+// 枚举和结构体按其名称引用对应的 schema，与接口中使用的类型名一致
+func (w *codecWriter) zodType(ast *parser.Thrift, named, t *parser.Type) string {
+	switch t.Category {
+	case parser.Category_Bool:
+		return "z.boolean()"
+	case parser.Category_Byte, parser.Category_I16, parser.Category_I32:
+		r := intRanges[t.Category]
+		return fmt.Sprintf("z.number().int().min(%d).max(%d)", r[0], r[1])
+	case parser.Category_I64:
+		switch w.i64As {
+		case I64AsBigInt:
+			return "z.bigint()"
+		case I64AsString:
+			return "z.string().regex(/^-?\\d+$/)"
+		default:
+			return "z.number().int()"
+		}
+	case parser.Category_Double:
+		return "z.number()"
+	case parser.Category_String:
+		return "z.string()"
+	case parser.Category_Binary:
+		return "z.instanceof(Uint8Array)"
+	case parser.Category_List:
+		elemAST, elem := w.deref(ast, t.ValueType)
+		return fmt.Sprintf("z.array(%s)", w.zodType(elemAST, t.ValueType, elem))
+	case parser.Category_Set:
+		// JSON 中的 set 以数组表示，解析时转换为 Set
+		elemAST, elem := w.deref(ast, t.ValueType)
+		e := w.zodType(elemAST, t.ValueType, elem)
+		return fmt.Sprintf("z.union([z.set(%s), z.array(%s).transform((items) => new Set(items))])", e, e)
+	case parser.Category_Map:
+		valAST, val := w.deref(ast, t.ValueType)
+		return fmt.Sprintf("z.record(z.string(), %s)", w.zodType(valAST, t.ValueType, val))
+	case parser.Category_Enum:
+		return GetZodSchemaName(named.Name)
+	case parser.Category_Struct, parser.Category_Union, parser.Category_Exception:
+		// 延迟引用，避免声明顺序和循环引用的问题
+		return fmt.Sprintf("z.lazy(() => %s)", GetZodSchemaName(named.Name))
+	default:
+		return "z.any()"
+	}
+}
+
+// zodObject 把属性列表拼接为 z.object 的参数
+func zodObject(fields []*zodField, indent int) string {
+	if len(fields) == 0 {
+		return "{}"
+	}
+	pad := strings.Repeat("  ", indent)
+	var sb strings.Builder
+	sb.WriteString("{\n")
+	for _, f := range fields {
+		sb.WriteString(fmt.Sprintf("%s  %s: %s,\n", pad, f.property, f.schema))
+	}
+	sb.WriteString(pad + "}")
+	return sb.String()
+}
@@ -0,0 +1,67 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apiutil
+
+import (
+	"testing"
+
+	"github.com/cloudwego/thriftgo/parser"
+	"github.com/cloudwego/thriftgo/pkg/test"
+)
+
+func TestParseVd(t *testing.T) {
+	clauses := ParseVd(`@:len($)>0 && $<=100 && regexp('^a&&b$') && in($, 'x,y', 2, 1.5) && f($); msg:'bad; value'`)
+	test.Assert(t, len(clauses) == 5, len(clauses))
+
+	test.Assert(t, clauses[0].Kind == VdLen && clauses[0].Op == ">" && clauses[0].Bound == "0", clauses[0])
+	test.Assert(t, clauses[1].Kind == VdRange && clauses[1].Op == "<=" && clauses[1].Bound == "100", clauses[1])
+	test.Assert(t, clauses[2].Kind == VdRegexp && clauses[2].Pattern == "^a&&b$", clauses[2])
+
+	in := clauses[3]
+	test.Assert(t, in.Kind == VdIn && len(in.Values) == 3, in)
+	test.Assert(t, in.Values[0].Value == "x,y" && in.Values[0].Text == "'x,y'", in.Values[0])
+	test.Assert(t, in.Values[1].Value == int64(2), in.Values[1])
+	test.Assert(t, in.Values[2].Value == 1.5, in.Values[2])
+
+	test.Assert(t, clauses[4].Kind == VdUnknown && clauses[4].Expr == "f($)", clauses[4])
+
+	test.Assert(t, ParseVd("") == nil)
+	test.Assert(t, ParseVd("in($, $)")[0].Kind == VdUnknown)
+}
+
+func TestExceptionCode(t *testing.T) {
+	ast, err := parser.ParseString("main.thrift", `
+exception NotFound {} (api.http_code = "404")
+exception Internal {}
+`)
+	test.Assert(t, err == nil, err)
+
+	field := func(typ, code string) *parser.Field {
+		f := &parser.Field{Name: "e", Type: &parser.Type{Name: typ, Category: parser.Category_Exception}}
+		if code != "" {
+			f.Annotations = parser.Annotations{{Key: "api.http_code", Values: []string{code}}}
+		}
+		return f
+	}
+
+	code, err := ExceptionCode(ast, field("NotFound", ""))
+	test.Assert(t, code == 404 && err == nil, code, err)
+	code, err = ExceptionCode(ast, field("NotFound", "410"))
+	test.Assert(t, code == 410 && err == nil, code, err)
+	code, err = ExceptionCode(ast, field("Internal", ""))
+	test.Assert(t, code == DefaultExceptionCode && err == nil, code, err)
+	code, err = ExceptionCode(ast, field("Internal", "abc"))
+	test.Assert(t, code == DefaultExceptionCode && err != nil, code, err)
+}
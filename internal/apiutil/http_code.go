@@ -0,0 +1,50 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package apiutil
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/cloudwego/thriftgo/parser"
+	"github.com/cloudwego/thriftgo/semantic"
+)
+
+// DefaultExceptionCode is the status code of exceptions without a valid api.http_code.
+const DefaultExceptionCode = 500
+
+// ExceptionCode returns the HTTP status code of an exception in the throws list
+// of a function defined in ast. The api.http_code on the throws field takes
+// precedence over the one on the exception definition. An invalid code is
+// reported as an error along with DefaultExceptionCode.
+func ExceptionCode(ast *parser.Thrift, field *parser.Field) (int, error) {
+	vals := field.Annotations.Get("api.http_code")
+	if len(vals) == 0 && ast != nil {
+		if g, t, err := semantic.Deref(ast, field.Type); err == nil {
+			if x, ok := g.GetException(t.Name); ok {
+				vals = x.Annotations.Get("api.http_code")
+			}
+		}
+	}
+	if len(vals) == 0 {
+		return DefaultExceptionCode, nil
+	}
+	code, err := strconv.Atoi(strings.TrimSpace(vals[0]))
+	if err != nil || code < 100 || code > 599 {
+		return DefaultExceptionCode, fmt.Errorf("invalid api.http_code %q on exception %q, use %d instead", vals[0], field.Name, DefaultExceptionCode)
+	}
+	return code, nil
+}
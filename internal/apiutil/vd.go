@@ -0,0 +1,179 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package apiutil interprets the api.* annotations shared by the HTTP code
+// generators, so that the Go, TypeScript and OpenAPI backends agree on them.
+package apiutil
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/cloudwego/thriftgo/parser"
+)
+
+// VdKind is the form of a condition in an api.vd expression.
+type VdKind int
+
+// Forms of api.vd conditions that the generators can translate.
+const (
+	VdUnknown VdKind = iota
+	VdLen            // len($) <op> n
+	VdRange          // $ <op> n
+	VdRegexp         // regexp('...')
+	VdIn             // in($, v1, v2, ...)
+)
+
+var (
+	vdLenPattern    = regexp.MustCompile(`^len\(\s*\$\s*\)\s*(>=|<=|==|!=|>|<)\s*(\d+)$`)
+	vdRangePattern  = regexp.MustCompile(`^\$\s*(>=|<=|==|!=|>|<)\s*(-?\d+(?:\.\d+)?)$`)
+	vdRegexpPattern = regexp.MustCompile(`^regexp\(\s*'(.*)'\s*\)$`)
+	vdInPattern     = regexp.MustCompile(`^in\(\s*\$\s*,(.*)\)$`)
+)
+
+// VdClause is a condition of an api.vd expression split at the top-level &&.
+type VdClause struct {
+	Expr    string // the condition as written
+	Kind    VdKind
+	Op      string      // the comparison operator of VdLen and VdRange
+	Bound   string      // the number compared with in VdLen and VdRange
+	Pattern string      // the regular expression of VdRegexp
+	Values  []VdLiteral // the candidates of VdIn
+}
+
+// VdLiteral is a string or number literal in an api.vd expression.
+type VdLiteral struct {
+	Text  string      // the literal as written
+	Value interface{} // string, int64 or float64
+}
+
+// VdExpr returns the api.vd expression of the field. Empty if there is none.
+func VdExpr(f *parser.Field) string {
+	vals := f.Annotations.Get("api.vd")
+	if len(vals) == 0 {
+		return ""
+	}
+	return strings.TrimSpace(vals[0])
+}
+
+// ParseVd splits the condition of an api.vd expression into clauses. The @:
+// prefix and the ; msg:'...' suffix are ignored. Clauses that are not of a
+// known form have the kind VdUnknown.
+func ParseVd(expr string) (clauses []*VdClause) {
+	cond := vdCondition(expr)
+	if cond == "" {
+		return nil
+	}
+	for _, c := range splitVdClauses(cond) {
+		clauses = append(clauses, parseVdClause(c))
+	}
+	return
+}
+
+func parseVdClause(expr string) *VdClause {
+	c := &VdClause{Expr: expr}
+	if m := vdLenPattern.FindStringSubmatch(expr); m != nil {
+		c.Kind, c.Op, c.Bound = VdLen, m[1], m[2]
+	} else if m := vdRangePattern.FindStringSubmatch(expr); m != nil {
+		c.Kind, c.Op, c.Bound = VdRange, m[1], m[2]
+	} else if m := vdRegexpPattern.FindStringSubmatch(expr); m != nil {
+		c.Kind, c.Pattern = VdRegexp, m[1]
+	} else if m := vdInPattern.FindStringSubmatch(expr); m != nil {
+		for _, arg := range splitVdArgs(m[1]) {
+			lit, ok := parseVdLiteral(arg)
+			if !ok {
+				return c
+			}
+			c.Values = append(c.Values, lit)
+		}
+		if len(c.Values) > 0 {
+			c.Kind = VdIn
+		}
+	}
+	return c
+}
+
+// vdCondition strips the @: prefix and the ; msg:'...' part of the expression.
+func vdCondition(expr string) string {
+	expr = strings.TrimPrefix(strings.TrimSpace(expr), "@:")
+	quoted := false
+	for i := 0; i < len(expr); i++ {
+		switch expr[i] {
+		case '\'':
+			quoted = !quoted
+		case ';':
+			if !quoted {
+				return strings.TrimSpace(expr[:i])
+			}
+		}
+	}
+	return strings.TrimSpace(expr)
+}
+
+// splitVdClauses splits the expression at the && outside of parentheses and quotes.
+func splitVdClauses(expr string) []string {
+	var clauses []string
+	depth, quoted, start := 0, false, 0
+	for i := 0; i < len(expr); i++ {
+		switch c := expr[i]; {
+		case c == '\'':
+			quoted = !quoted
+		case quoted:
+		case c == '(':
+			depth++
+		case c == ')':
+			depth--
+		case c == '&' && depth == 0 && i+1 < len(expr) && expr[i+1] == '&':
+			clauses = append(clauses, strings.TrimSpace(expr[start:i]))
+			start = i + 2
+			i++
+		}
+	}
+	return append(clauses, strings.TrimSpace(expr[start:]))
+}
+
+// splitVdArgs splits the arguments of in(...) at the commas outside of quotes.
+func splitVdArgs(args string) []string {
+	var res []string
+	quoted, start := false, 0
+	for i := 0; i < len(args); i++ {
+		switch args[i] {
+		case '\'':
+			quoted = !quoted
+		case ',':
+			if !quoted {
+				res = append(res, strings.TrimSpace(args[start:i]))
+				start = i + 1
+			}
+		}
+	}
+	if last := strings.TrimSpace(args[start:]); last != "" {
+		res = append(res, last)
+	}
+	return res
+}
+
+func parseVdLiteral(s string) (VdLiteral, bool) {
+	if len(s) >= 2 && s[0] == '\'' && s[len(s)-1] == '\'' {
+		return VdLiteral{Text: s, Value: s[1 : len(s)-1]}, true
+	}
+	if i, err := strconv.ParseInt(s, 10, 64); err == nil {
+		return VdLiteral{Text: s, Value: i}, true
+	}
+	if f, err := strconv.ParseFloat(s, 64); err == nil {
+		return VdLiteral{Text: s, Value: f}, true
+	}
+	return VdLiteral{}, false
+}
@@ -0,0 +1,342 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lsp
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/cloudwego/thriftgo/parser"
+)
+
+var baseTypes = []string{"bool", "byte", "i8", "i16", "i32", "i64", "double", "string", "binary", "list", "set", "map"}
+
+// annotationKeys are the annotations recognized by the generators of thriftgo.
+// Keys used in the loaded IDLs are suggested as well.
+var annotationKeys = []string{
+	"api.get", "api.post", "api.put", "api.delete", "api.patch", "api.head", "api.options",
+	"api.path", "api.query", "api.header", "api.cookie", "api.body", "api.form", "api.vd", "api.http_code",
+	"go.tag", "go.type", "thrift.expand", "thrift.nested", "thrift.is_interface", "thrift.is_alias",
+	"ts.gen_fields", "expandable",
+}
+
+var symbolKinds = map[string]int{
+	kindStruct:    SymbolKindStruct,
+	kindUnion:     SymbolKindStruct,
+	kindException: SymbolKindClass,
+	kindEnum:      SymbolKindEnum,
+	kindEnumValue: SymbolKindEnumMember,
+	kindTypedef:   SymbolKindTypeParam,
+	kindConst:     SymbolKindConstant,
+	kindService:   SymbolKindInterface,
+	kindField:     SymbolKindField,
+	kindFunction:  SymbolKindMethod,
+}
+
+var completionKinds = map[string]int{
+	kindStruct:    CompletionKindStruct,
+	kindUnion:     CompletionKindStruct,
+	kindException: CompletionKindClass,
+	kindEnum:      CompletionKindEnum,
+	kindTypedef:   CompletionKindTypeParam,
+	kindService:   CompletionKindInterface,
+}
+
+// lookup returns the reference or the definition at the position of a document.
+// The definition is the target of the reference if it is resolved.
+func (s *Server) lookup(params TextDocumentPositionParams) (*file, *reference, *definition) {
+	f := s.file(params.TextDocument.URI)
+	if f == nil {
+		return nil, nil, nil
+	}
+	r, d := f.at(f.runeOffset(params.Position))
+	if r != nil && r.kind != refInclude {
+		d = f.resolve(r)
+	}
+	return f, r, d
+}
+
+func (s *Server) definition(params TextDocumentPositionParams) []Location {
+	_, r, d := s.lookup(params)
+	if r != nil && r.kind == refInclude {
+		return []Location{{URI: pathToURI(r.target.path)}}
+	}
+	if d == nil {
+		return nil
+	}
+	return []Location{{URI: pathToURI(d.file.path), Range: d.file.rangeOf(d.start, d.end)}}
+}
+
+// references searches the IDLs under the root directory of the workspace and
+// the open documents for the references of the definition at the position.
+func (s *Server) references(params ReferenceParams) []Location {
+	_, _, d := s.lookup(params.TextDocumentPositionParams)
+	if d == nil {
+		return nil
+	}
+	w := s.workspace()
+	if s.root != "" {
+		w.loadDir(s.root)
+	}
+	for path := range s.docs {
+		w.load(path)
+	}
+	paths := make([]string, 0, len(w.files))
+	for path := range w.files {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+
+	locs := []Location{}
+	if params.Context.IncludeDeclaration {
+		locs = append(locs, Location{URI: pathToURI(d.file.path), Range: d.file.rangeOf(d.start, d.end)})
+	}
+	key := d.key()
+	for _, path := range paths {
+		f := w.files[path]
+		if f == nil {
+			continue
+		}
+		for _, r := range f.refs {
+			if r.kind == refInclude {
+				continue
+			}
+			if x := f.resolve(r); x != nil && x.key() == key {
+				locs = append(locs, Location{URI: pathToURI(path), Range: f.rangeOf(r.start, r.end)})
+			}
+		}
+	}
+	return locs
+}
+
+func (s *Server) hover(params TextDocumentPositionParams) *Hover {
+	f, r, d := s.lookup(params)
+	if d == nil {
+		return nil
+	}
+	var sig string
+	switch d.kind {
+	case kindStruct, kindUnion, kindException, kindEnum, kindService:
+		sig = d.kind + " " + d.name
+	default:
+		sig = strings.TrimSpace(d.file.source(d.span))
+		if sig == "" {
+			sig = d.kind + " " + d.name
+		}
+	}
+	value := "```thrift\n" + sig + "\n```"
+	if doc := cleanComments(d.comments); doc != "" {
+		value += "\n\n" + doc
+	}
+	h := &Hover{Contents: MarkupContent{Kind: "markdown", Value: value}}
+	if r != nil {
+		rg := f.rangeOf(r.start, r.end)
+		h.Range = &rg
+	} else {
+		rg := f.rangeOf(d.start, d.end)
+		h.Range = &rg
+	}
+	return h
+}
+
+// cleanComments removes the comment markers of reserved comments.
+func cleanComments(comments string) string {
+	var lines []string
+	for _, line := range strings.Split(comments, "\n") {
+		line = strings.TrimSpace(line)
+		line = strings.TrimSuffix(line, "*/")
+		for _, prefix := range []string{"/**", "/*", "//", "#", "*"} {
+			if strings.HasPrefix(line, prefix) {
+				line = strings.TrimPrefix(line, prefix)
+				break
+			}
+		}
+		lines = append(lines, strings.TrimSpace(line))
+	}
+	return strings.TrimSpace(strings.Join(lines, "\n"))
+}
+
+func (s *Server) completion(params TextDocumentPositionParams) []CompletionItem {
+	f := s.file(params.TextDocument.URI)
+	if f == nil {
+		return nil
+	}
+	end := f.runeOffset(params.Position)
+	start := end
+	for start > 0 && isIdentRune(f.text[start-1]) {
+		start--
+	}
+	edit := func(text string) *TextEdit {
+		return &TextEdit{Range: f.rangeOf(start, end), NewText: text}
+	}
+
+	items := []CompletionItem{}
+	if isAnnotationKey(f.text, start) {
+		keys := map[string]bool{}
+		for _, k := range annotationKeys {
+			keys[k] = true
+		}
+		for _, g := range s.workspace().files {
+			if g != nil {
+				collectAnnotationKeys(g.ast, keys)
+			}
+		}
+		for k := range keys {
+			items = append(items, CompletionItem{Label: k, Kind: CompletionKindProperty, TextEdit: edit(k)})
+		}
+		sort.Slice(items, func(i, j int) bool { return items[i].Label < items[j].Label })
+		return items
+	}
+
+	lineStart := f.lines[f.position(start).Line]
+	services := strings.Contains(string(f.text[lineStart:start]), "extends")
+	if !services {
+		for _, t := range baseTypes {
+			items = append(items, CompletionItem{Label: t, Kind: CompletionKindKeyword, TextEdit: edit(t)})
+		}
+	}
+	add := func(prefix string, g *file) {
+		for _, d := range g.symbols {
+			kind, ok := completionKinds[d.kind]
+			if !ok || (d.kind == kindService) != services {
+				continue
+			}
+			label := prefix + d.name
+			items = append(items, CompletionItem{Label: label, Kind: kind, Detail: d.kind, TextEdit: edit(label)})
+		}
+	}
+	add("", f)
+	prefixes := make([]string, 0, len(f.includes))
+	for prefix := range f.includes {
+		prefixes = append(prefixes, prefix)
+	}
+	sort.Strings(prefixes)
+	for _, prefix := range prefixes {
+		for _, g := range f.includes[prefix] {
+			add(prefix+".", g)
+		}
+	}
+	return items
+}
+
+// isAnnotationKey reports whether the offset is at the key of an annotation,
+// that is, right after the opening parenthesis or a separator of an annotation list.
+func isAnnotationKey(text []rune, at int) bool {
+	i := at - 1
+	for i >= 0 && isSpace(text[i]) {
+		i--
+	}
+	if i < 0 || text[i] != '(' && text[i] != ',' && text[i] != ';' {
+		return false
+	}
+	for ; i >= 0; i-- {
+		switch text[i] {
+		case '"', '\'':
+			q := text[i]
+			for i--; i >= 0 && text[i] != q; i-- {
+			}
+		case '(':
+			// the arguments of a function start right after its name
+			return i == 0 || !isIdentRune(text[i-1])
+		case ')', '{', '}', ':', '<', '>':
+			return false
+		}
+	}
+	return false
+}
+
+func isSpace(r rune) bool {
+	return r == ' ' || r == '\t' || r == '\r' || r == '\n'
+}
+
+func collectAnnotationKeys(ast *parser.Thrift, keys map[string]bool) {
+	add := func(as parser.Annotations) {
+		for _, a := range as {
+			keys[a.Key] = true
+		}
+	}
+	var addType func(t *parser.Type)
+	addType = func(t *parser.Type) {
+		if t != nil {
+			add(t.Annotations)
+			addType(t.KeyType)
+			addType(t.ValueType)
+		}
+	}
+	addFields := func(fs []*parser.Field) {
+		for _, f := range fs {
+			add(f.Annotations)
+			addType(f.Type)
+		}
+	}
+	for _, t := range ast.Typedefs {
+		add(t.Annotations)
+		addType(t.Type)
+	}
+	for _, c := range ast.Constants {
+		add(c.Annotations)
+	}
+	for _, e := range ast.Enums {
+		add(e.Annotations)
+		for _, v := range e.Values {
+			add(v.Annotations)
+		}
+	}
+	for _, s := range ast.GetStructLikes() {
+		add(s.Annotations)
+		addFields(s.Fields)
+	}
+	for _, s := range ast.Services {
+		add(s.Annotations)
+		for _, f := range s.Functions {
+			add(f.Annotations)
+			addFields(f.Arguments)
+			addFields(f.Throws)
+		}
+	}
+}
+
+func (s *Server) documentSymbols(params DocumentSymbolParams) []DocumentSymbol {
+	f := s.file(params.TextDocument.URI)
+	if f == nil {
+		return nil
+	}
+	var convert func(ds []*definition) []DocumentSymbol
+	convert = func(ds []*definition) []DocumentSymbol {
+		syms := []DocumentSymbol{}
+		for _, d := range ds {
+			name := d.name
+			if i := strings.LastIndex(name, "."); i >= 0 && d.kind != kindTypedef && d.kind != kindConst {
+				name = name[i+1:]
+			}
+			sym := DocumentSymbol{
+				Name:           name,
+				Detail:         d.kind,
+				Kind:           symbolKinds[d.kind],
+				Range:          f.spanRange(d.span),
+				SelectionRange: f.rangeOf(d.start, d.end),
+			}
+			if d.span == nil {
+				sym.Range = sym.SelectionRange
+			}
+			if len(d.children) > 0 {
+				sym.Children = convert(d.children)
+			}
+			syms = append(syms, sym)
+		}
+		return syms
+	}
+	return convert(f.symbols)
+}
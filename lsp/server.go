@@ -0,0 +1,263 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package lsp implements a language server of thrift IDL over stdio.
+// It reports syntax and semantic errors, and provides go-to-definition, find
+// references, hover, completion and document symbols.
+package lsp
+
+import (
+	"encoding/json"
+	"flag"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+
+	"github.com/cloudwego/thriftgo/version"
+)
+
+// Server is a language server of thrift IDL.
+type Server struct {
+	conn        *conn
+	includeDirs []string
+	root        string            // the root directory of the workspace
+	docs        map[string]string // content of the open documents by path
+	ws          *workspace        // cached, reset when any document changes
+	shutdown    bool
+}
+
+// NewServer creates a server that reads requests from r and writes responses to w.
+// Included files are searched in includeDirs as well as the directory of the including file.
+func NewServer(r io.Reader, w io.Writer, includeDirs []string) *Server {
+	return &Server{
+		conn:        newConn(r, w),
+		includeDirs: includeDirs,
+		docs:        make(map[string]string),
+	}
+}
+
+// Run parses the arguments of 'thriftgo lsp' and serves on stdio.
+func Run(args []string) error {
+	var includes stringSlice
+	fs := flag.NewFlagSet("thriftgo lsp", flag.ContinueOnError)
+	fs.Var(&includes, "i", "Add a search path for includes.")
+	fs.Var(&includes, "include", "Add a search path for includes.")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	return NewServer(os.Stdin, os.Stdout, includes).Serve()
+}
+
+type stringSlice []string
+
+func (ss *stringSlice) String() string {
+	return strings.Join(*ss, ",")
+}
+
+func (ss *stringSlice) Set(value string) error {
+	*ss = append(*ss, value)
+	return nil
+}
+
+// Serve handles messages until the client sends 'exit' or closes the input.
+func (s *Server) Serve() error {
+	for {
+		msg, err := s.conn.read()
+		if err == io.EOF {
+			return nil
+		}
+		if e, ok := err.(*rpcError); ok {
+			if err = s.conn.reply(nil, nil, e); err != nil {
+				return err
+			}
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		if msg.Method == "exit" {
+			return nil
+		}
+		result, err := s.handle(msg)
+		if msg.ID == nil { // notification
+			continue
+		}
+		if err = s.conn.reply(msg.ID, result, err); err != nil {
+			return err
+		}
+	}
+}
+
+func (s *Server) handle(msg *message) (interface{}, error) {
+	if s.shutdown && msg.Method != "exit" && msg.ID != nil {
+		return nil, &rpcError{Code: codeInvalidRequest, Message: "server is shut down"}
+	}
+	switch msg.Method {
+	case "initialize":
+		var params InitializeParams
+		if err := unmarshal(msg.Params, &params); err != nil {
+			return nil, err
+		}
+		if params.RootURI != "" {
+			s.root = uriToPath(params.RootURI)
+		}
+		return &InitializeResult{
+			Capabilities: ServerCapabilities{
+				TextDocumentSync:       textDocumentSyncFull,
+				DefinitionProvider:     true,
+				ReferencesProvider:     true,
+				HoverProvider:          true,
+				DocumentSymbolProvider: true,
+				CompletionProvider:     &CompletionOptions{TriggerCharacters: []string{".", "("}},
+			},
+			ServerInfo: ServerInfo{Name: "thriftgo", Version: version.ThriftgoVersion},
+		}, nil
+	case "shutdown":
+		s.shutdown = true
+		return nil, nil
+	case "textDocument/didOpen":
+		var params DidOpenTextDocumentParams
+		if err := unmarshal(msg.Params, &params); err != nil {
+			return nil, err
+		}
+		s.docs[uriToPath(params.TextDocument.URI)] = params.TextDocument.Text
+		return nil, s.changed()
+	case "textDocument/didChange":
+		var params DidChangeTextDocumentParams
+		if err := unmarshal(msg.Params, &params); err != nil {
+			return nil, err
+		}
+		if n := len(params.ContentChanges); n > 0 {
+			s.docs[uriToPath(params.TextDocument.URI)] = params.ContentChanges[n-1].Text
+		}
+		return nil, s.changed()
+	case "textDocument/didSave":
+		return nil, s.changed()
+	case "textDocument/didClose":
+		var params DidCloseTextDocumentParams
+		if err := unmarshal(msg.Params, &params); err != nil {
+			return nil, err
+		}
+		delete(s.docs, uriToPath(params.TextDocument.URI))
+		if err := s.conn.notify("textDocument/publishDiagnostics", &PublishDiagnosticsParams{
+			URI: params.TextDocument.URI, Diagnostics: []Diagnostic{},
+		}); err != nil {
+			return nil, err
+		}
+		return nil, s.changed()
+	case "textDocument/definition":
+		var params TextDocumentPositionParams
+		if err := unmarshal(msg.Params, &params); err != nil {
+			return nil, err
+		}
+		return s.definition(params), nil
+	case "textDocument/references":
+		var params ReferenceParams
+		if err := unmarshal(msg.Params, &params); err != nil {
+			return nil, err
+		}
+		return s.references(params), nil
+	case "textDocument/hover":
+		var params TextDocumentPositionParams
+		if err := unmarshal(msg.Params, &params); err != nil {
+			return nil, err
+		}
+		return s.hover(params), nil
+	case "textDocument/completion":
+		var params TextDocumentPositionParams
+		if err := unmarshal(msg.Params, &params); err != nil {
+			return nil, err
+		}
+		return s.completion(params), nil
+	case "textDocument/documentSymbol":
+		var params DocumentSymbolParams
+		if err := unmarshal(msg.Params, &params); err != nil {
+			return nil, err
+		}
+		return s.documentSymbols(params), nil
+	}
+	if msg.ID != nil {
+		return nil, &rpcError{Code: codeMethodNotFound, Message: "method not found: " + msg.Method}
+	}
+	return nil, nil // ignore unknown notifications
+}
+
+func unmarshal(params json.RawMessage, v interface{}) error {
+	if len(params) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(params, v); err != nil {
+		return &rpcError{Code: codeInvalidParams, Message: err.Error()}
+	}
+	return nil
+}
+
+// changed drops the cached workspace and publishes the diagnostics of all open documents,
+// since a change of a document may affect the documents that include it.
+func (s *Server) changed() error {
+	s.ws = nil
+	paths := make([]string, 0, len(s.docs))
+	for path := range s.docs {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	for _, path := range paths {
+		// the semantic checks modify the ASTs, so each document is loaded separately
+		f := newWorkspace(s.includeDirs, s.docs).load(path)
+		if f == nil {
+			continue
+		}
+		params := &PublishDiagnosticsParams{URI: pathToURI(path), Diagnostics: f.diagnostics()}
+		if err := s.conn.notify("textDocument/publishDiagnostics", params); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *Server) workspace() *workspace {
+	if s.ws == nil {
+		s.ws = newWorkspace(s.includeDirs, s.docs)
+	}
+	return s.ws
+}
+
+// file returns the parsed document.
+func (s *Server) file(uri string) *file {
+	return s.workspace().load(uriToPath(uri))
+}
+
+func uriToPath(uri string) string {
+	u, err := url.Parse(uri)
+	if err != nil || u.Scheme != "file" {
+		return uri
+	}
+	path := u.Path
+	if runtime.GOOS == "windows" {
+		path = filepath.FromSlash(strings.TrimPrefix(path, "/"))
+	}
+	return path
+}
+
+func pathToURI(path string) string {
+	path = filepath.ToSlash(path)
+	if !strings.HasPrefix(path, "/") {
+		path = "/" + path
+	}
+	return (&url.URL{Scheme: "file", Path: path}).String()
+}
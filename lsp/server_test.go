@@ -0,0 +1,210 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lsp
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/cloudwego/thriftgo/pkg/test"
+)
+
+const testBase = `namespace go base
+
+// User is a user.
+struct User {
+    1: i64 id
+    2: Color color = Color.RED (api.query = "color")
+}
+
+enum Color {
+    RED = 1
+    BLUE = 2
+}
+`
+
+const testMain = `include "base.thrift"
+
+struct Req {
+    1: base.User user
+    2: base.Color color = base.Color.BLUE
+}
+
+service S {
+    base.User Get(1: Req req) (api.get = "/user")
+}
+`
+
+type session struct {
+	t  *testing.T
+	in bytes.Buffer
+	id int
+}
+
+func (s *session) send(method string, params interface{}, request bool) {
+	msg := map[string]interface{}{"jsonrpc": "2.0", "method": method, "params": params}
+	if request {
+		s.id++
+		msg["id"] = s.id
+	}
+	body, err := json.Marshal(msg)
+	test.Assert(s.t, err == nil, err)
+	fmt.Fprintf(&s.in, "Content-Length: %d\r\n\r\n%s", len(body), body)
+}
+
+// run serves the requests and returns the responses by ID and the notifications.
+func (s *session) run() (map[int]json.RawMessage, []json.RawMessage) {
+	var out bytes.Buffer
+	err := NewServer(&s.in, &out, nil).Serve()
+	test.Assert(s.t, err == nil, err)
+
+	results := make(map[int]json.RawMessage)
+	var notes []json.RawMessage
+	c := newConn(&out, nil)
+	for {
+		var msg struct {
+			ID     *int            `json:"id"`
+			Result json.RawMessage `json:"result"`
+			Params json.RawMessage `json:"params"`
+		}
+		header, err := c.r.ReadString('\n')
+		if err == io.EOF {
+			break
+		}
+		var length int
+		fmt.Sscanf(header, "Content-Length: %d", &length)
+		c.r.ReadString('\n')
+		body := make([]byte, length)
+		io.ReadFull(c.r, body)
+		test.Assert(s.t, json.Unmarshal(body, &msg) == nil, string(body))
+		if msg.ID != nil {
+			results[*msg.ID] = msg.Result
+		} else {
+			notes = append(notes, msg.Params)
+		}
+	}
+	return results, notes
+}
+
+func at(uri string, line, char int) TextDocumentPositionParams {
+	return TextDocumentPositionParams{
+		TextDocument: TextDocumentIdentifier{URI: uri},
+		Position:     Position{Line: line, Character: char},
+	}
+}
+
+func TestServer(t *testing.T) {
+	dir, err := ioutil.TempDir(".", "lsp")
+	test.Assert(t, err == nil, err)
+	defer os.RemoveAll(dir)
+	dir, _ = filepath.Abs(dir)
+	test.Assert(t, ioutil.WriteFile(filepath.Join(dir, "base.thrift"), []byte(testBase), 0o644) == nil)
+	uri := pathToURI(filepath.Join(dir, "main.thrift"))
+	baseURI := pathToURI(filepath.Join(dir, "base.thrift"))
+
+	s := &session{t: t}
+	s.send("initialize", InitializeParams{RootURI: pathToURI(dir)}, true)
+	s.send("initialized", struct{}{}, false)
+	s.send("textDocument/didOpen", DidOpenTextDocumentParams{
+		TextDocument: TextDocumentItem{URI: uri, LanguageID: "thrift", Version: 1, Text: testMain},
+	}, false)
+	s.send("textDocument/definition", at(uri, 3, 13), true) // 2: base.User
+	s.send("textDocument/hover", at(uri, 3, 13), true)      // 3: base.User
+	s.send("textDocument/references", ReferenceParams{      // 4: User
+		TextDocumentPositionParams: at(baseURI, 3, 8),
+	}, true)
+	s.send("textDocument/definition", at(uri, 4, 35), true)     // 5: base.Color.BLUE
+	s.send("textDocument/completion", at(uri, 3, 7), true)      // 6: types
+	s.send("textDocument/completion", at(uri, 8, 31), true)     // 7: annotation keys
+	s.send("textDocument/documentSymbol", DocumentSymbolParams{ // 8
+		TextDocument: TextDocumentIdentifier{URI: baseURI},
+	}, true)
+	s.send("textDocument/didChange", DidChangeTextDocumentParams{
+		TextDocument:   VersionedTextDocumentIdentifier{URI: uri, Version: 2},
+		ContentChanges: []TextDocumentContentChangeEvent{{Text: "struct A {\n  1: Missing m\n}\nstruct {\n"}},
+	}, false)
+	s.send("shutdown", nil, true)
+	s.send("exit", nil, false)
+	results, notes := s.run()
+
+	var locs []Location
+	test.Assert(t, json.Unmarshal(results[2], &locs) == nil)
+	test.Assert(t, len(locs) == 1 && locs[0].URI == baseURI, locs)
+	test.Assert(t, locs[0].Range == Range{Start: Position{3, 7}, End: Position{3, 11}}, locs[0].Range)
+
+	var h Hover
+	test.Assert(t, json.Unmarshal(results[3], &h) == nil)
+	test.Assert(t, h.Contents.Value == "```thrift\nstruct User\n```\n\nUser is a user.", h.Contents.Value)
+
+	locs = nil
+	test.Assert(t, json.Unmarshal(results[4], &locs) == nil)
+	test.Assert(t, len(locs) == 2, locs)
+	test.Assert(t, locs[0].URI == uri && locs[0].Range.Start == Position{3, 7}, locs[0])
+	test.Assert(t, locs[1].URI == uri && locs[1].Range.Start == Position{8, 4}, locs[1])
+
+	locs = nil
+	test.Assert(t, json.Unmarshal(results[5], &locs) == nil)
+	test.Assert(t, len(locs) == 1 && locs[0].URI == baseURI && locs[0].Range.Start == Position{10, 4}, locs)
+
+	var items []CompletionItem
+	test.Assert(t, json.Unmarshal(results[6], &items) == nil)
+	labels := map[string]bool{}
+	for _, it := range items {
+		labels[it.Label] = true
+	}
+	test.Assert(t, labels["i64"] && labels["Req"] && labels["base.User"] && labels["base.Color"], labels)
+	test.Assert(t, !labels["S"], labels)
+
+	items = nil
+	test.Assert(t, json.Unmarshal(results[7], &items) == nil)
+	labels = map[string]bool{}
+	for _, it := range items {
+		labels[it.Label] = true
+	}
+	test.Assert(t, labels["api.get"] && labels["go.tag"] && !labels["i64"], labels)
+
+	var syms []DocumentSymbol
+	test.Assert(t, json.Unmarshal(results[8], &syms) == nil)
+	test.Assert(t, len(syms) == 2 && syms[0].Name == "User" && syms[1].Name == "Color", syms)
+	test.Assert(t, len(syms[0].Children) == 2 && syms[0].Children[1].Name == "color", syms[0].Children)
+	test.Assert(t, len(syms[1].Children) == 2 && syms[1].Children[0].Name == "RED", syms[1].Children)
+
+	var diags []PublishDiagnosticsParams
+	for _, n := range notes {
+		var p PublishDiagnosticsParams
+		test.Assert(t, json.Unmarshal(n, &p) == nil)
+		diags = append(diags, p)
+	}
+	test.Assert(t, len(diags) == 2, diags)
+	test.Assert(t, len(diags[0].Diagnostics) == 0, diags[0])
+	test.Assert(t, len(diags[1].Diagnostics) == 1, diags[1])
+	d := diags[1].Diagnostics[0]
+	test.Assert(t, d.Range.Start == Position{3, 7} && strings.HasPrefix(d.Message, `unexpected "{"`), d)
+}
+
+func TestSemanticDiagnostics(t *testing.T) {
+	w := newWorkspace(nil, map[string]string{"/a.thrift": "struct A {\n  1: Missing m\n}\n"})
+	ds := w.load("/a.thrift").diagnostics()
+	test.Assert(t, len(ds) == 1, ds)
+	test.Assert(t, ds[0].Range.Start == Position{1, 2}, ds[0])
+	test.Assert(t, ds[0].Message == `resolve field "m" of "A": undefined type: "Missing"`, ds[0].Message)
+}
@@ -0,0 +1,579 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package lsp
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode/utf16"
+
+	"github.com/cloudwego/thriftgo/parser"
+	"github.com/cloudwego/thriftgo/semantic"
+)
+
+// Kinds of definitions.
+const (
+	kindStruct    = "struct"
+	kindUnion     = "union"
+	kindException = "exception"
+	kindEnum      = "enum"
+	kindEnumValue = "enum value"
+	kindTypedef   = "typedef"
+	kindConst     = "const"
+	kindService   = "service"
+	kindField     = "field"
+	kindFunction  = "function"
+)
+
+// definition is a named node of an IDL.
+type definition struct {
+	file     *file
+	name     string // qualified in the file, e.g. "Color.RED" for enum values
+	kind     string
+	start    int // rune offsets of the name
+	end      int
+	span     *parser.Span
+	comments string
+	children []*definition
+}
+
+// key identifies the definition across files.
+func (d *definition) key() string {
+	return d.file.path + "#" + d.kind + "#" + d.name
+}
+
+// Kinds of references.
+const (
+	refType = iota
+	refValue
+	refService
+	refInclude
+)
+
+// reference is a use of a name in an IDL.
+type reference struct {
+	kind   int
+	name   string // as written, e.g. "base.User"
+	start  int    // rune offsets
+	end    int
+	target *file // the included file of include references
+}
+
+// file is a parsed IDL file.
+type file struct {
+	path     string
+	text     []rune
+	lines    []int // rune offsets of the first rune of each line
+	ast      *parser.Thrift
+	syntax   parser.Diagnostics
+	err      error // non-syntax errors of the parser
+	defs     map[string]*definition
+	symbols  []*definition // top-level definitions in the order of the source
+	refs     []*reference
+	includes map[string][]*file // included files by their IDL prefix
+	included map[*parser.Include]*file
+	missing  []*parser.Include
+}
+
+// workspace loads IDL files from the open documents or the disk.
+type workspace struct {
+	includeDirs []string
+	overlay     map[string]string // content of the open documents by path
+	files       map[string]*file
+}
+
+func newWorkspace(includeDirs []string, overlay map[string]string) *workspace {
+	return &workspace{
+		includeDirs: includeDirs,
+		overlay:     overlay,
+		files:       make(map[string]*file),
+	}
+}
+
+// search finds an included file like the parser does.
+func (w *workspace) search(name, dir string) (string, bool) {
+	ps := []string{filepath.Join(dir, name), name}
+	for _, inc := range w.includeDirs {
+		ps = append(ps, filepath.Join(inc, name))
+	}
+	for _, p := range ps {
+		p, err := filepath.Abs(p)
+		if err != nil {
+			continue
+		}
+		if _, ok := w.overlay[p]; ok {
+			return p, true
+		}
+		if fi, err := os.Stat(p); err == nil && !fi.IsDir() {
+			return p, true
+		}
+	}
+	return "", false
+}
+
+// load parses the file and the files it includes recursively.
+// It returns nil if the file can not be read.
+func (w *workspace) load(path string) *file {
+	if f, ok := w.files[path]; ok {
+		return f
+	}
+	content, ok := w.overlay[path]
+	if !ok {
+		bs, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil
+		}
+		content = string(bs)
+	}
+	f := &file{
+		path:     path,
+		text:     []rune(content),
+		defs:     make(map[string]*definition),
+		includes: make(map[string][]*file),
+		included: make(map[*parser.Include]*file),
+	}
+	f.lines = []int{0}
+	for i, r := range f.text {
+		if r == '\n' {
+			f.lines = append(f.lines, i+1)
+		}
+	}
+	w.files[path] = f
+
+	ast, err := parser.ParseStringWithRecovery(path, content)
+	if ds, ok := err.(parser.Diagnostics); ok {
+		f.syntax = ds
+	} else if err != nil {
+		f.err = err
+		ast = &parser.Thrift{Filename: path}
+	}
+	f.ast = ast
+	for _, inc := range ast.Includes {
+		p, ok := w.search(inc.Path, filepath.Dir(path))
+		var g *file
+		if ok {
+			g = w.load(p)
+		}
+		if g == nil {
+			f.missing = append(f.missing, inc)
+			continue
+		}
+		inc.Reference = g.ast
+		f.included[inc] = g
+		prefix := semantic.IDLPrefix(inc.Path)
+		f.includes[prefix] = append(f.includes[prefix], g)
+	}
+	f.index()
+	return f
+}
+
+// loadDir loads all IDL files under the directory.
+func (w *workspace) loadDir(dir string) {
+	filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return nil
+		}
+		if info.IsDir() {
+			if path != dir && strings.HasPrefix(info.Name(), ".") {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if filepath.Ext(path) == ".thrift" {
+			w.load(path)
+		}
+		return nil
+	})
+}
+
+// valid reports whether the file and the files it includes are parsed without errors.
+func (f *file) valid(seen map[*file]bool) bool {
+	if seen[f] {
+		return true
+	}
+	seen[f] = true
+	if len(f.syntax) > 0 || f.err != nil || len(f.missing) > 0 {
+		return false
+	}
+	for _, fs := range f.includes {
+		for _, g := range fs {
+			if !g.valid(seen) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// position converts a rune offset into a LSP position.
+func (f *file) position(offset int) Position {
+	if offset > len(f.text) {
+		offset = len(f.text)
+	}
+	line := 0
+	for line+1 < len(f.lines) && f.lines[line+1] <= offset {
+		line++
+	}
+	char := len(utf16.Encode(f.text[f.lines[line]:offset]))
+	return Position{Line: line, Character: char}
+}
+
+// runeOffset converts a LSP position into a rune offset.
+func (f *file) runeOffset(pos Position) int {
+	if pos.Line >= len(f.lines) {
+		return len(f.text)
+	}
+	i, units := f.lines[pos.Line], 0
+	for i < len(f.text) && f.text[i] != '\n' && units < pos.Character {
+		units += len(utf16.Encode([]rune{f.text[i]}))
+		i++
+	}
+	return i
+}
+
+func (f *file) rangeOf(start, end int) Range {
+	return Range{Start: f.position(start), End: f.position(end)}
+}
+
+func (f *file) spanRange(s *parser.Span) Range {
+	if s == nil {
+		return Range{}
+	}
+	return f.rangeOf(s.Start.Offset, s.End.Offset)
+}
+
+// source returns the text of the span.
+func (f *file) source(s *parser.Span) string {
+	if s == nil || s.End.Offset > len(f.text) {
+		return ""
+	}
+	return string(f.text[s.Start.Offset:s.End.Offset])
+}
+
+// wordEnd returns the end of the identifier starting at the offset.
+func (f *file) wordEnd(offset int) int {
+	end := offset
+	for end < len(f.text) && isIdentRune(f.text[end]) {
+		end++
+	}
+	if end == offset && end < len(f.text) {
+		end++
+	}
+	return end
+}
+
+// findName returns the offset of the identifier from the offset, or -1 if not found.
+func (f *file) findName(from int, name string) int {
+	word := []rune(name)
+	for i := from; i+len(word) <= len(f.text); i++ {
+		if i > 0 && isIdentRune(f.text[i-1]) {
+			continue
+		}
+		if string(f.text[i:i+len(word)]) != name {
+			continue
+		}
+		if i+len(word) < len(f.text) && isIdentRune(f.text[i+len(word)]) {
+			continue
+		}
+		return i
+	}
+	return -1
+}
+
+func isIdentRune(r rune) bool {
+	return r == '_' || r == '.' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9'
+}
+
+// define records a definition whose name is searched from the offset.
+func (f *file) define(name, local, kind string, from int, span *parser.Span, comments string) *definition {
+	d := &definition{file: f, name: name, kind: kind, span: span, comments: comments}
+	if span != nil && from < span.Start.Offset {
+		from = span.Start.Offset
+	}
+	d.start = f.findName(from, local)
+	if d.start < 0 {
+		d.start = from
+		d.end = from
+	} else {
+		d.end = d.start + len([]rune(local))
+	}
+	return d
+}
+
+// index collects the definitions and references of the file.
+func (f *file) index() {
+	ast := f.ast
+	top := func(d *definition) {
+		f.defs[d.name] = d
+		f.symbols = append(f.symbols, d)
+	}
+	for _, inc := range ast.Includes {
+		if g, span := f.included[inc], f.span(inc); g != nil && span != nil {
+			start := span.Start.Offset + len("include")
+			f.refs = append(f.refs, &reference{kind: refInclude, name: inc.Path, start: start, end: span.End.Offset, target: g})
+		}
+	}
+	for _, t := range ast.Typedefs {
+		top(f.define(t.Alias, t.Alias, kindTypedef, f.typeEnd(t.Type), f.span(t), t.ReservedComments))
+		f.addType(t.Type)
+	}
+	for _, c := range ast.Constants {
+		top(f.define(c.Name, c.Name, kindConst, f.typeEnd(c.Type), f.span(c), c.ReservedComments))
+		f.addType(c.Type)
+		f.addValue(c.Value)
+	}
+	for _, e := range ast.Enums {
+		d := f.define(e.Name, e.Name, kindEnum, spanStart(f.span(e))+len(kindEnum), f.span(e), e.ReservedComments)
+		for _, v := range e.Values {
+			vd := f.define(e.Name+"."+v.Name, v.Name, kindEnumValue, spanStart(f.span(v)), f.span(v), v.ReservedComments)
+			f.defs[vd.name] = vd
+			d.children = append(d.children, vd)
+		}
+		top(d)
+	}
+	structs := [][]*parser.StructLike{ast.Structs, ast.Unions, ast.Exceptions}
+	for i, kind := range []string{kindStruct, kindUnion, kindException} {
+		for _, s := range structs[i] {
+			d := f.define(s.Name, s.Name, kind, spanStart(f.span(s))+len(kind), f.span(s), s.ReservedComments)
+			d.children = f.addFields(s.Name, s.Fields)
+			top(d)
+		}
+	}
+	for _, s := range ast.Services {
+		d := f.define(s.Name, s.Name, kindService, spanStart(f.span(s))+len(kindService), f.span(s), s.ReservedComments)
+		if s.Extends != "" && f.span(s) != nil {
+			if at := f.findName(d.end, "extends"); at >= 0 {
+				if at = f.findName(at+len("extends"), s.Extends); at >= 0 {
+					f.refs = append(f.refs, &reference{kind: refService, name: s.Extends, start: at, end: at + len([]rune(s.Extends))})
+				}
+			}
+		}
+		for _, fn := range s.Functions {
+			from := spanStart(f.span(fn))
+			if !fn.Void {
+				from = f.typeEnd(fn.FunctionType)
+				f.addType(fn.FunctionType)
+			}
+			fd := f.define(s.Name+"."+fn.Name, fn.Name, kindFunction, from, f.span(fn), fn.ReservedComments)
+			f.addFields(fd.name, fn.Arguments)
+			f.addFields(fd.name, fn.Throws)
+			d.children = append(d.children, fd)
+		}
+		top(d)
+	}
+	sort.Slice(f.symbols, func(i, j int) bool { return f.symbols[i].start < f.symbols[j].start })
+}
+
+func (f *file) addFields(owner string, fields []*parser.Field) (ds []*definition) {
+	for _, fd := range fields {
+		ds = append(ds, f.define(owner+"."+fd.Name, fd.Name, kindField, f.typeEnd(fd.Type), f.span(fd), fd.ReservedComments))
+		f.addType(fd.Type)
+		f.addValue(fd.Default)
+	}
+	return
+}
+
+func (f *file) addType(t *parser.Type) {
+	span := f.span(t)
+	if t == nil || span == nil {
+		return
+	}
+	switch t.Name {
+	case "bool", "byte", "i8", "i16", "i32", "i64", "double", "string", "binary", "void":
+	case "map", "list", "set":
+		f.addType(t.KeyType)
+		f.addType(t.ValueType)
+	default:
+		start := span.Start.Offset
+		f.refs = append(f.refs, &reference{kind: refType, name: t.Name, start: start, end: start + len([]rune(t.Name))})
+	}
+}
+
+func (f *file) addValue(v *parser.ConstValue) {
+	if v == nil {
+		return
+	}
+	switch v.Type {
+	case parser.ConstType_ConstIdentifier:
+		id := v.TypedValue.GetIdentifier()
+		span := f.span(v)
+		if id == "true" || id == "false" || span == nil {
+			return
+		}
+		start := span.Start.Offset
+		f.refs = append(f.refs, &reference{kind: refValue, name: id, start: start, end: start + len([]rune(id))})
+	case parser.ConstType_ConstList:
+		for _, x := range v.TypedValue.List {
+			f.addValue(x)
+		}
+	case parser.ConstType_ConstMap:
+		for _, m := range v.TypedValue.Map {
+			f.addValue(m.Key)
+			f.addValue(m.Value)
+		}
+	}
+}
+
+func spanStart(s *parser.Span) int {
+	if s == nil {
+		return 0
+	}
+	return s.Start.Offset
+}
+
+func (f *file) typeEnd(t *parser.Type) int {
+	span := f.span(t)
+	if t == nil || span == nil {
+		return 0
+	}
+	return span.End.Offset
+}
+
+// span returns the span of a node of the AST of the file.
+func (f *file) span(node interface{}) *parser.Span {
+	return parser.SpanOf(f.ast, node)
+}
+
+// resolve finds the definition that the reference refers to.
+func (f *file) resolve(r *reference) *definition {
+	switch r.kind {
+	case refType, refService:
+		switch ss := semantic.SplitType(r.name); len(ss) {
+		case 1:
+			return f.defs[ss[0]]
+		case 2:
+			for _, g := range f.includes[ss[0]] {
+				if d := g.defs[ss[1]]; d != nil {
+					return d
+				}
+			}
+		}
+	case refValue:
+		for _, ss := range semantic.SplitValue(r.name) {
+			switch len(ss) {
+			case 1: // constant
+				if d := f.defs[ss[0]]; d != nil && d.kind == kindConst {
+					return d
+				}
+			case 2: // enum.value or include.constant
+				if d := f.defs[ss[0]+"."+ss[1]]; d != nil && d.kind == kindEnumValue {
+					return d
+				}
+				for _, g := range f.includes[ss[0]] {
+					if d := g.defs[ss[1]]; d != nil && d.kind == kindConst {
+						return d
+					}
+				}
+			case 3: // include.enum.value
+				for _, g := range f.includes[ss[0]] {
+					if d := g.defs[ss[1]+"."+ss[2]]; d != nil && d.kind == kindEnumValue {
+						return d
+					}
+				}
+			}
+		}
+	}
+	return nil
+}
+
+// at returns the reference or the definition at the offset.
+func (f *file) at(offset int) (*reference, *definition) {
+	for _, r := range f.refs {
+		if r.start <= offset && offset <= r.end {
+			return r, nil
+		}
+	}
+	var walk func(ds []*definition) *definition
+	walk = func(ds []*definition) *definition {
+		for _, d := range ds {
+			if d.start <= offset && offset <= d.end && d.start < d.end {
+				return d
+			}
+			if x := walk(d.children); x != nil {
+				return x
+			}
+		}
+		return nil
+	}
+	return nil, walk(f.symbols)
+}
+
+// messagePattern matches messages prefixed with 'file:line:column: '.
+var messagePattern = regexp.MustCompile(`^(.+?):(\d+):(\d+): (.*)$`)
+
+// diagnostics returns the errors and warnings of the file. The semantic checks
+// run only when the file and all files it includes are free of syntax errors.
+func (f *file) diagnostics() []Diagnostic {
+	ds := []Diagnostic{}
+	add := func(severity int, start, end int, msg string) {
+		ds = append(ds, Diagnostic{
+			Range:    f.rangeOf(start, end),
+			Severity: severity,
+			Source:   "thriftgo",
+			Message:  msg,
+		})
+	}
+	for _, d := range f.syntax {
+		msg := strings.TrimPrefix(d.Error(), d.Pos.String()+": ")
+		add(SeverityError, d.Pos.Offset, f.wordEnd(d.Pos.Offset), msg)
+	}
+	if f.err != nil {
+		add(SeverityError, 0, 0, f.err.Error())
+	}
+	for _, inc := range f.missing {
+		add(SeverityError, spanStart(f.span(inc)), spanStart(f.span(inc))+len("include"), fmt.Sprintf("cannot find included file %q", inc.Path))
+	}
+	for _, inc := range f.ast.Includes {
+		if g := f.included[inc]; g != nil && len(g.syntax) > 0 {
+			add(SeverityError, spanStart(f.span(inc)), spanStart(f.span(inc))+len("include"),
+				fmt.Sprintf("included file has syntax errors: %s", g.syntax[0].Error()))
+		}
+	}
+	if !f.valid(make(map[*file]bool)) {
+		return ds
+	}
+	report := func(severity int, msg string) {
+		if m := messagePattern.FindStringSubmatch(msg); m != nil && m[1] == f.path {
+			line, _ := strconv.Atoi(m[2])
+			col, _ := strconv.Atoi(m[3])
+			if line-1 < len(f.lines) {
+				offset := f.lines[line-1] + col - 1
+				add(severity, offset, f.wordEnd(offset), m[4])
+				return
+			}
+		}
+		add(severity, 0, 0, msg)
+	}
+	warns, err := semantic.NewChecker(semantic.Options{}).CheckAll(f.ast)
+	for _, w := range warns {
+		if m := messagePattern.FindStringSubmatch(w); m == nil || m[1] == f.path {
+			report(SeverityWarning, w)
+		}
+	}
+	if err != nil {
+		report(SeverityError, err.Error())
+		return ds
+	}
+	if err = semantic.ResolveSymbols(f.ast); err != nil {
+		report(SeverityError, err.Error())
+	}
+	return ds
+}
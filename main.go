@@ -23,6 +23,8 @@ import (
 	"runtime/pprof"
 	"time"
 
+	"github.com/cloudwego/thriftgo/format"
+	"github.com/cloudwego/thriftgo/lsp"
 	"github.com/cloudwego/thriftgo/sdk"
 )
 
@@ -62,6 +64,24 @@ func main() {
 
 	defer handlePanic()
 
+	if len(os.Args) > 1 && os.Args[1] == "lsp" {
+		if err := lsp.Run(os.Args[2:]); err != nil {
+			println(err.Error())
+			os.Exit(2)
+		}
+		return
+	}
+
+	if len(os.Args) > 1 && os.Args[1] == "fmt" {
+		if err := format.Run(os.Args[2:]); err != nil {
+			if !errors.Is(err, flag.ErrHelp) && !errors.Is(err, format.ErrReported) {
+				println(err.Error())
+			}
+			os.Exit(2)
+		}
+		return
+	}
+
 	if err := sdk.InvokeThriftgo(nil, os.Args...); err != nil {
 		if !errors.Is(err, flag.ErrHelp) {
 			println(err.Error())
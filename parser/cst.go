@@ -0,0 +1,70 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser
+
+// CSTNode is a node of the concrete syntax tree. Rule is the name of the
+// grammar rule in 'thrift.peg' that matches the node, and Begin and End are
+// the rune offsets of the matched text.
+type CSTNode struct {
+	Rule     string
+	Begin    int
+	End      int
+	Children []*CSTNode
+}
+
+// CST is the concrete syntax tree of an IDL. Unlike the AST, it is lossless:
+// spaces and comments are kept as nodes of the Skip, SkipLine and Comment rules,
+// so the text of the root is exactly the source.
+type CST struct {
+	Filename string
+	Source   []rune
+	Root     *CSTNode
+}
+
+// Text returns the source text of the node.
+func (c *CST) Text(n *CSTNode) string {
+	return string(c.Source[n.Begin:n.End])
+}
+
+// ParseCST parses the content into a concrete syntax tree.
+// Syntax errors are reported as Diagnostics.
+func ParseCST(path, content string) (*CST, error) {
+	p := &ThriftIDL{Buffer: content}
+	p.Init()
+	if err := p.Parse(); err != nil {
+		if _, derr := ParseStringWithRecovery(path, content); derr != nil {
+			return nil, derr
+		}
+		return nil, err
+	}
+	root := p.AST()
+	cst := &CST{Filename: path, Source: p.buffer[:len(p.buffer)-1]}
+	var convert func(n *node32) *CSTNode
+	convert = func(n *node32) *CSTNode {
+		cn := &CSTNode{Rule: rul3s[n.pegRule], Begin: int(n.begin), End: int(n.end)}
+		for c := n.up; c != nil; c = c.next {
+			cn.Children = append(cn.Children, convert(c))
+		}
+		return cn
+	}
+	if root == nil { // empty content
+		cst.Root = &CSTNode{Rule: rul3s[ruleDocument]}
+	} else {
+		cst.Root = convert(root)
+	}
+	// the document may end with spaces that are matched by no rule
+	cst.Root.Begin, cst.Root.End = 0, len(cst.Source)
+	return cst, nil
+}
@@ -35,6 +35,9 @@ type parser struct {
 	IncludeDirs               []string
 	Annotations               *Annotations
 	DefinitionReservedComment string
+	lines                     *lineIndex
+	spans                     map[interface{}]*Span
+	source                    []rune // the whole content when the buffer is a part of it
 }
 
 func exists(path string) bool {
@@ -172,6 +175,7 @@ func parseString(path, content string, includeDirs []string) (*Thrift, error) {
 	if err := p.parse(); err != nil {
 		return nil, err
 	}
+	registerSpans(&p.Thrift, p.spans)
 	return &p.Thrift, nil
 }
 
@@ -261,6 +265,7 @@ func (p *parser) parseHeader(node *node32) (err error) {
 }
 
 func (p *parser) parseInclude(node *node32) (err error) {
+	pos := p.span(node, node)
 	node, err = checkrule(node, ruleInclude)
 	if err != nil {
 		return err
@@ -274,7 +279,9 @@ func (p *parser) parseInclude(node *node32) (err error) {
 			return
 		}
 	}
-	p.Includes = append(p.Includes, &Include{Path: filename})
+	inc := &Include{Path: filename}
+	p.record(inc, pos)
+	p.Includes = append(p.Includes, inc)
 	return nil
 }
 
@@ -288,7 +295,8 @@ func (p *parser) parseCppInclude(node *node32) (err error) {
 }
 
 func (p *parser) parseNamespace(node *node32) (err error) {
-	ns := Namespace{}
+	var ns Namespace
+	p.record(&ns, p.span(node, node))
 	node, err = checkrule(node, ruleNamespace)
 	if err != nil {
 		return err
@@ -395,6 +403,7 @@ func (p *parser) parseDefinition(node *node32) (err error) {
 }
 
 func (p *parser) parseConst(node *node32) (err error) {
+	pos := p.span(node, node)
 	node, err = checkrule(node, ruleConst)
 	if err != nil {
 		return err
@@ -413,6 +422,7 @@ func (p *parser) parseConst(node *node32) (err error) {
 		return err
 	}
 	c := &Constant{Name: name, Type: ft, Value: value}
+	p.record(c, pos)
 	c.ReservedComments = p.DefinitionReservedComment
 	p.Constants = append(p.Constants, c)
 	p.Annotations = &c.Annotations
@@ -420,10 +430,16 @@ func (p *parser) parseConst(node *node32) (err error) {
 }
 
 func (p *parser) parseFieldType(node *node32) (typ *Type, err error) {
+	pos := p.span(node, node)
 	node, err = checkrule(node, ruleFieldType)
 	if err != nil {
 		return nil, err
 	}
+	defer func() {
+		if typ != nil {
+			p.record(typ, pos)
+		}
+	}()
 	// ContainerType / BaseType / Identifier
 	switch node.pegRule {
 	case ruleContainerType:
@@ -502,10 +518,16 @@ func (p *parser) parseContainerType(node *node32) (typ *Type, err error) {
 }
 
 func (p *parser) parseConstValue(node *node32) (cv *ConstValue, err error) {
+	pos := p.span(node, node)
 	node, err = checkrule(node, ruleConstValue)
 	if err != nil {
 		return nil, err
 	}
+	defer func() {
+		if cv != nil {
+			p.record(cv, pos)
+		}
+	}()
 	// DoubleConstant / IntConstant / Literal / Identifier / ConstList / ConstMap
 	switch node.pegRule {
 	case ruleDoubleConstant:
@@ -562,6 +584,7 @@ func (p *parser) parseConstValue(node *node32) (cv *ConstValue, err error) {
 }
 
 func (p *parser) parseTypedef(node *node32) (err error) {
+	pos := p.span(node, node)
 	node, err = checkrule(node, ruleTypedef)
 	if err != nil {
 		return err
@@ -574,6 +597,7 @@ func (p *parser) parseTypedef(node *node32) (err error) {
 	}
 	var typd Typedef
 	typd.Type = ft
+	p.record(&typd, pos)
 	node = node.next
 	typd.Alias = p.pegText(node)
 	typd.ReservedComments = p.DefinitionReservedComment
@@ -583,6 +607,7 @@ func (p *parser) parseTypedef(node *node32) (err error) {
 }
 
 func (p *parser) parseEnum(node *node32) (err error) {
+	pos := p.span(node, node)
 	node, err = checkrule(node, ruleEnum)
 	if err != nil {
 		return err
@@ -601,6 +626,7 @@ func (p *parser) parseEnum(node *node32) (err error) {
 			n = n.next
 		}
 		if n.pegRule == ruleIdentifier {
+			first := n
 			var v EnumValue
 			v.ReservedComments = valueComments
 			v.Name = p.pegText(n)
@@ -622,6 +648,7 @@ func (p *parser) parseEnum(node *node32) (err error) {
 					return err
 				}
 			}
+			p.record(&v, p.span(first, n))
 			if n.next.pegRule == ruleListSeparator {
 				n = n.next
 			}
@@ -636,6 +663,7 @@ func (p *parser) parseEnum(node *node32) (err error) {
 		}
 	}
 	e := &Enum{Name: name, Values: values}
+	p.record(e, pos)
 	e.ReservedComments = p.DefinitionReservedComment
 	p.Enums = append(p.Enums, e)
 	p.Annotations = &e.Annotations
@@ -643,6 +671,7 @@ func (p *parser) parseEnum(node *node32) (err error) {
 }
 
 func (p *parser) parseUnion(node *node32) (err error) {
+	pos := p.span(node, node)
 	node, err = checkrule(node, ruleUnion)
 	if err != nil {
 		return err
@@ -670,6 +699,7 @@ func (p *parser) parseUnion(node *node32) (err error) {
 		}
 	}
 	u := &StructLike{Category: "union", Name: name, Fields: fields}
+	p.record(u, pos)
 	u.ReservedComments = p.DefinitionReservedComment
 	p.Unions = append(p.Unions, u)
 	p.Annotations = &u.Annotations
@@ -677,6 +707,7 @@ func (p *parser) parseUnion(node *node32) (err error) {
 }
 
 func (p *parser) parseStruct(node *node32) (err error) {
+	pos := p.span(node, node)
 	node, err = checkrule(node, ruleStruct)
 	if err != nil {
 		return err
@@ -704,6 +735,7 @@ func (p *parser) parseStruct(node *node32) (err error) {
 		}
 	}
 	s := &StructLike{Category: "struct", Name: name, Fields: fields}
+	p.record(s, pos)
 	s.ReservedComments = p.DefinitionReservedComment
 
 	p.Structs = append(p.Structs, s)
@@ -713,6 +745,7 @@ func (p *parser) parseStruct(node *node32) (err error) {
 }
 
 func (p *parser) parseException(node *node32) (err error) {
+	pos := p.span(node, node)
 	node, err = checkrule(node, ruleException)
 	if err != nil {
 		return err
@@ -738,6 +771,7 @@ func (p *parser) parseException(node *node32) (err error) {
 		}
 	}
 	e := &StructLike{Category: "exception", Name: name, Fields: fields}
+	p.record(e, pos)
 	e.ReservedComments = p.DefinitionReservedComment
 	p.Exceptions = append(p.Exceptions, e)
 	p.Annotations = &e.Annotations
@@ -746,6 +780,7 @@ func (p *parser) parseException(node *node32) (err error) {
 }
 
 func (p *parser) parseField(node *node32) (field *Field, err error) {
+	pos := p.span(node, node)
 	node, err = checkrule(node, ruleField)
 	if err != nil {
 		return nil, err
@@ -753,6 +788,7 @@ func (p *parser) parseField(node *node32) (field *Field, err error) {
 	// ReservedComments Skip FieldId? FieldReq? FieldType Identifier (EQUAL ConstValue)? Annotations? ListSeparator? ReservedEndLineComments
 	var f Field
 	f.ID = NOTSET
+	p.record(&f, pos)
 	for ; node != nil; node = node.next {
 		switch node.pegRule {
 		case ruleSkip, ruleSkipLine:
@@ -822,6 +858,9 @@ func (p *parser) parseAnnotations(node *node32) ([]*Annotation, error) {
 				return nil, err
 			}
 			ret.Append(k, v)
+			if last := ret[len(ret)-1]; last.Key == k && p.spans[last] == nil {
+				p.record(last, p.span(node, node))
+			}
 		}
 	}
 	return ret, nil
@@ -841,12 +880,14 @@ func (p *parser) parseAnnotation(node *node32) (k, v string, err error) {
 }
 
 func (p *parser) parseService(node *node32) (err error) {
+	pos := p.span(node, node)
 	node, err = checkrule(node, ruleService)
 	if err != nil {
 		return err
 	}
 	// SERVICE Identifier ( EXTENDS Identifier )? LWING Function* RWING
 	var s Service
+	p.record(&s, pos)
 	node = node.next // ignore SERVICE
 	s.Name = p.pegText(node)
 	node = node.next
@@ -870,12 +911,14 @@ func (p *parser) parseService(node *node32) (err error) {
 }
 
 func (p *parser) parseFunction(node *node32) (fu *Function, err error) {
+	pos := p.span(node, node)
 	node, err = checkrule(node, ruleFunction)
 	if err != nil {
 		return nil, err
 	}
 	// ReservedComments ONEWAY? FunctionType Identifier LPAR Field* RPAR Throws? Annotations? ListSeparator?
 	var f Function
+	p.record(&f, pos)
 	for ; node != nil; node = node.next {
 		switch node.pegRule {
 		case ruleReservedComments:
@@ -896,6 +939,7 @@ func (p *parser) parseFunction(node *node32) (fu *Function, err error) {
 			} else if n.pegRule == ruleVOID {
 				f.Void = true
 				f.FunctionType = &Type{Name: "void"}
+				p.record(f.FunctionType, p.span(n, n))
 			}
 		case ruleIdentifier:
 			f.Name = p.pegText(node)
@@ -442,3 +442,123 @@ func TestNamespace(t *testing.T) {
 	test.Assert(t, ast.Namespaces[2].Language == "py")
 	test.Assert(t, ast.Namespaces[2].Name == "python.org")
 }
+
+const testPosition = `namespace go pos
+
+// a comment
+struct User {
+    1: required i64 id = 1, // end line
+    /* inline */ 2: string name (api.query = "name")
+} (k = "v")
+
+enum Color {
+	RED = 1,
+	BLUE
+}
+
+service UserService {
+	void Ping()
+	User Get(1: i64 id) throws (1: User e)
+}
+`
+
+func TestPosition(t *testing.T) {
+	ast, err := parser.ParseString("pos.thrift", testPosition)
+	test.Assert(t, err == nil, err)
+
+	at := func(node interface{}, start, end string) {
+		t.Helper()
+		s := parser.SpanOf(ast, node)
+		test.Assert(t, s != nil)
+		test.Assert(t, s.Start.String() == start, s.Start.String())
+		test.Assert(t, s.End.String() == end, s.End.String())
+	}
+	at(ast.Namespaces[0], "pos.thrift:1:1", "pos.thrift:1:17")
+	user := ast.Structs[0]
+	at(user, "pos.thrift:4:1", "pos.thrift:7:2")
+	at(user.Fields[0], "pos.thrift:5:5", "pos.thrift:5:27")
+	at(user.Fields[0].Type, "pos.thrift:5:17", "pos.thrift:5:20")
+	at(user.Fields[0].Default, "pos.thrift:5:26", "pos.thrift:5:27")
+	at(user.Fields[1], "pos.thrift:6:18", "pos.thrift:6:53")
+	at(user.Fields[1].Annotations[0], "pos.thrift:6:34", "pos.thrift:6:52")
+	at(user.Annotations[0], "pos.thrift:7:4", "pos.thrift:7:11")
+
+	color := ast.Enums[0]
+	at(color, "pos.thrift:9:1", "pos.thrift:12:2")
+	at(color.Values[0], "pos.thrift:10:2", "pos.thrift:10:9")
+	at(color.Values[1], "pos.thrift:11:2", "pos.thrift:11:6")
+
+	svc := ast.Services[0]
+	at(svc, "pos.thrift:14:1", "pos.thrift:17:2")
+	at(svc.Functions[0], "pos.thrift:15:2", "pos.thrift:15:13")
+	at(svc.Functions[0].FunctionType, "pos.thrift:15:2", "pos.thrift:15:6")
+	at(svc.Functions[1].Arguments[0], "pos.thrift:16:11", "pos.thrift:16:20")
+	at(svc.Functions[1].Throws[0], "pos.thrift:16:30", "pos.thrift:16:39")
+	test.Assert(t, parser.Where(ast, user) == "pos.thrift:4:1", parser.Where(ast, user))
+
+	// the spans of an AST are dropped when its file is parsed again
+	again, err := parser.ParseString("pos.thrift", testPosition)
+	test.Assert(t, err == nil, err)
+	test.Assert(t, parser.SpanOf(ast, user) == nil)
+	test.Assert(t, parser.Where(ast, user) == "pos.thrift", parser.Where(ast, user))
+	test.Assert(t, parser.SpanOf(again, again.Structs[0]).String() == "pos.thrift:4:1")
+	test.Assert(t, parser.SpanOf(again, &parser.StructLike{}) == nil)
+}
+
+const testRecovery = `namespace go demo
+
+// User is a user.
+struct User {
+    1: i64 id
+    2: i32
+}
+
+enum Color {
+    RED = 1,
+    GREEN = ,
+}
+
+const i32 MAX = 10
+
+service Demo {
+    void Ping(
+}
+
+/* Ok is fine. */
+struct Ok {
+    1: string name
+}
+`
+
+func TestRecovery(t *testing.T) {
+	_, err := parser.ParseString("recovery.thrift", testRecovery)
+	test.Assert(t, err != nil)
+
+	ast, err := parser.ParseStringWithRecovery("recovery.thrift", testRecovery)
+	diags, ok := err.(parser.Diagnostics)
+	test.Assert(t, ok, err)
+	test.Assert(t, len(diags) == 3, err)
+	test.Assert(t, diags[0].Pos.String() == "recovery.thrift:7:1", diags[0].Pos)
+	test.Assert(t, diags[0].Message == `unexpected "}"`, diags[0].Message)
+	test.Assert(t, diags[0].Expected[0] == "identifier", diags[0].Expected)
+	test.Assert(t, diags[1].Error() == `recovery.thrift:11:13: unexpected ",", expecting integer`, diags[1])
+	test.Assert(t, diags[2].Pos.String() == "recovery.thrift:18:1", diags[2].Pos)
+
+	test.Assert(t, ast != nil)
+	test.Assert(t, len(ast.Namespaces) == 1)
+	test.Assert(t, len(ast.Constants) == 1 && ast.Constants[0].Name == "MAX")
+	test.Assert(t, len(ast.Structs) == 1 && ast.Structs[0].Name == "Ok")
+	test.Assert(t, ast.Structs[0].ReservedComments == "/* Ok is fine. */", ast.Structs[0].ReservedComments)
+	test.Assert(t, parser.SpanOf(ast, ast.Structs[0]).String() == "recovery.thrift:21:1", parser.SpanOf(ast, ast.Structs[0]))
+	test.Assert(t, len(ast.Enums) == 0 && len(ast.Services) == 0)
+
+	_, err = parser.ParseStringWithRecovery("eof.thrift", "const i32 Z =\n\n// S\nservice S {}\n")
+	diags, ok = err.(parser.Diagnostics)
+	test.Assert(t, ok && len(diags) == 1, err)
+	test.Assert(t, diags[0].Pos.String() == "eof.thrift:4:1", diags[0].Pos)
+	test.Assert(t, diags[0].Message == `unexpected "service"`, diags[0].Message)
+
+	ast, err = parser.ParseStringWithRecovery("recovery.thrift", testPosition)
+	test.Assert(t, err == nil, err)
+	test.Assert(t, len(ast.Structs) == 1)
+}
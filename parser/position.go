@@ -0,0 +1,208 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+)
+
+// Position describes a location in an IDL file.
+// Offset is 0-based and Line and Column are 1-based; Offset and Column count runes.
+type Position struct {
+	Filename string
+	Offset   int
+	Line     int
+	Column   int
+}
+
+// IsValid reports whether the position is known.
+func (p Position) IsValid() bool {
+	return p.Line > 0
+}
+
+// String returns the position in the form of 'file:line:column'.
+func (p Position) String() string {
+	s := p.Filename
+	if p.IsValid() {
+		if s != "" {
+			s += ":"
+		}
+		s += fmt.Sprintf("%d", p.Line)
+		if p.Column > 0 {
+			s += fmt.Sprintf(":%d", p.Column)
+		}
+	}
+	if s == "" {
+		s = "-"
+	}
+	return s
+}
+
+// Span is the source range of an AST node, from the first character of
+// the node to the one after its last character, excluding the surrounding
+// comments and separators.
+//
+// The AST types are generated from AST.thrift and sent to plugins, so spans
+// are not stored in them. The parser records them in a side table instead,
+// which is looked up with SpanOf.
+type Span struct {
+	Start Position
+	End   Position
+}
+
+// String returns the start position of the span.
+func (s *Span) String() string {
+	if s == nil {
+		return "-"
+	}
+	return s.Start.String()
+}
+
+// spanTable holds the spans of the nodes of an AST returned by the parser.
+type spanTable struct {
+	ast   *Thrift
+	spans map[interface{}]*Span
+}
+
+// spanTables holds the span table of the last parsed AST of each file.
+// Parsing a file again replaces its table, so that a long running process
+// that parses the same files over and over, like the language server,
+// only keeps the spans of their latest versions.
+var spanTables = struct {
+	sync.RWMutex
+	files map[string]*spanTable
+}{files: make(map[string]*spanTable)}
+
+func registerSpans(ast *Thrift, spans map[interface{}]*Span) {
+	spanTables.Lock()
+	defer spanTables.Unlock()
+	spanTables.files[ast.Filename] = &spanTable{ast: ast, spans: spans}
+}
+
+// SpanOf returns the span of a node of the AST, such as a *StructLike, a *Field
+// or a *ConstValue. It returns nil if the node is not created by the parser for
+// this AST, or if the file of the AST has been parsed again since.
+func SpanOf(ast *Thrift, node interface{}) *Span {
+	if ast == nil {
+		return nil
+	}
+	spanTables.RLock()
+	defer spanTables.RUnlock()
+	if t := spanTables.files[ast.Filename]; t != nil && t.ast == ast {
+		return t.spans[node]
+	}
+	return nil
+}
+
+// Where returns the start position of a node of the AST if it is known, or the
+// filename of the AST otherwise. It is used to prefix error messages.
+func Where(ast *Thrift, node interface{}) string {
+	if s := SpanOf(ast, node); s != nil && s.Start.IsValid() {
+		return s.Start.String()
+	}
+	return ast.Filename
+}
+
+// lineIndex converts rune offsets of a buffer into positions.
+type lineIndex struct {
+	filename string
+	starts   []int // offsets of the first rune of each line
+	base     int   // added to the offsets of a buffer that is a part of the file
+}
+
+func newLineIndex(filename string, buffer []rune) *lineIndex {
+	starts := []int{0}
+	for i, r := range buffer {
+		if r == '\n' {
+			starts = append(starts, i+1)
+		}
+	}
+	return &lineIndex{filename: filename, starts: starts}
+}
+
+func (l *lineIndex) position(offset int) Position {
+	offset += l.base
+	line := sort.Search(len(l.starts), func(i int) bool { return l.starts[i] > offset }) - 1
+	if line < 0 {
+		line = 0
+	}
+	return Position{
+		Filename: l.filename,
+		Offset:   offset,
+		Line:     line + 1,
+		Column:   offset - l.starts[line] + 1,
+	}
+}
+
+// isTrivia reports whether the rule matches comments, spaces or separators
+// which do not belong to the span of a node.
+func isTrivia(rule pegRule) bool {
+	switch rule {
+	case ruleSkip, ruleSkipLine, ruleIndent, ruleSpace, ruleComment,
+		ruleReservedComments, ruleReservedEndLineComments, ruleListSeparator:
+		return true
+	}
+	return false
+}
+
+// startOf returns the offset of the first non-trivia character of the node.
+func startOf(node *node32) int {
+	begin := node.begin
+	for n := node.up; n != nil && n.begin == begin; n = n.next {
+		if !isTrivia(n.pegRule) {
+			return startOf(n)
+		}
+		begin = n.end
+	}
+	return int(begin)
+}
+
+// endOf returns the offset after the last non-trivia character of the node.
+func endOf(node *node32) int {
+	var children []*node32
+	for n := node.up; n != nil; n = n.next {
+		children = append(children, n)
+	}
+	end := node.end
+	for i := len(children) - 1; i >= 0 && children[i].end == end; i-- {
+		if !isTrivia(children[i].pegRule) {
+			return endOf(children[i])
+		}
+		end = children[i].begin
+	}
+	return int(end)
+}
+
+// record sets the span of an AST node.
+func (p *parser) record(node interface{}, span *Span) {
+	if p.spans == nil {
+		p.spans = make(map[interface{}]*Span)
+	}
+	p.spans[node] = span
+}
+
+// span returns the span covering the nodes from first to last.
+func (p *parser) span(first, last *node32) *Span {
+	if p.lines == nil {
+		p.lines = newLineIndex(p.Filename, p.buffer)
+	}
+	start, end := startOf(first), endOf(last)
+	if end < start {
+		end = start
+	}
+	return &Span{Start: p.lines.position(start), End: p.lines.position(end)}
+}
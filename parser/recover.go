@@ -0,0 +1,417 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package parser
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+)
+
+// Diagnostic is a syntax error found by the recovering parser.
+type Diagnostic struct {
+	Pos      Position
+	Message  string
+	Expected []string // the tokens that would be accepted at Pos, if known
+}
+
+// Error implements the error interface.
+func (d *Diagnostic) Error() string {
+	msg := d.Pos.String() + ": " + d.Message
+	switch n := len(d.Expected); n {
+	case 0:
+	case 1:
+		msg += ", expecting " + d.Expected[0]
+	default:
+		msg += ", expecting " + strings.Join(d.Expected[:n-1], ", ") + " or " + d.Expected[n-1]
+	}
+	return msg
+}
+
+// Diagnostics is a list of syntax errors. It implements the error interface
+// and reports one error per line.
+type Diagnostics []*Diagnostic
+
+// Error implements the error interface.
+func (ds Diagnostics) Error() string {
+	msgs := make([]string, 0, len(ds))
+	for _, d := range ds {
+		msgs = append(msgs, d.Error())
+	}
+	return strings.Join(msgs, "\n")
+}
+
+// syncKeywords are the keywords that start a header or a definition.
+// The recovering parser resynchronizes at them after a syntax error.
+var syncKeywords = map[string]bool{
+	"include":     true,
+	"cpp_include": true,
+	"namespace":   true,
+	"const":       true,
+	"typedef":     true,
+	"enum":        true,
+	"struct":      true,
+	"union":       true,
+	"exception":   true,
+	"service":     true,
+}
+
+func isHeaderKeyword(kw string) bool {
+	return kw == "include" || kw == "cpp_include" || kw == "namespace"
+}
+
+// expectedTokens are the candidates tried at the position of a syntax error
+// to tell what the parser expects there.
+var expectedTokens = []struct {
+	text string
+	name string
+}{
+	{"x", "identifier"},
+	{"0", "integer"},
+	{`""`, "literal"},
+	{"{", `"{"`},
+	{"}", `"}"`},
+	{"(", `"("`},
+	{")", `")"`},
+	{"[", `"["`},
+	{"]", `"]"`},
+	{"<", `"<"`},
+	{">", `">"`},
+	{"=", `"="`},
+	{":", `":"`},
+	{",", `","`},
+}
+
+// expectedKeywords are tried only when an identifier is not acceptable,
+// since any keyword is a valid identifier.
+var expectedKeywords = []string{
+	"include", "cpp_include", "namespace",
+	"const", "typedef", "enum", "struct", "union", "exception", "service",
+}
+
+// ParseStringWithRecovery parses the thrift file path and file content like ParseString,
+// but does not stop at the first syntax error. When the content has syntax errors,
+// it returns the AST of the definitions that are parsed successfully and a
+// Diagnostics error that contains all the syntax errors.
+func ParseStringWithRecovery(path, content string) (*Thrift, error) {
+	return parseStringWithRecovery(path, content, nil)
+}
+
+// ParseFileWithRecovery parses a thrift file like ParseFile, but does not stop at
+// the first syntax error. When any of the parsed files has syntax errors, it returns
+// the partial AST and a Diagnostics error that contains the syntax errors of all files.
+// Other errors, such as a missing include, are returned as is with a nil AST.
+func ParseFileWithRecovery(path string, includeDirs []string, recursive bool) (*Thrift, error) {
+	var diags Diagnostics
+	var t *Thrift
+	var err error
+	if recursive {
+		thriftMap := make(map[string]*Thrift)
+		dir := filepath.Dir(normalizeFilename(path))
+		t, err = recoverFileRecursively(path, dir, includeDirs, thriftMap, &diags)
+	} else {
+		var bs []byte
+		if bs, err = ioutil.ReadFile(path); err != nil {
+			return nil, err
+		}
+		t, err = parseStringWithRecovery(path, string(bs), includeDirs)
+		if ds, ok := err.(Diagnostics); ok {
+			diags, err = ds, nil
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(diags) > 0 {
+		return t, diags
+	}
+	return t, nil
+}
+
+func recoverFileRecursively(file, dir string, includeDirs []string, thriftMap map[string]*Thrift, diags *Diagnostics) (*Thrift, error) {
+	path, err := search(file, dir, includeDirs)
+	if err != nil {
+		return nil, err
+	}
+	if t, ok := thriftMap[path]; ok {
+		return t, nil
+	}
+	bs, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	t, err := parseStringWithRecovery(path, string(bs), includeDirs)
+	if ds, ok := err.(Diagnostics); ok {
+		*diags = append(*diags, ds...)
+	} else if err != nil {
+		return nil, fmt.Errorf("parse %s err: %w", path, err)
+	}
+	thriftMap[path] = t
+	dir = filepath.Dir(path)
+	for _, inc := range t.Includes {
+		t, err := recoverFileRecursively(inc.Path, dir, includeDirs, thriftMap, diags)
+		if err != nil {
+			return nil, err
+		}
+		inc.Reference = t
+	}
+	return t, nil
+}
+
+func parseStringWithRecovery(path, content string, includeDirs []string) (*Thrift, error) {
+	p := &parser{
+		IncludeDirs: includeDirs,
+	}
+	p.Filename = path
+	p.Buffer = content
+	p.Init()
+	err := p.ThriftIDL.Parse()
+	if err == nil {
+		if err := p.parse(); err != nil {
+			return nil, err
+		}
+		registerSpans(&p.Thrift, p.spans)
+		return &p.Thrift, nil
+	}
+	whole := p
+
+	// Parse the definitions one by one, each as a standalone document.
+	// Positions are kept relative to the whole content.
+	runes := []rune(content)
+	lines := newLineIndex(path, runes)
+	p = &parser{
+		IncludeDirs: includeDirs,
+	}
+	p.Filename = path
+	p.source = runes
+	var diags Diagnostics
+	var definitions bool
+	for _, seg := range splitDefinitions(runes) {
+		if skipTrivia(runes[:seg.end], seg.start) == seg.end {
+			continue
+		}
+		if isHeaderKeyword(seg.keyword) && definitions {
+			diags = append(diags, &Diagnostic{
+				Pos:     lines.position(seg.at),
+				Message: fmt.Sprintf("unexpected %q, headers must precede definitions", seg.keyword),
+			})
+		}
+		definitions = definitions || (seg.keyword != "" && !isHeaderKeyword(seg.keyword))
+
+		p.ThriftIDL = ThriftIDL{Buffer: string(runes[seg.start:seg.end])}
+		p.lines = &lineIndex{filename: path, starts: lines.starts, base: seg.start}
+		p.Init()
+		if err := p.ThriftIDL.Parse(); err != nil {
+			diags = append(diags, p.diagnose(err))
+			continue
+		}
+		if err := p.parse(); err != nil {
+			diags = append(diags, &Diagnostic{Pos: lines.position(seg.at), Message: err.Error()})
+		}
+	}
+	if len(diags) == 0 {
+		// every definition is fine by itself, report the error of the whole content
+		diags = append(diags, whole.diagnose(err))
+	}
+	registerSpans(&p.Thrift, p.spans)
+	return &p.Thrift, diags
+}
+
+// diagnose converts an error of the PEG parser into a diagnostic.
+func (p *parser) diagnose(err error) *Diagnostic {
+	if p.lines == nil {
+		p.lines = newLineIndex(p.Filename, p.buffer)
+	}
+	pe, ok := err.(*parseError)
+	if !ok {
+		return &Diagnostic{Pos: p.lines.position(0), Message: err.Error()}
+	}
+	src := p.buffer[:len(p.buffer)-1] // drop the end symbol
+	at := skipTrivia(src, int(pe.max.end))
+	d := &Diagnostic{
+		Pos:      p.lines.position(at),
+		Message:  "unexpected " + tokenAt(src, at),
+		Expected: expectedAt(src, at),
+	}
+	if p.source != nil && at == len(src) {
+		// the end of a definition is not the end of the file,
+		// so describe what follows it in the whole content
+		next := skipTrivia(p.source, p.lines.base+at)
+		d.Pos = p.lines.position(next - p.lines.base)
+		d.Message = "unexpected " + tokenAt(p.source, next)
+	}
+	return d
+}
+
+// expectedAt returns the names of the tokens that let the parser
+// go past the offset when they are inserted there.
+func expectedAt(src []rune, at int) (names []string) {
+	accepts := func(text string) bool {
+		buf := make([]rune, 0, len(src)+len(text)+2)
+		buf = append(buf, src[:at]...)
+		buf = append(buf, ' ')
+		buf = append(buf, []rune(text)...)
+		buf = append(buf, ' ')
+		buf = append(buf, src[at:]...)
+		p := &ThriftIDL{Buffer: string(buf)}
+		p.Init()
+		err := p.Parse()
+		if err == nil {
+			return true
+		}
+		pe := err.(*parseError)
+		return skipTrivia(buf, int(pe.max.end)) > at+1
+	}
+	for _, t := range expectedTokens {
+		if accepts(t.text) {
+			names = append(names, t.name)
+		}
+	}
+	if len(names) > 0 && names[0] == "identifier" {
+		return names
+	}
+	for _, kw := range expectedKeywords {
+		if accepts(kw) {
+			names = append(names, fmt.Sprintf("%q", kw))
+		}
+	}
+	return names
+}
+
+// tokenAt returns a quoted description of the token at the offset.
+func tokenAt(src []rune, at int) string {
+	if at >= len(src) {
+		return "end of file"
+	}
+	end := at + 1
+	switch r := src[at]; {
+	case isWordRune(r):
+		for end < len(src) && isWordRune(src[end]) {
+			end++
+		}
+	case r == '"' || r == '\'':
+		for end < len(src) && src[end] != r && src[end] != '\n' {
+			end++
+		}
+		if end < len(src) && src[end] == r {
+			end++
+		}
+	}
+	return fmt.Sprintf("%q", string(src[at:end]))
+}
+
+func isWordRune(r rune) bool {
+	return r == '_' || r == '.' || r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9'
+}
+
+// skipTrivia returns the offset of the first character from i that
+// is not a space or a part of comments.
+func skipTrivia(src []rune, i int) int {
+	for i < len(src) {
+		switch {
+		case src[i] == ' ' || src[i] == '\t' || src[i] == '\v' || src[i] == '\r' || src[i] == '\n':
+			i++
+		case src[i] == '#' || src[i] == '/' && i+1 < len(src) && (src[i+1] == '/' || src[i+1] == '*'):
+			i = skipComment(src, i)
+		default:
+			return i
+		}
+	}
+	return i
+}
+
+// segment is a part of the content that starts with a header or a definition.
+type segment struct {
+	start, end int    // the range of the segment, including the leading comments
+	at         int    // the offset of the keyword
+	keyword    string // empty for the part before the first keyword
+}
+
+// splitDefinitions splits the content at the keywords that start a header or
+// a definition. A keyword is a boundary when it is outside of any braces or
+// it is the first token of a line, which makes unclosed braces recoverable.
+// The comments right before a keyword belong to its segment.
+func splitDefinitions(src []rune) (segs []segment) {
+	cur := segment{}
+	depth, lead, lineStart := 0, -1, true
+	for i := 0; i < len(src); {
+		r := src[i]
+		switch {
+		case r == '\n':
+			lineStart = true
+			i++
+		case r == ' ' || r == '\t' || r == '\v' || r == '\r':
+			i++
+		case r == '#' || r == '/' && i+1 < len(src) && (src[i+1] == '/' || src[i+1] == '*'):
+			if lineStart && lead < 0 {
+				lead = i
+			}
+			i = skipComment(src, i)
+		case r == '"' || r == '\'':
+			lead, lineStart = -1, false
+			for i++; i < len(src) && src[i] != r; i++ {
+				if src[i] == '\\' {
+					i++
+				}
+			}
+			i++
+		case isWordRune(r):
+			start := i
+			for i < len(src) && isWordRune(src[i]) {
+				i++
+			}
+			word := string(src[start:i])
+			if syncKeywords[word] && (depth == 0 || lineStart) {
+				boundary := start
+				if lead >= 0 {
+					boundary = lead
+				}
+				cur.end = boundary
+				segs = append(segs, cur)
+				cur = segment{start: boundary, at: start, keyword: word}
+				depth = 0
+			}
+			lead, lineStart = -1, false
+		default:
+			if r == '{' {
+				depth++
+			} else if r == '}' && depth > 0 {
+				depth--
+			}
+			lead, lineStart = -1, false
+			i++
+		}
+	}
+	cur.end = len(src)
+	return append(segs, cur)
+}
+
+// skipComment returns the offset after the comment starting at i.
+// Line comments end before the line break.
+func skipComment(src []rune, i int) int {
+	if src[i] == '/' && src[i+1] == '*' {
+		for i += 2; i+1 < len(src); i++ {
+			if src[i] == '*' && src[i+1] == '/' {
+				return i + 2
+			}
+		}
+		return len(src)
+	}
+	for i < len(src) && src[i] != '\n' {
+		i++
+	}
+	return i
+}
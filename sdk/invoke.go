@@ -61,7 +61,8 @@ func InvokeThriftgo(SDKPlugins []plugin.SDKPlugin, args ...string) (err error) {
 	// todo check log
 	log := a.MakeLogFunc()
 
-	ast, err := parser.ParseFile(a.IDL, a.Includes, true)
+	// report all the syntax errors at once
+	ast, err := parser.ParseFileWithRecovery(a.IDL, a.Includes, true)
 	if err != nil {
 		return err
 	}
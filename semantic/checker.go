@@ -54,6 +54,7 @@ func (c *checker) CheckAll(t *parser.Thrift) (warns []string, err error) {
 		c.CheckStructLikes,
 		c.CheckUnions,
 		c.CheckFunctions,
+		c.CheckConstants,
 	}
 	for tt := range t.DepthFirstSearch() {
 		for _, f := range checks {
@@ -68,29 +69,34 @@ func (c *checker) CheckAll(t *parser.Thrift) (warns []string, err error) {
 }
 
 func (c *checker) CheckGlobals(t *parser.Thrift) (warns []string, err error) {
+	type duplicated struct {
+		name string
+		node interface{}
+	}
 	defer func() {
 		if e := recover(); e != nil {
-			err = fmt.Errorf("[IDL grammar error] duplicated names in global scope: %s from file %s", e, t.Filename)
+			d := e.(duplicated)
+			err = fmt.Errorf("%s: duplicated names in global scope: %s", parser.Where(t, d.node), d.name)
 		}
 	}()
 	globals := make(map[string]bool)
-	check := func(s string) {
+	check := func(s string, node interface{}) {
 		if globals[s] {
-			panic(s)
+			panic(duplicated{s, node})
 		}
 		globals[s] = true
 	}
 	for _, v := range t.Typedefs {
-		check(v.Alias)
+		check(v.Alias, v)
 	}
 	for _, v := range t.Constants {
-		check(v.Name)
+		check(v.Name, v)
 	}
 	for _, v := range t.GetStructLikes() {
-		check(v.Name)
+		check(v.Name, v)
 	}
 	for _, v := range t.Services {
-		check(v.Name)
+		check(v.Name, v)
 	}
 	return
 }
@@ -101,13 +107,13 @@ func (c *checker) CheckEnums(t *parser.Thrift) (warns []string, err error) {
 		v2n := make(map[int64]string)
 		for _, v := range e.Values {
 			if exist[v.Name] {
-				err = fmt.Errorf("[IDL grammar error] enum %s has duplicated value: %s from file %s", e.Name, v.Name, t.Filename)
+				err = fmt.Errorf("%s: enum %s has duplicated value: %s", parser.Where(t, v), e.Name, v.Name)
 			}
 			exist[v.Name] = true
 			if n, ok := v2n[v.Value]; ok && n != v.Name {
 				err = fmt.Errorf(
-					"[IDL grammar error] enum %s: duplicate value %d between '%s' and '%s' from file %s",
-					e.Name, v.Value, n, v.Name, t.Filename,
+					"%s: enum %s: duplicate value %d between '%s' and '%s'",
+					parser.Where(t, v), e.Name, v.Value, n, v.Name,
 				)
 			}
 			v2n[v.Value] = v.Name
@@ -117,13 +123,14 @@ func (c *checker) CheckEnums(t *parser.Thrift) (warns []string, err error) {
 			// check if enum value can be safely converted to int 32
 			if v.Value < math.MinInt32 || v.Value > math.MaxInt32 {
 				return nil, fmt.Errorf(
-					"enum overflow: the value (%d) of enum '%s %s' exceeds the range of int32.\n"+
+					"%s: enum overflow: the value (%d) of enum '%s %s' exceeds the range of int32.\n"+
 						"Due to legacy implementation, thriftgo generates int64 for enums in Go code. \n"+
 						"However, during network, values undergo int64->int32->int64 conversion. Values outside int32 will overflow.\n"+
 						"Please adjust the enum value to fit within the int32 range [-2147483648, 2147483647].\n"+
 						"If you just want to define a very big constant, please use 'const i64 MyConst = xxx' instead.\n",
+					parser.Where(t, v),
 					v.Value,
-					t.Filename,
+					e.Name,
 					v.Name,
 				)
 			}
@@ -138,20 +145,20 @@ func (c *checker) CheckStructLikes(t *parser.Thrift) (warns []string, err error)
 		names := make(map[string]bool)
 		for _, f := range s.Fields {
 			if fieldIDs[f.ID] {
-				err = fmt.Errorf("[IDL grammar error] duplicated field ID %d in %s %q from file %s",
-					f.ID, s.Category, s.Name, t.Filename)
+				err = fmt.Errorf("%s: duplicated field ID %d in %s %q",
+					parser.Where(t, f), f.ID, s.Category, s.Name)
 				return
 			}
 			if names[f.Name] {
-				err = fmt.Errorf("[IDL grammar error] duplicated field name %q in %s %q from file %s",
-					f.Name, s.Category, s.Name, t.Filename)
+				err = fmt.Errorf("%s: duplicated field name %q in %s %q",
+					parser.Where(t, f), f.Name, s.Category, s.Name)
 				return
 			}
 			fieldIDs[f.ID] = true
 			names[f.Name] = true
 			if f.ID <= 0 {
-				warns = append(warns, fmt.Sprintf("non-positive ID %d of field %q in %q  from file %s",
-					f.ID, f.Name, s.Name, t.Filename))
+				warns = append(warns, fmt.Sprintf("%s: non-positive ID %d of field %q in %q",
+					parser.Where(t, f), f.ID, f.Name, s.Name))
 			}
 		}
 	}
@@ -165,14 +172,14 @@ func (c *checker) CheckUnions(t *parser.Thrift) (warns []string, err error) {
 		for _, f := range u.Fields {
 			if f.Requiredness == parser.FieldType_Required {
 				msg := fmt.Sprintf(
-					"union %s field %s: union members must be optional, ignoring specified requiredness.",
-					u.Name, f.Name)
+					"%s: union %s field %s: union members must be optional, ignoring specified requiredness.",
+					parser.Where(t, f), u.Name, f.Name)
 				warns = append(warns, msg)
 			}
 
 			if f.GetDefault() != nil {
 				if hasDefault {
-					err = fmt.Errorf("[IDL grammar error] field %s provides another default value for union %s from file %s", f.Name, u.Name, t.Filename)
+					err = fmt.Errorf("%s: field %s provides another default value for union %s", parser.Where(t, f), f.Name, u.Name)
 					return warns, err
 				}
 			}
@@ -192,36 +199,36 @@ func (c *checker) CheckFunctions(t *parser.Thrift) (warns []string, err error) {
 		defined := make(map[string]bool)
 		for _, f := range svc.Functions {
 			if defined[f.Name] {
-				err = fmt.Errorf("[IDL grammar error] duplicated function name in %q: %q from file %s", svc.Name, f.Name, t.Filename)
+				err = fmt.Errorf("%s: duplicated function name in %q: %q", parser.Where(t, f), svc.Name, f.Name)
 				return
 			}
 			defined[f.Name] = true
 
 			if f.Oneway && !f.Void {
-				err = fmt.Errorf("[IDL grammar error] %s.%s: oneway function must be void type from file %s", svc.Name, f.Name, t.Filename)
+				err = fmt.Errorf("%s: %s.%s: oneway function must be void type", parser.Where(t, f), svc.Name, f.Name)
 				return
 			}
 			if f.Oneway && len(f.Throws) > 0 {
-				err = fmt.Errorf("[IDL grammar error] %s.%s: oneway methods can't throw exceptions from file %s", svc.Name, f.Name, t.Filename)
+				err = fmt.Errorf("%s: %s.%s: oneway methods can't throw exceptions", parser.Where(t, f), svc.Name, f.Name)
 				return
 			}
 			for _, a := range f.Arguments {
 				if a.Requiredness == parser.FieldType_Optional {
-					argOpt = t.Filename + ": optional keyword is ignored in argument lists."
+					argOpt = parser.Where(t, a) + ": optional keyword is ignored in argument lists."
 					if c.FixWarnings {
 						a.Requiredness = parser.FieldType_Default
 					}
 				}
 				if a.ID <= 0 {
-					warns = append(warns, fmt.Sprintf("non-positive ID %d of argument %q in %q.%q",
-						a.ID, a.Name, svc.Name, f.Name))
+					warns = append(warns, fmt.Sprintf("%s: non-positive ID %d of argument %q in %q.%q",
+						parser.Where(t, a), a.ID, a.Name, svc.Name, f.Name))
 				}
 			}
 			for _, a := range f.Throws {
 				switch a.Requiredness {
 				case parser.FieldType_Required:
-					warns = append(warns, fmt.Sprintf("exception %q in %q.%q: throw field must be optional, ignoring specified requiredness.",
-						a.Name, svc.Name, f.Name))
+					warns = append(warns, fmt.Sprintf("%s: exception %q in %q.%q: throw field must be optional, ignoring specified requiredness.",
+						parser.Where(t, a), a.Name, svc.Name, f.Name))
 					if !c.FixWarnings {
 						continue
 					}
@@ -0,0 +1,109 @@
+// Copyright 2021 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package semantic_test
+
+import (
+	"testing"
+
+	"github.com/cloudwego/thriftgo/parser"
+	"github.com/cloudwego/thriftgo/pkg/test"
+	"github.com/cloudwego/thriftgo/semantic"
+)
+
+func TestCheckerPositions(t *testing.T) {
+	ast, err := parser.ParseString("user.thrift", `struct User {
+    1: i64 id
+    3: string a
+    3: string b
+}
+
+service S {
+    void Ping(0: i32 x)
+}
+`)
+	test.Assert(t, err == nil, err)
+	test.Assert(t, semantic.ResolveSymbols(ast) == nil)
+
+	warns, err := semantic.NewChecker(semantic.Options{}).CheckAll(ast)
+	test.Assert(t, err != nil)
+	test.Assert(t, err.Error() == `user.thrift:4:5: duplicated field ID 3 in struct "User"`, err)
+	test.Assert(t, len(warns) == 0, warns)
+
+	ast.Structs[0].Fields[2].ID = 4
+	warns, err = semantic.NewChecker(semantic.Options{}).CheckAll(ast)
+	test.Assert(t, err == nil, err)
+	test.Assert(t, len(warns) == 1 && warns[0] == `user.thrift:8:15: non-positive ID 0 of argument "x" in "S"."Ping"`, warns)
+}
+
+func TestResolvePositions(t *testing.T) {
+	ast, err := parser.ParseString("a.thrift", "struct A {\n  1: Missing m\n}\n")
+	test.Assert(t, err == nil, err)
+	err = semantic.ResolveSymbols(ast)
+	test.Assert(t, err != nil)
+	test.Assert(t, err.Error() == `a.thrift:2:3: resolve field "m" of "A": undefined type: "Missing"`, err)
+}
+
+func TestCheckConstants(t *testing.T) {
+	const header = `enum Color {
+    RED = 1
+}
+enum Shape {
+    CIRCLE = 1
+}
+typedef i8 Small
+struct Point {
+    1: i32 x
+    2: Color color = Color.RED
+}
+const i64 BIG = 300
+`
+	ok := []string{
+		`const Small A = 127`,
+		`const i16 B = BIG`,
+		`const bool C = 1`,
+		`const double D = 1`,
+		`const i32 E = Color.RED`,
+		`const Color F = 1`,
+		`const map<string, list<Small>> G = {"a": [1, -128]}`,
+		`const Point H = {"x": 1, "color": Color.RED}`,
+		`struct S { 1: Shape s = Shape.CIRCLE }`,
+	}
+	for _, src := range ok {
+		ast, err := parser.ParseString("c.thrift", header+src+"\n")
+		test.Assert(t, err == nil, err)
+		_, err = semantic.NewChecker(semantic.Options{}).CheckAll(ast)
+		test.Assert(t, err == nil, src, err)
+	}
+
+	bad := map[string]string{
+		`const i8 X = 1000`:                         `c.thrift:13:14: constant "X": 1000 overflows i8 [-128, 127]`,
+		`const Small X = BIG`:                       `c.thrift:13:17: constant "X": BIG: 300 overflows i8 [-128, 127]`,
+		`const string X = 3`:                        `c.thrift:13:18: constant "X": cannot use 3 as string`,
+		`const i32 X = "3"`:                         `c.thrift:13:15: constant "X": cannot use "3" (string) as i32`,
+		`const bool X = 2`:                          `c.thrift:13:16: constant "X": cannot use 2 as bool`,
+		`const map<string, i32> X = {"a": 1, 2: 3}`: `c.thrift:13:37: constant "X": cannot use 2 as string`,
+		`const list<i16> X = [1, 65536]`:            `c.thrift:13:25: constant "X": 65536 overflows i16 [-32768, 32767]`,
+		`const Point X = {"y": 1}`:                  `c.thrift:13:18: constant "X": unknown field "y" of struct "Point"`,
+		`struct S { 1: Color c = Shape.CIRCLE }`:    `c.thrift:13:25: default value of field "c" of "S": Shape.CIRCLE is a value of enum "Shape", not Color`,
+		`struct T { 1: string s = Color.RED }`:      `c.thrift:13:26: default value of field "s" of "T": cannot use Color.RED (enum Color) as string`,
+		`service V { void f(1: i8 b = 128) }`:       `c.thrift:13:30: default value of argument "b" in "V"."f": 128 overflows i8 [-128, 127]`,
+	}
+	for src, msg := range bad {
+		ast, err := parser.ParseString("c.thrift", header+src+"\n")
+		test.Assert(t, err == nil, err)
+		_, err = semantic.NewChecker(semantic.Options{}).CheckAll(ast)
+		test.Assert(t, err != nil && err.Error() == msg, src, err)
+	}
+}
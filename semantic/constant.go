@@ -0,0 +1,291 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package semantic
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/cloudwego/thriftgo/parser"
+)
+
+// constError is a type error of a value nested in a constant or a default value.
+type constError struct {
+	pos *parser.Span
+	msg string
+}
+
+func (e *constError) Error() string {
+	return e.msg
+}
+
+// CheckConstants type-checks the values of constants and the default values of
+// fields against their types, and reports integers out of the range of their types.
+// Symbols are resolved first since both types and values may refer to other IDLs.
+func (c *checker) CheckConstants(t *parser.Thrift) (warns []string, err error) {
+	if err = ResolveSymbols(t); err != nil {
+		return nil, err
+	}
+	check := func(typ *parser.Type, v *parser.ConstValue, format string, args ...interface{}) error {
+		cc := &constChecker{visiting: make(map[*parser.Constant]bool)}
+		if e := cc.check(t, typ, t, v); e != nil {
+			where := parser.Where(t, v)
+			if ce, ok := e.(*constError); ok && ce.pos != nil {
+				where = ce.pos.String()
+			}
+			return fmt.Errorf("%s: %s: %s", where, fmt.Sprintf(format, args...), e.Error())
+		}
+		return nil
+	}
+	for _, v := range t.Constants {
+		if err = check(v.Type, v.Value, "constant %q", v.Name); err != nil {
+			return
+		}
+	}
+	for _, s := range t.GetStructLikes() {
+		for _, f := range s.Fields {
+			if f.Default == nil {
+				continue
+			}
+			if err = check(f.Type, f.Default, "default value of field %q of %q", f.Name, s.Name); err != nil {
+				return
+			}
+		}
+	}
+	for _, svc := range t.Services {
+		for _, f := range svc.Functions {
+			for _, a := range f.Arguments {
+				if a.Default == nil {
+					continue
+				}
+				if err = check(a.Type, a.Default, "default value of argument %q in %q.%q", a.Name, svc.Name, f.Name); err != nil {
+					return
+				}
+			}
+		}
+	}
+	return
+}
+
+type constChecker struct {
+	visiting map[*parser.Constant]bool // to detect constants that refer to themselves
+}
+
+// check reports whether the value in the AST vast fits the type in the AST tast.
+func (cc *constChecker) check(tast *parser.Thrift, typ *parser.Type, vast *parser.Thrift, v *parser.ConstValue) error {
+	name := typeString(typ)
+	tast, typ, err := Deref(tast, typ)
+	if err != nil {
+		return err
+	}
+	mismatch := func() error {
+		return &constError{parser.SpanOf(vast, v), fmt.Sprintf("cannot use %s as %s", describe(v), name)}
+	}
+
+	if v.Type == parser.ConstType_ConstIdentifier {
+		id := v.TypedValue.GetIdentifier()
+		if id == "true" || id == "false" {
+			if typ.Category != parser.Category_Bool {
+				return mismatch()
+			}
+			return nil
+		}
+		return cc.checkReference(tast, typ, name, vast, v)
+	}
+
+	switch typ.Category {
+	case parser.Category_Bool:
+		// 0 and 1 are accepted as booleans
+		if v.Type == parser.ConstType_ConstInt && (v.TypedValue.GetInt() == 0 || v.TypedValue.GetInt() == 1) {
+			return nil
+		}
+	case parser.Category_Byte, parser.Category_I16, parser.Category_I32, parser.Category_I64, parser.Category_Enum:
+		if v.Type == parser.ConstType_ConstInt {
+			return checkRange(vast, v, v.TypedValue.GetInt(), typ.Category, name)
+		}
+	case parser.Category_Double:
+		if v.Type == parser.ConstType_ConstInt || v.Type == parser.ConstType_ConstDouble {
+			return nil
+		}
+	case parser.Category_String, parser.Category_Binary:
+		if v.Type == parser.ConstType_ConstLiteral {
+			return nil
+		}
+	case parser.Category_List, parser.Category_Set:
+		if v.Type == parser.ConstType_ConstList {
+			for _, elem := range v.TypedValue.List {
+				if err = cc.check(tast, typ.ValueType, vast, elem); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	case parser.Category_Map:
+		if v.Type == parser.ConstType_ConstMap {
+			for _, kv := range v.TypedValue.Map {
+				if err = cc.check(tast, typ.KeyType, vast, kv.Key); err != nil {
+					return err
+				}
+				if err = cc.check(tast, typ.ValueType, vast, kv.Value); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	case parser.Category_Struct, parser.Category_Union, parser.Category_Exception:
+		if v.Type == parser.ConstType_ConstMap {
+			return cc.checkStruct(tast, typ, vast, v)
+		}
+	}
+	return mismatch()
+}
+
+// checkStruct checks a map that initializes the fields of a struct-like.
+func (cc *constChecker) checkStruct(tast *parser.Thrift, typ *parser.Type, vast *parser.Thrift, v *parser.ConstValue) error {
+	var s *parser.StructLike
+	for _, x := range tast.GetStructLikes() {
+		if x.Name == typ.Name {
+			s = x
+		}
+	}
+	if s == nil {
+		return fmt.Errorf("%s %q not found in %q", typ.Category, typ.Name, tast.Filename)
+	}
+	for _, kv := range v.TypedValue.Map {
+		if kv.Key.Type != parser.ConstType_ConstLiteral {
+			return &constError{parser.SpanOf(vast, kv.Key), fmt.Sprintf("cannot use %s as a field name of %s %q", describe(kv.Key), s.Category, s.Name)}
+		}
+		var field *parser.Field
+		for _, f := range s.Fields {
+			if f.Name == kv.Key.TypedValue.GetLiteral() {
+				field = f
+			}
+		}
+		if field == nil {
+			return &constError{parser.SpanOf(vast, kv.Key), fmt.Sprintf("unknown field %q of %s %q", kv.Key.TypedValue.GetLiteral(), s.Category, s.Name)}
+		}
+		if err := cc.check(tast, field.Type, vast, kv.Value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// checkReference checks an identifier that refers to an enum value or a constant.
+func (cc *constChecker) checkReference(tast *parser.Thrift, typ *parser.Type, name string, vast *parser.Thrift, v *parser.ConstValue) error {
+	id := v.TypedValue.GetIdentifier()
+	ref := v.Extra
+	if ref == nil {
+		// not resolved by ResolveSymbols, such as the default values of arguments
+		return nil
+	}
+	scope := vast
+	if ref.Index >= 0 && int(ref.Index) < len(vast.Includes) {
+		scope = vast.Includes[ref.Index].Reference
+	}
+
+	if ref.IsEnum {
+		enum, _ := getEnum(scope, ref.Sel)
+		if enum == nil {
+			enum, _ = getEnum(vast, ref.Sel)
+		}
+		if enum == nil {
+			return &constError{parser.SpanOf(vast, v), fmt.Sprintf("undefined enum of value: %q", id)}
+		}
+		switch typ.Category {
+		case parser.Category_Enum:
+			if expected, ok := tast.GetEnum(typ.Name); !ok || expected != enum {
+				return &constError{parser.SpanOf(vast, v), fmt.Sprintf("%s is a value of enum %q, not %s", id, enum.Name, name)}
+			}
+			return nil
+		case parser.Category_Byte, parser.Category_I16, parser.Category_I32, parser.Category_I64:
+			for _, ev := range enum.Values {
+				if ev.Name == ref.Name {
+					return checkRange(vast, v, ev.Value, typ.Category, name)
+				}
+			}
+			return nil
+		}
+		return &constError{parser.SpanOf(vast, v), fmt.Sprintf("cannot use %s (enum %s) as %s", id, enum.Name, name)}
+	}
+
+	c, ok := scope.GetConstant(ref.Name)
+	if !ok {
+		return &constError{parser.SpanOf(vast, v), fmt.Sprintf("undefined value: %q", id)}
+	}
+	if cc.visiting[c] {
+		return &constError{parser.SpanOf(vast, v), fmt.Sprintf("constant %q refers to itself", id)}
+	}
+	cc.visiting[c] = true
+	defer delete(cc.visiting, c)
+	// the value of the constant is checked against the expected type rather than
+	// the declared one, so that integers are range-checked by their values
+	if err := cc.check(tast, typ, scope, c.Value); err != nil {
+		if ce, ok := err.(*constError); ok {
+			return &constError{parser.SpanOf(vast, v), fmt.Sprintf("%s: %s", id, ce.msg)}
+		}
+		return err
+	}
+	return nil
+}
+
+// checkRange reports whether the integer fits the type of the category.
+func checkRange(vast *parser.Thrift, v *parser.ConstValue, i int64, category parser.Category, name string) error {
+	var min, max int64
+	switch category {
+	case parser.Category_Byte:
+		min, max = math.MinInt8, math.MaxInt8
+	case parser.Category_I16:
+		min, max = math.MinInt16, math.MaxInt16
+	case parser.Category_I32, parser.Category_Enum:
+		min, max = math.MinInt32, math.MaxInt32
+	default:
+		return nil
+	}
+	if i < min || i > max {
+		return &constError{parser.SpanOf(vast, v), fmt.Sprintf("%d overflows %s [%d, %d]", i, name, min, max)}
+	}
+	return nil
+}
+
+// typeString returns the type as it is written in the IDL.
+func typeString(t *parser.Type) string {
+	switch t.Name {
+	case "map":
+		return fmt.Sprintf("map<%s,%s>", typeString(t.KeyType), typeString(t.ValueType))
+	case "list", "set":
+		return fmt.Sprintf("%s<%s>", t.Name, typeString(t.ValueType))
+	}
+	return t.Name
+}
+
+// describe returns a short description of the value for error messages.
+func describe(v *parser.ConstValue) string {
+	switch v.Type {
+	case parser.ConstType_ConstInt:
+		return fmt.Sprint(v.TypedValue.GetInt())
+	case parser.ConstType_ConstDouble:
+		return fmt.Sprint(v.TypedValue.GetDouble())
+	case parser.ConstType_ConstLiteral:
+		return fmt.Sprintf("%q (string)", v.TypedValue.GetLiteral())
+	case parser.ConstType_ConstIdentifier:
+		return v.TypedValue.GetIdentifier()
+	case parser.ConstType_ConstList:
+		return fmt.Sprintf("a list of %d elements", len(v.TypedValue.List))
+	case parser.ConstType_ConstMap:
+		return fmt.Sprintf("a map of %d entries", len(v.TypedValue.Map))
+	}
+	return v.String()
+}
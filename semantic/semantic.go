@@ -57,9 +57,9 @@ func guard(err error) (ok bool) {
 	return true
 }
 
-func (r *resolver) AddName(name string, category parser.Category) error {
+func (r *resolver) AddName(name string, category parser.Category, node interface{}) error {
 	if _, exist := r.ast.Name2Category[name]; exist {
-		return fmt.Errorf("%s: multiple definition of %q", r.ast.Filename, name)
+		return fmt.Errorf("%s: multiple definition of %q", parser.Where(r.ast, node), name)
 	}
 	r.ast.Name2Category[name] = category
 	return nil
@@ -69,34 +69,42 @@ func (r *resolver) AddName(name string, category parser.Category) error {
 // It panics when encounters any error.
 func (r *resolver) RegisterNames() {
 	r.ast.ForEachTypedef(func(v *parser.Typedef) bool {
-		return guard(r.AddName(v.Alias, parser.Category_Typedef))
+		return guard(r.AddName(v.Alias, parser.Category_Typedef, v))
 	})
 
 	r.ast.ForEachConstant(func(v *parser.Constant) bool {
-		return guard(r.AddName(v.Name, parser.Category_Constant))
+		return guard(r.AddName(v.Name, parser.Category_Constant, v))
 	})
 
 	r.ast.ForEachEnum(func(v *parser.Enum) bool {
-		return guard(r.AddName(v.Name, parser.Category_Enum))
+		return guard(r.AddName(v.Name, parser.Category_Enum, v))
 	})
 
 	r.ast.ForEachStructLike(func(v *parser.StructLike) bool {
 		switch v.Category {
 		case "struct":
-			return guard(r.AddName(v.Name, parser.Category_Struct))
+			return guard(r.AddName(v.Name, parser.Category_Struct, v))
 		case "union":
-			return guard(r.AddName(v.Name, parser.Category_Union))
+			return guard(r.AddName(v.Name, parser.Category_Union, v))
 		case "exception":
-			return guard(r.AddName(v.Name, parser.Category_Exception))
+			return guard(r.AddName(v.Name, parser.Category_Exception, v))
 		}
 		return false
 	})
 
 	r.ast.ForEachService(func(v *parser.Service) bool {
-		return guard(r.AddName(v.Name, parser.Category_Service))
+		return guard(r.AddName(v.Name, parser.Category_Service, v))
 	})
 }
 
+// wrap prefixes a non-nil error with the position of the node and a description.
+func (r *resolver) wrap(node interface{}, err error, format string, args ...interface{}) error {
+	if err == nil {
+		return nil
+	}
+	return fmt.Errorf("%s: %s: %w", parser.Where(r.ast, node), fmt.Sprintf(format, args...), err)
+}
+
 // ResolveAST iterates the current AST and checks the legitimacy of each symbol.
 func (r *resolver) ResolveAST() (err error) {
 	defer func() {
@@ -111,10 +119,10 @@ func (r *resolver) ResolveAST() (err error) {
 
 	r.ast.ForEachInclude(func(v *parser.Include) bool {
 		if v.Reference == nil {
-			panic(fmt.Errorf("reference %q of %q is not parsed", v.Path, r.ast.Filename))
+			panic(fmt.Errorf("%s: reference %q is not parsed", parser.Where(r.ast, v), v.Path))
 		}
 		if err = ResolveSymbols(v.Reference); err != nil {
-			panic(fmt.Errorf("resolve include %q: %w from file %s", v.Path, err, r.ast.Filename))
+			panic(fmt.Errorf("%s: resolve include %q: %w", parser.Where(r.ast, v), v.Path, err))
 		}
 		return true
 	})
@@ -124,11 +132,12 @@ func (r *resolver) ResolveAST() (err error) {
 	r.RegisterNames()
 
 	r.ast.ForEachTypedef(func(v *parser.Typedef) bool {
-		return guard(r.ResolveType(v.Type))
+		return guard(r.wrap(v, r.ResolveType(v.Type), "resolve typedef %q", v.Alias))
 	})
 
 	r.ast.ForEachConstant(func(v *parser.Constant) bool {
-		return guard(r.ResolveType(v.Type)) && guard(r.ResolveConstValue(v.Value))
+		return guard(r.wrap(v.Type, r.ResolveType(v.Type), "resolve type of constant %q", v.Name)) &&
+			guard(r.wrap(v.Value, r.ResolveConstValue(v.Value), "resolve value of constant %q", v.Name))
 	})
 
 	r.ast.ForEachStructLike(func(v *parser.StructLike) bool {
@@ -163,7 +172,7 @@ func (r *resolver) ResolveBaseService(v *parser.Service) error {
 		if c, exist := r.ast.Name2Category[tmp[0]]; exist && c == parser.Category_Service {
 			break
 		}
-		return fmt.Errorf("base service %q not found for %q", v.Extends, v.Name)
+		return fmt.Errorf("%s: base service %q not found for %q", parser.Where(r.ast, v), v.Extends, v.Name)
 	case 2:
 		for idx, inc := range r.ast.Includes {
 			if IDLPrefix(inc.Path) == tmp[0] {
@@ -178,7 +187,7 @@ func (r *resolver) ResolveBaseService(v *parser.Service) error {
 			}
 		}
 		if v.Reference == nil {
-			return fmt.Errorf("base service %q not found for %q", v.Extends, v.Name)
+			return fmt.Errorf("%s: base service %q not found for %q", parser.Where(r.ast, v), v.Extends, v.Name)
 		}
 	}
 	return nil
@@ -186,11 +195,11 @@ func (r *resolver) ResolveBaseService(v *parser.Service) error {
 
 func (r *resolver) ResolveStructField(s string, f *parser.Field) (err error) {
 	if err = r.ResolveType(f.Type); err != nil {
-		return fmt.Errorf("resolve field %q of %q: %w from file %s", f.Name, s, err, r.ast.Filename)
+		return r.wrap(f, err, "resolve field %q of %q", f.Name, s)
 	}
 	if f.IsSetDefault() {
 		if err = r.ResolveConstValue(f.Default); err != nil {
-			return fmt.Errorf("resolve default value of %q of %q: %w from file %s", f.Name, s, err, r.ast.Filename)
+			return r.wrap(f.Default, err, "resolve default value of %q of %q", f.Name, s)
 		}
 	}
 	return
@@ -199,17 +208,17 @@ func (r *resolver) ResolveStructField(s string, f *parser.Field) (err error) {
 func (r *resolver) ResolveFunction(s string, f *parser.Function) (err error) {
 	if !f.Void {
 		if err = r.ResolveType(f.FunctionType); err != nil {
-			return fmt.Errorf("function %q of service %q: %w from file %s", f.Name, s, err, r.ast.Filename)
+			return r.wrap(f.FunctionType, err, "function %q of service %q", f.Name, s)
 		}
 	}
 	for _, v := range f.Arguments {
 		if err := r.ResolveType(v.Type); err != nil {
-			return fmt.Errorf("resolve argument %q of %q of %q: %w from file %s", v.Name, f.Name, s, err, r.ast.Filename)
+			return r.wrap(v, err, "resolve argument %q of %q of %q", v.Name, f.Name, s)
 		}
 	}
 	for _, v := range f.Throws {
 		if err := r.ResolveType(v.Type); err != nil {
-			return fmt.Errorf("resolve exception %q of %q of %q: %w from file %s", v.Name, f.Name, s, err, r.ast.Filename)
+			return r.wrap(v, err, "resolve exception %q of %q of %q", v.Name, f.Name, s)
 		}
 	}
 	return
@@ -447,7 +456,7 @@ func (r *resolver) ResolveTypedefs() error {
 			var ss []string
 			for _, t := range tmp {
 				ss = append(ss, fmt.Sprintf(
-					"%q in %q", t.Type.Name, t.AST.Filename,
+					"%q at %s", t.Type.Name, parser.Where(t.AST, t.Type),
 				))
 			}
 			return fmt.Errorf("typedefs can not be resolved: %s", strings.Join(ss, ", "))
@@ -0,0 +1,10 @@
+namespace * common
+
+struct User {
+    1: required i64 id
+    2: optional string name
+}
+
+exception NotFound {
+    1: required string message
+} (api.http_code = "404")
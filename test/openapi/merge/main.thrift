@@ -0,0 +1,16 @@
+namespace * account
+
+include "common.thrift"
+
+struct User {
+    1: required common.User profile
+    2: optional list<string> roles
+}
+
+struct GetAccountRequest {
+    1: required i64 id (api.path = "id")
+}
+
+service AccountService {
+    User getAccount(1: GetAccountRequest req) throws (1: common.NotFound nf) (api.get = "/v1/accounts/:id")
+}
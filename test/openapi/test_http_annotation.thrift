@@ -0,0 +1,80 @@
+namespace go example
+
+// 用户信息结构体
+struct User {
+    1: required i64 id,
+    2: required string name,
+    3: optional string email,
+}
+
+// 分页参数，可展开到请求中
+struct PageParam {
+    1: optional i32 page (api.query = "page"),
+    2: optional i32 pageSize (api.query = "page_size"),
+} (expandable = "true")
+
+// 用户查询请求
+struct GetUserRequest {
+    1: required i64 id (api.path = "id"),
+}
+
+// 用户列表请求
+struct ListUsersRequest {
+    1: optional string keyword,
+    2: PageParam page,
+    3: optional string traceId (api.header = "X-Trace-Id"),
+}
+
+// 更新用户请求
+struct UpdateUserRequest {
+    1: required i64 id (api.path = "id"),
+    2: optional string name (api.body = "name"),
+    3: optional string email,
+}
+
+// 上传头像请求
+struct UploadAvatarRequest {
+    1: required i64 id (api.path = "id"),
+    2: required binary avatar (api.form = "avatar"),
+    3: optional string comment (api.form = "comment"),
+}
+
+// 用户不存在
+exception NotFound {
+    1: required string message,
+} (api.http_code = "404")
+
+// 请求参数错误
+exception BadRequest {
+    1: required string message,
+    2: optional string field,
+} (api.http_code = "400")
+
+// 用户名冲突
+exception Conflict {
+    1: required string message,
+} (api.http_code = "400")
+
+// 用户服务
+service UserService {
+    // 获取用户信息
+    User GetUser(1: GetUserRequest req) throws (1: NotFound nf) (api.get = "/v1/users/:id"),
+
+    // 删除用户
+    bool RemoveUser(1: GetUserRequest req) (api.delete = "/v1/users/:id"),
+
+    // 用户列表
+    list<User> ListUsers(1: ListUsersRequest req) (api.get = "/v1/users"),
+
+    // 创建用户
+    User CreateUser(1: User user) (api.post = "/v1/users"),
+
+    // 更新用户
+    User UpdateUser(1: UpdateUserRequest req) throws (1: NotFound nf, 2: BadRequest br, 3: Conflict cf) (api.put = "/v1/users/:id"),
+
+    // 上传头像
+    bool UploadAvatar(1: UploadAvatarRequest req) (api.post = "/v1/users/:id/avatar"),
+
+    // 没有注解时按函数名推断
+    User updateUserName(1: i64 id, 2: string name),
+}
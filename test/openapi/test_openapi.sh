@@ -44,5 +44,53 @@ else
     echo "✗ 带配置选项的生成失败"
 fi
 
+echo ""
+
+# 测试 OpenAPI 3.1 模式
+echo "4. 测试 OpenAPI 3.1 模式..."
+go run ../../main.go -g openapi:version=3.1.0 -o output test_openapi31.thrift
+if [ $? -eq 0 ]; then
+    echo "✓ 3.1 模式生成成功"
+    grep -E "openapi:|const:|unevaluatedProperties:" output/test_openapi31.yaml
+else
+    echo "✗ 3.1 模式生成失败"
+fi
+
+echo ""
+
+# 测试合并 include 树
+echo "5. 测试合并 include 树..."
+go run ../../main.go -g openapi:merge_includes -o output merge/main.thrift
+if [ $? -eq 0 ]; then
+    echo "✓ 合并生成成功"
+    grep '$ref' output/main.yaml
+else
+    echo "✗ 合并生成失败"
+fi
+
+echo ""
+
+# 测试 api.vd 校验约束
+echo "6. 测试校验约束..."
+go run ../../main.go -g openapi -o output test_validation.thrift
+if [ $? -eq 0 ]; then
+    echo "✓ 校验约束生成成功"
+    grep -E "minLength:|minimum:|pattern:|x-api-vd:" output/test_validation.yaml
+else
+    echo "✗ 校验约束生成失败"
+fi
+
+echo ""
+
+# 测试 JSON 输出格式
+echo "7. 测试 JSON 输出格式..."
+go run ../../main.go -g openapi:output_format=json -o output test_basic.thrift
+if [ $? -eq 0 ] && [ -f output/test_basic.json ]; then
+    echo "✓ JSON 格式生成成功"
+    grep -E '"openapi":|"title":' output/test_basic.json
+else
+    echo "✗ JSON 格式生成失败"
+fi
+
 echo ""
 echo "测试完成！"
@@ -0,0 +1,32 @@
+namespace go openapi31
+
+enum Status {
+    ACTIVE = 1
+}
+
+enum Role {
+    ADMIN = 1
+    MEMBER = 2
+}
+
+struct Profile {
+    1: required string name
+    2: optional string nickname
+    3: optional Status status
+}
+
+union Contact {
+    1: string email
+    2: string phone
+    3: Profile profile
+}
+
+service ContactService {
+    Profile getProfile(1: string id) (api.get = "/v1/profiles/:id")
+    Contact getContact(1: string id) (api.get = "/v1/contacts/:id")
+}
+
+union Payment {
+    1: string card_number
+    2: string wallet_id
+} (openapi.discriminator = "method")
@@ -0,0 +1,17 @@
+namespace go validation
+
+struct CreateItemRequest {
+    1: required string name (api.vd = "len($)>0&&len($)<=64")
+    2: required i32 quantity (api.vd = "$>=1&&$<=100")
+    3: optional string sku (api.vd = "regexp('^[A-Z]{3}-\d+$')")
+    4: optional string color (api.vd = "in($, 'red', 'green', 'blue')")
+    5: optional set<string> tags (api.vd = "len($)>0")
+    6: optional double price (api.vd = "$>0")
+    7: optional string owner (api.vd = "@:len($)>0; msg:'owner required'")
+    8: optional i32 level (api.vd = "$>=1&&mblen($)<3")
+    9: required i64 page (api.query = "page", api.vd = "$>=1")
+}
+
+service ItemService {
+    CreateItemRequest createItem(1: CreateItemRequest req) (api.post = "/v1/items")
+}
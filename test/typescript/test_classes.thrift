@@ -0,0 +1,44 @@
+namespace ts test.classes
+
+include "base.thrift"
+
+enum Level {
+  LOW = 1
+  HIGH = 2
+}
+
+const i32 DEFAULT_PAGE_SIZE = 20
+const Level DEFAULT_LEVEL = Level.HIGH
+const list<string> DEFAULT_TAGS = ["a", "b"]
+const map<string, i32> LIMITS = {"page": DEFAULT_PAGE_SIZE, "max": 100}
+const map<i32, string> CODES = {-1: "unknown", 0: "ok"}
+const i64 MAX_ID = 9007199254740993
+
+struct Point {
+  1: i32 x = 0
+  2: i32 y = DEFAULT_PAGE_SIZE
+}
+
+const Point ORIGIN = {"x": 0, "y": 0}
+
+struct Settings {
+  1: optional Point corner = {"x": 1}
+  2: list<Point> path = [{"x": 1, "y": 2}, ORIGIN]
+  3: Level level = DEFAULT_LEVEL
+  4: set<string> tags = DEFAULT_TAGS
+  5: map<string, list<i32>> ranges = {"page": [1, DEFAULT_PAGE_SIZE]}
+  6: double ratio = 1.5
+  7: bool enabled = true
+  8: i64 maxId = MAX_ID
+  9: optional base.PageReq page
+}
+
+union Target {
+  1: i32 id = 1
+  2: string name
+}
+
+exception SettingsError {
+  1: string message = "invalid settings"
+  2: i32 code = 400
+}
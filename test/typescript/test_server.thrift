@@ -0,0 +1,51 @@
+namespace ts test.server
+
+include "test_codec.thrift"
+
+enum OrderStatus {
+  PENDING = 1
+  PAID = 2
+}
+
+struct ListOrdersReq {
+  1: required i64 user_id (api.path = "userId")
+  2: optional i32 page (api.query = "page")
+  3: optional list<OrderStatus> statuses (api.query = "status")
+  4: optional bool with_items
+  5: optional string trace_id (api.header = "X-Trace-Id")
+}
+
+struct Order {
+  1: required i64 order_id
+  2: required OrderStatus status
+  3: optional double amount
+  4: optional set<string> tags
+  5: optional test_codec.Address address
+}
+
+struct ListOrdersResp {
+  1: list<Order> orders
+  2: i32 total
+}
+
+struct CreateOrderReq {
+  1: required string shop_id (api.path = "shopId")
+  2: required Order order
+  3: optional string remark
+}
+
+exception OrderNotFound {
+  1: required i64 order_id
+  2: optional string message
+} (api.http_code = "404")
+
+service OrderService {
+  ListOrdersResp listOrders(1: ListOrdersReq req) (api.get = "/users/:userId/orders", api.get = "/v2/users/:userId/orders")
+  Order createOrder(1: CreateOrderReq req) (api.post = "/shops/:shopId/orders")
+  Order getOrder(1: i64 orderId (api.path = "orderId"), 2: string token (api.header = "X-Token"))
+    throws (1: OrderNotFound notFound, 2: test_codec.NotFound gone (api.http_code = "410")) (api.get = "/orders/:orderId")
+  void updateRemark(1: i64 orderId (api.path = "orderId"), 2: required string remark (api.form = "remark"))
+    throws (1: OrderNotFound notFound) (api.post = "/orders/:orderId/remark")
+  void cancelOrder(1: i64 orderId, 2: string reason (api.query = "reason")) (api.delete = "/orders/:orderId")
+  void ping()
+}
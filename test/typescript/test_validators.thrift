@@ -0,0 +1,21 @@
+namespace ts test.validators
+
+include "test_codec.thrift"
+
+enum Status {
+  ACTIVE = 1
+  DISABLED = 2
+}
+
+// 带有 api.vd 校验规则的请求
+struct CreateUserReq {
+  1: required string name (api.vd = "len($) > 0 && len($) <= 32")
+  2: optional string email (api.vd = "regexp('^[^@]+@[^@]+$')")
+  3: optional i32 age (api.vd = "$ >= 0 && $ < 150; msg:'invalid age'")
+  4: optional string role (api.vd = "in($, 'admin', 'user')")
+  5: optional list<string> tags (api.vd = "len($) <= 3")
+  6: optional Status status
+  7: optional map<Status, i64> quota
+  8: optional test_codec.Address address
+  9: optional string nickname (api.vd = "@:mblen($) < 10")
+}
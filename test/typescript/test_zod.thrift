@@ -0,0 +1,36 @@
+namespace ts test.zod
+
+include "test_codec.thrift"
+
+enum Priority {
+  LOW = 1
+  HIGH = 2
+}
+
+// 带有默认值的结构体
+struct TaskOptions {
+  1: optional i32 retry_count = 3
+  2: optional string queue_name = "default"
+  3: optional bool dry_run = false
+  4: optional double timeout = 1.5
+  5: optional i64 deadline = 1000
+  6: required Priority priority
+}
+
+// 递归引用自身的结构体
+struct TreeNode {
+  1: required string name
+  2: optional list<TreeNode> children
+}
+
+// 相互引用的结构体
+struct Folder {
+  1: required string path
+  2: optional list<File> files
+}
+
+struct File {
+  1: required string name
+  2: optional Folder parent
+  3: optional test_codec.Address location
+}
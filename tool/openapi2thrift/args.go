@@ -0,0 +1,84 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/cloudwego/thriftgo/version"
+)
+
+// Arguments contains command line arguments for openapi2thrift.
+type Arguments struct {
+	AskVersion  bool
+	OutputFile  string
+	Namespace   string
+	ServiceName string
+	Spec        string
+}
+
+// BuildFlags initializes command line flags.
+func (a *Arguments) BuildFlags() *flag.FlagSet {
+	f := flag.NewFlagSet(os.Args[0], flag.ContinueOnError)
+
+	f.BoolVar(&a.AskVersion, "version", false, "")
+
+	f.StringVar(&a.OutputFile, "o", "", "")
+	f.StringVar(&a.OutputFile, "out", "", "")
+
+	f.StringVar(&a.Namespace, "n", "", "")
+	f.StringVar(&a.Namespace, "namespace", "", "")
+
+	f.StringVar(&a.ServiceName, "s", "", "")
+	f.StringVar(&a.ServiceName, "service", "", "")
+
+	f.Usage = help
+	return f
+}
+
+// Parse parse command line arguments.
+func (a *Arguments) Parse(argv []string) error {
+	f := a.BuildFlags()
+	if err := f.Parse(argv[1:]); err != nil {
+		return err
+	}
+
+	if a.AskVersion {
+		return nil
+	}
+
+	rest := f.Args()
+	if len(rest) != 1 {
+		return fmt.Errorf("require exactly 1 argument for the OpenAPI document, got: %d", len(rest))
+	}
+
+	a.Spec = rest[0]
+	return nil
+}
+
+func help() {
+	fmt.Fprintln(os.Stderr, "openapi2thrift version:", version.ThriftgoVersion)
+	fmt.Fprint(os.Stderr, `Usage: openapi2thrift [options] file
+Convert an OpenAPI 3.x document (JSON or YAML) into Thrift IDL.
+Options:
+  --version			Print the version and exit.
+  -h, --help			Print help message and exit.
+  -o, --out [file]		Specify the output IDL file. Default is the input file with a .thrift extension.
+  -n, --namespace [name]	Add "namespace go <name>" to the output IDL.
+  -s, --service [name]		Specify the service of operations without tags. Default is derived from info.title.
+`)
+}
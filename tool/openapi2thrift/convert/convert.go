@@ -0,0 +1,595 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package convert builds Thrift ASTs from OpenAPI 3.x documents.
+package convert
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/cloudwego/thriftgo/parser"
+)
+
+// Options controls the conversion.
+type Options struct {
+	// Namespace is written as "namespace go <Namespace>" when it is not empty.
+	Namespace string
+	// ServiceName is the service that holds operations without tags.
+	// It is derived from info.title when empty.
+	ServiceName string
+}
+
+const (
+	schemaRefPrefix      = "#/components/schemas/"
+	parameterRefPrefix   = "#/components/parameters/"
+	requestBodyRefPrefix = "#/components/requestBodies/"
+	responseRefPrefix    = "#/components/responses/"
+)
+
+// Convert parses an OpenAPI 3.x document in YAML or JSON format and converts it
+// into a Thrift AST that can be printed with dump.DumpIDL:
+//   - object schemas become structs, or exceptions when they are only used by error responses;
+//   - string and integer enums become enums, oneOf schemas become unions;
+//   - operations become functions grouped into services by their first tag,
+//     annotated with api.get/api.post/... and a request struct whose fields carry
+//     api.path/api.query/api.header/api.cookie/api.body/api.form annotations.
+func Convert(filename string, data []byte, opts Options) (*parser.Thrift, error) {
+	doc := new(document)
+	if err := yaml.Unmarshal(data, doc); err != nil {
+		return nil, fmt.Errorf("parse %s: %w", filename, err)
+	}
+	if !strings.HasPrefix(doc.OpenAPI, "3.") {
+		return nil, fmt.Errorf("%s: unsupported OpenAPI version %q, expect 3.x", filename, doc.OpenAPI)
+	}
+
+	c := &converter{
+		doc:        doc,
+		opts:       opts,
+		ast:        &parser.Thrift{Filename: filename},
+		names:      make(map[string]bool),
+		exceptions: make(map[string]bool),
+	}
+	if opts.Namespace != "" {
+		c.ast.Namespaces = append(c.ast.Namespaces, &parser.Namespace{Language: "go", Name: opts.Namespace})
+	}
+	c.markExceptions()
+	c.convertSchemas()
+	c.convertPaths()
+	if c.err != nil {
+		return nil, fmt.Errorf("%s: %w", filename, c.err)
+	}
+	return c.ast, nil
+}
+
+type converter struct {
+	doc        *document
+	opts       Options
+	ast        *parser.Thrift
+	names      map[string]bool // type names already taken in the IDL
+	exceptions map[string]bool // component schemas converted into exceptions
+	err        error           // the first undefined reference
+}
+
+// undefined records a $ref that does not point to a component of the document.
+func (c *converter) undefined(ref string) {
+	if c.err == nil {
+		c.err = fmt.Errorf("undefined $ref %q", ref)
+	}
+}
+
+// schemaRef returns the component schema that ref points to.
+func (c *converter) schemaRef(ref string) *schema {
+	s := c.doc.Components.Schemas.Schemas[strings.TrimPrefix(ref, schemaRefPrefix)]
+	if s == nil || !strings.HasPrefix(ref, schemaRefPrefix) {
+		c.undefined(ref)
+		return nil
+	}
+	return s
+}
+
+// markExceptions finds the component schemas that are referenced by error
+// responses but never by successful responses.
+func (c *converter) markExceptions() {
+	success := make(map[string]bool)
+	for _, path := range c.doc.Paths.Paths {
+		_, ops := c.doc.Paths.Items[path].operations()
+		for _, op := range ops {
+			for code, resp := range op.Responses {
+				for _, name := range schemaRefs(c.responseSchema(resp)) {
+					if isSuccessCode(code) {
+						success[name] = true
+					} else if isErrorCode(code) {
+						c.exceptions[name] = true
+					}
+				}
+			}
+		}
+	}
+	for name := range c.exceptions {
+		if s := c.doc.Components.Schemas.Schemas[name]; success[name] || s == nil || !isObject(s) {
+			delete(c.exceptions, name)
+		}
+	}
+}
+
+// convertSchemas converts component schemas in their definition order.
+func (c *converter) convertSchemas() {
+	schemas := c.doc.Components.Schemas
+	for _, name := range schemas.Names {
+		c.names[typeName(name)] = true
+	}
+	for _, name := range schemas.Names {
+		s, tn := schemas.Schemas[name], typeName(name)
+		switch {
+		case isEnum(s):
+			c.defineEnum(tn, s)
+		case isUnion(s):
+			c.defineUnion(tn, s)
+		case isObject(s):
+			category := "struct"
+			if c.exceptions[name] {
+				category = "exception"
+			}
+			c.defineStruct(tn, category, s)
+		default:
+			typ, _ := c.typeOf(s, tn+"Value")
+			c.ast.Typedefs = append(c.ast.Typedefs, &parser.Typedef{
+				Type:             typ,
+				Alias:            tn,
+				ReservedComments: comment(s.Description),
+			})
+		}
+	}
+}
+
+// uniqueName returns name, or name with a numeric suffix when it is taken.
+func (c *converter) uniqueName(name string) string {
+	unique := name
+	for i := 2; c.names[unique]; i++ {
+		unique = name + strconv.Itoa(i)
+	}
+	c.names[unique] = true
+	return unique
+}
+
+func (c *converter) defineEnum(name string, s *schema) {
+	enum := &parser.Enum{Name: name, ReservedComments: comment(s.Description)}
+	values := s.Enum
+	if len(values) == 0 && s.Const != nil {
+		values = []interface{}{s.Const}
+	}
+	seen := make(map[string]bool)
+	for i, v := range values {
+		var ev *parser.EnumValue
+		switch val := v.(type) {
+		case nil:
+			continue
+		case int:
+			label := strconv.Itoa(val)
+			if val < 0 {
+				label = "N" + strconv.Itoa(-val)
+			}
+			ev = &parser.EnumValue{Name: name + "_" + label, Value: int64(val)}
+		default:
+			ev = &parser.EnumValue{Name: identifier(fmt.Sprint(val)), Value: int64(i)}
+		}
+		if !seen[ev.Name] {
+			seen[ev.Name] = true
+			enum.Values = append(enum.Values, ev)
+		}
+	}
+	c.ast.Enums = append(c.ast.Enums, enum)
+}
+
+func (c *converter) defineUnion(name string, s *schema) {
+	union := &parser.StructLike{Category: "union", Name: name, ReservedComments: comment(s.Description)}
+	for i, branch := range nonNullBranches(s) {
+		var field *parser.Field
+		if branch.Ref == "" && len(branch.Properties.Names) == 1 {
+			// a single-property object names the union field
+			prop := branch.Properties.Names[0]
+			field = c.newField(prop, branch.Properties.Schemas[prop], name)
+		} else {
+			typ, _ := c.typeOf(branch, name+"Option"+strconv.Itoa(i+1))
+			fieldName := "option" + strconv.Itoa(i+1)
+			if branch.Ref != "" {
+				fieldName = lowerFirst(typ.Name)
+			}
+			field = &parser.Field{Name: fieldName, Type: typ, ReservedComments: comment(branch.Description)}
+		}
+		field.ID = int32(len(union.Fields) + 1)
+		union.Fields = append(union.Fields, field)
+	}
+	c.ast.Unions = append(c.ast.Unions, union)
+}
+
+func (c *converter) defineStruct(name, category string, s *schema) *parser.StructLike {
+	st := &parser.StructLike{Category: category, Name: name, ReservedComments: comment(s.Description)}
+	props, required := c.properties(s)
+	for _, prop := range props.Names {
+		field := c.newField(prop, props.Schemas[prop], name)
+		field.ID = int32(len(st.Fields) + 1)
+		field.Requiredness = parser.FieldType_Optional
+		if required[prop] {
+			field.Requiredness = parser.FieldType_Required
+		}
+		st.Fields = append(st.Fields, field)
+	}
+	switch category {
+	case "exception":
+		c.ast.Exceptions = append(c.ast.Exceptions, st)
+	default:
+		c.ast.Structs = append(c.ast.Structs, st)
+	}
+	return st
+}
+
+// newField converts a property into a field. Properties whose names are not valid
+// Thrift identifiers keep their JSON name through a go.tag annotation.
+func (c *converter) newField(prop string, s *schema, owner string) *parser.Field {
+	typ, _ := c.typeOf(s, owner+upperFirst(identifier(prop)))
+	field := &parser.Field{Name: identifier(prop), Type: typ}
+	if s != nil {
+		field.ReservedComments = comment(s.Description)
+	}
+	if field.Name != prop {
+		field.Annotations = append(field.Annotations, &parser.Annotation{
+			Key:    "go.tag",
+			Values: []string{fmt.Sprintf(`json:"%s"`, prop)},
+		})
+	}
+	return field
+}
+
+// properties collects the properties of an object schema, including those
+// inherited through allOf.
+func (c *converter) properties(s *schema) (props schemaMap, required map[string]bool) {
+	props.Schemas = make(map[string]*schema)
+	required = make(map[string]bool)
+	var collect func(s *schema, depth int)
+	collect = func(s *schema, depth int) {
+		if s == nil || depth > 16 {
+			return
+		}
+		if s.Ref != "" {
+			collect(c.schemaRef(s.Ref), depth+1)
+			return
+		}
+		for _, sub := range s.AllOf {
+			collect(sub, depth+1)
+		}
+		for _, name := range s.Properties.Names {
+			if _, ok := props.Schemas[name]; !ok {
+				props.Names = append(props.Names, name)
+			}
+			props.Schemas[name] = s.Properties.Schemas[name]
+		}
+		for _, name := range s.Required {
+			required[name] = true
+		}
+	}
+	collect(s, 0)
+	return props, required
+}
+
+// typeOf returns the Thrift type of a schema. Inline objects and unions are defined
+// as new types named after hint. nullable reports whether the schema allows null.
+func (c *converter) typeOf(s *schema, hint string) (typ *parser.Type, nullable bool) {
+	if s == nil {
+		return &parser.Type{Name: "string", Category: parser.Category_String}, false
+	}
+	nullable = s.Nullable || s.Type.Nullable
+	if s.Ref != "" {
+		c.schemaRef(s.Ref)
+		return &parser.Type{Name: typeName(strings.TrimPrefix(s.Ref, schemaRefPrefix))}, nullable
+	}
+	if len(s.AllOf) == 1 && len(s.Properties.Names) == 0 {
+		typ, n := c.typeOf(s.AllOf[0], hint)
+		return typ, nullable || n
+	}
+	if branches := nonNullBranches(s); len(branches) == 1 && (len(s.OneOf) > 1 || len(s.AnyOf) > 1) {
+		typ, _ := c.typeOf(branches[0], hint)
+		return typ, true
+	}
+
+	switch s.Type.Name {
+	case "string":
+		if s.Format == "binary" || s.Format == "byte" {
+			return &parser.Type{Name: "binary", Category: parser.Category_Binary}, nullable
+		}
+		return &parser.Type{Name: "string", Category: parser.Category_String}, nullable
+	case "integer":
+		switch s.Format {
+		case "int8":
+			return &parser.Type{Name: "byte", Category: parser.Category_Byte}, nullable
+		case "int16":
+			return &parser.Type{Name: "i16", Category: parser.Category_I16}, nullable
+		case "int32":
+			return &parser.Type{Name: "i32", Category: parser.Category_I32}, nullable
+		}
+		return &parser.Type{Name: "i64", Category: parser.Category_I64}, nullable
+	case "number":
+		return &parser.Type{Name: "double", Category: parser.Category_Double}, nullable
+	case "boolean":
+		return &parser.Type{Name: "bool", Category: parser.Category_Bool}, nullable
+	case "array":
+		elem, _ := c.typeOf(s.Items, hint+"Item")
+		if s.UniqueItems {
+			return &parser.Type{Name: "set", Category: parser.Category_Set, ValueType: elem}, nullable
+		}
+		return &parser.Type{Name: "list", Category: parser.Category_List, ValueType: elem}, nullable
+	}
+
+	switch {
+	case isUnion(s):
+		name := c.uniqueName(hint)
+		c.defineUnion(name, s)
+		return &parser.Type{Name: name, Category: parser.Category_Union}, nullable
+	case len(s.Properties.Names) > 0 || len(s.AllOf) > 1:
+		name := c.uniqueName(hint)
+		c.defineStruct(name, "struct", s)
+		return &parser.Type{Name: name, Category: parser.Category_Struct}, nullable
+	case s.Type.Name == "object":
+		value := &parser.Type{Name: "string", Category: parser.Category_String}
+		if s.AdditionalProperties != nil {
+			value, _ = c.typeOf(s.AdditionalProperties, hint+"Value")
+		}
+		return &parser.Type{
+			Name:      "map",
+			Category:  parser.Category_Map,
+			KeyType:   &parser.Type{Name: "string", Category: parser.Category_String},
+			ValueType: value,
+		}, nullable
+	}
+	return &parser.Type{Name: "string", Category: parser.Category_String}, nullable
+}
+
+// convertPaths converts operations into service functions in their definition order.
+func (c *converter) convertPaths() {
+	services := make(map[string]*parser.Service)
+	functions := make(map[string]map[string]bool)
+	for _, path := range c.doc.Paths.Paths {
+		item := c.doc.Paths.Items[path]
+		methods, ops := item.operations()
+		for i, op := range ops {
+			svcName := c.serviceName(op)
+			svc, ok := services[svcName]
+			if !ok {
+				svc = &parser.Service{Name: svcName}
+				services[svcName] = svc
+				functions[svcName] = make(map[string]bool)
+				c.ast.Services = append(c.ast.Services, svc)
+			}
+			name := functionName(svcName, methods[i], path, op)
+			unique := name
+			for n := 2; functions[svcName][unique]; n++ {
+				unique = name + strconv.Itoa(n)
+			}
+			functions[svcName][unique] = true
+			svc.Functions = append(svc.Functions, c.convertOperation(unique, methods[i], path, item, op))
+		}
+	}
+}
+
+// serviceName returns the service of an operation: its first tag, or the default service.
+func (c *converter) serviceName(op *operation) string {
+	if len(op.Tags) > 0 && op.Tags[0] != "" {
+		return upperFirst(identifier(op.Tags[0]))
+	}
+	if c.opts.ServiceName != "" {
+		return identifier(c.opts.ServiceName)
+	}
+	if title := strings.Join(strings.Fields(c.doc.Info.Title), ""); title != "" {
+		return upperFirst(identifier(title)) + "Service"
+	}
+	return "APIService"
+}
+
+func (c *converter) convertOperation(name, method, path string, item *pathItem, op *operation) *parser.Function {
+	fn := &parser.Function{
+		Name:             name,
+		ReservedComments: comment(firstNonEmpty(op.Summary, op.Description)),
+		Annotations: parser.Annotations{
+			{Key: "api." + method, Values: []string{toRoute(path)}},
+		},
+	}
+	if arg := c.requestArgument(upperFirst(name)+"Request", item, op); arg != nil {
+		fn.Arguments = append(fn.Arguments, arg)
+	}
+	fn.FunctionType, fn.Void = c.responseType(upperFirst(name)+"Response", op)
+	fn.Throws = c.throws(op)
+	return fn
+}
+
+// requestArgument builds the argument of a function. A request that only has a
+// JSON body referencing a component schema uses that schema directly; otherwise
+// a request struct is generated with location annotations on each field.
+func (c *converter) requestArgument(name string, item *pathItem, op *operation) *parser.Field {
+	params := c.parameters(item, op)
+	body, location := c.requestBodySchema(op)
+	if len(params) == 0 && body == nil {
+		return nil
+	}
+	if len(params) == 0 && body != nil && body.Ref != "" && location == "body" {
+		if ref := c.schemaRef(body.Ref); ref != nil && isObject(ref) {
+			typ, _ := c.typeOf(body, name)
+			return &parser.Field{ID: 1, Name: "req", Type: typ}
+		}
+	}
+
+	name = c.uniqueName(name)
+	st := &parser.StructLike{Category: "struct", Name: name}
+	fieldNames := make(map[string]bool)
+	add := func(field *parser.Field, required bool, key, value string) {
+		unique := field.Name
+		for n := 2; fieldNames[unique]; n++ {
+			unique = field.Name + strconv.Itoa(n)
+		}
+		fieldNames[unique] = true
+		field.Name = unique
+		field.ID = int32(len(st.Fields) + 1)
+		field.Requiredness = parser.FieldType_Optional
+		if required {
+			field.Requiredness = parser.FieldType_Required
+		}
+		// the location annotation carries the wire name, so go.tag is not needed
+		field.Annotations = parser.Annotations{{Key: key, Values: []string{value}}}
+		st.Fields = append(st.Fields, field)
+	}
+
+	for _, p := range params {
+		field := c.newField(p.Name, p.Schema, name)
+		if field.ReservedComments == "" {
+			field.ReservedComments = comment(p.Description)
+		}
+		add(field, p.Required || p.In == "path", "api."+p.In, p.Name)
+	}
+	if body != nil {
+		props, required := c.properties(body)
+		if len(props.Names) == 0 {
+			field := c.newField("body", body, name)
+			add(field, true, "api."+location, "body")
+		}
+		for _, prop := range props.Names {
+			add(c.newField(prop, props.Schemas[prop], name), required[prop], "api."+location, prop)
+		}
+	}
+	c.ast.Structs = append(c.ast.Structs, st)
+	return &parser.Field{ID: 1, Name: "req", Type: &parser.Type{Name: name, Category: parser.Category_Struct}}
+}
+
+// parameters merges path level and operation level parameters; the latter
+// override the former with the same name and location.
+func (c *converter) parameters(item *pathItem, op *operation) []*parameter {
+	var params []*parameter
+	index := make(map[string]int)
+	for _, p := range append(append([]*parameter{}, item.Parameters...), op.Parameters...) {
+		if p.Ref != "" {
+			ref := p.Ref
+			if p = c.doc.Components.Parameters[strings.TrimPrefix(ref, parameterRefPrefix)]; p == nil || !strings.HasPrefix(ref, parameterRefPrefix) {
+				c.undefined(ref)
+				continue
+			}
+		}
+		if p == nil || p.Name == "" {
+			continue
+		}
+		switch p.In {
+		case "path", "query", "header", "cookie":
+		default:
+			continue
+		}
+		key := p.In + ":" + p.Name
+		if i, ok := index[key]; ok {
+			params[i] = p
+			continue
+		}
+		index[key] = len(params)
+		params = append(params, p)
+	}
+	return params
+}
+
+// requestBodySchema returns the schema of the request body and whether its
+// properties are sent as JSON ("body") or as a form ("form").
+func (c *converter) requestBodySchema(op *operation) (*schema, string) {
+	body := op.RequestBody
+	if body != nil && body.Ref != "" {
+		ref := body.Ref
+		if body = c.doc.Components.RequestBodies[strings.TrimPrefix(ref, requestBodyRefPrefix)]; body == nil || !strings.HasPrefix(ref, requestBodyRefPrefix) {
+			c.undefined(ref)
+			return nil, ""
+		}
+	}
+	if body == nil {
+		return nil, ""
+	}
+	for _, ct := range []string{"application/json", "application/x-www-form-urlencoded", "multipart/form-data"} {
+		if m := body.Content[ct]; m != nil && m.Schema != nil {
+			if ct == "application/json" {
+				return m.Schema, "body"
+			}
+			return m.Schema, "form"
+		}
+	}
+	for _, ct := range sortedContentTypes(body.Content) {
+		if m := body.Content[ct]; m != nil && m.Schema != nil {
+			return m.Schema, "body"
+		}
+	}
+	return nil, ""
+}
+
+// responseType returns the result type of the first successful response.
+func (c *converter) responseType(hint string, op *operation) (*parser.Type, bool) {
+	for _, code := range sortedCodes(op.Responses) {
+		if !isSuccessCode(code) {
+			continue
+		}
+		if s := c.responseSchema(op.Responses[code]); s != nil {
+			typ, _ := c.typeOf(s, hint)
+			return typ, false
+		}
+		break
+	}
+	return &parser.Type{Name: "void"}, true
+}
+
+// throws converts error responses that reference exceptions into throws fields
+// annotated with api.http_code.
+func (c *converter) throws(op *operation) []*parser.Field {
+	var fields []*parser.Field
+	seen := make(map[string]bool)
+	for _, code := range sortedCodes(op.Responses) {
+		if !isErrorCode(code) {
+			continue
+		}
+		for _, name := range schemaRefs(c.responseSchema(op.Responses[code])) {
+			if !c.exceptions[name] || seen[name] {
+				continue
+			}
+			seen[name] = true
+			tn := typeName(name)
+			fields = append(fields, &parser.Field{
+				ID:          int32(len(fields) + 1),
+				Name:        lowerFirst(tn),
+				Type:        &parser.Type{Name: tn, Category: parser.Category_Exception},
+				Annotations: parser.Annotations{{Key: "api.http_code", Values: []string{code}}},
+			})
+		}
+	}
+	return fields
+}
+
+// responseSchema returns the JSON (or the first) schema of a response.
+func (c *converter) responseSchema(resp *response) *schema {
+	if resp != nil && resp.Ref != "" {
+		ref := resp.Ref
+		if resp = c.doc.Components.Responses[strings.TrimPrefix(ref, responseRefPrefix)]; resp == nil || !strings.HasPrefix(ref, responseRefPrefix) {
+			c.undefined(ref)
+			return nil
+		}
+	}
+	if resp == nil || len(resp.Content) == 0 {
+		return nil
+	}
+	if m := resp.Content["application/json"]; m != nil {
+		return m.Schema
+	}
+	return resp.Content[sortedContentTypes(resp.Content)[0]].Schema
+}
@@ -0,0 +1,181 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package convert
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/cloudwego/thriftgo/parser"
+	"github.com/cloudwego/thriftgo/pkg/test"
+	"github.com/cloudwego/thriftgo/semantic"
+	"github.com/cloudwego/thriftgo/tool/trimmer/dump"
+)
+
+// roundTrip converts the document, dumps it and parses the IDL again.
+func roundTrip(t *testing.T, data []byte) *parser.Thrift {
+	ast, err := Convert("petstore.thrift", data, Options{Namespace: "petstore"})
+	test.Assert(t, err == nil, err)
+	idl, err := dump.DumpIDL(ast)
+	test.Assert(t, err == nil, err)
+
+	filename := filepath.Join(t.TempDir(), "petstore.thrift")
+	test.Assert(t, os.WriteFile(filename, []byte(idl), 0o644) == nil)
+	out, err := parser.ParseFile(filename, nil, true)
+	test.Assert(t, err == nil, err, idl)
+	_, err = semantic.NewChecker(semantic.Options{}).CheckAll(out)
+	test.Assert(t, err == nil, err, idl)
+	test.Assert(t, semantic.ResolveSymbols(out) == nil, idl)
+	return out
+}
+
+func TestConvertPetstore(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("testdata", "petstore.yaml"))
+	test.Assert(t, err == nil, err)
+	ast := roundTrip(t, data)
+
+	ns, _ := ast.GetNamespace("go")
+	test.Assert(t, ns == "petstore", ns)
+
+	status, ok := ast.GetEnum("Status")
+	test.Assert(t, ok)
+	test.Assert(t, len(status.Values) == 3 && status.Values[2].Name == "sold")
+
+	pet, ok := ast.GetStruct("Pet")
+	test.Assert(t, ok)
+	types := make(map[string]string)
+	for _, f := range pet.Fields {
+		types[f.Name] = f.Type.Name
+	}
+	test.Assert(t, pet.Fields[0].Requiredness.IsRequired())
+	test.Assert(t, pet.Fields[2].Requiredness.IsOptional())
+	test.Assert(t, types["id"] == "i64", types)
+	test.Assert(t, types["tags"] == "set", types)
+	test.Assert(t, types["attributes"] == "map", types)
+	test.Assert(t, types["owner"] == "PetOwner", types)
+	test.Assert(t, types["photo"] == "binary", types)
+	test.Assert(t, types["contact"] == "Contact", types)
+
+	owner, ok := ast.GetStruct("PetOwner")
+	test.Assert(t, ok)
+	test.Assert(t, owner.Fields[0].Name == "display_name")
+	test.Assert(t, owner.Fields[0].Annotations.Get("go.tag")[0] == `json:"display-name"`)
+
+	contact, ok := ast.GetUnion("Contact")
+	test.Assert(t, ok)
+	test.Assert(t, len(contact.Fields) == 2 && contact.Fields[1].Name == "phone")
+
+	_, ok = ast.GetException("Error")
+	test.Assert(t, ok, "Error is only used by error responses")
+	_, ok = ast.GetTypedef("Tags")
+	test.Assert(t, ok)
+
+	svc, ok := ast.GetService("Pets")
+	test.Assert(t, ok)
+	test.Assert(t, len(svc.Functions) == 4, len(svc.Functions))
+
+	list := svc.Functions[0]
+	test.Assert(t, list.Name == "listPets")
+	test.Assert(t, list.Annotations.Get("api.get")[0] == "/pets")
+	test.Assert(t, list.FunctionType.Name == "list")
+	req, ok := ast.GetStruct(list.Arguments[0].Type.Name)
+	test.Assert(t, ok)
+	test.Assert(t, req.Fields[0].Annotations.Get("api.query")[0] == "limit")
+	test.Assert(t, req.Fields[1].Annotations.Get("api.header")[0] == "X-Trace-Id")
+
+	create := svc.Functions[1]
+	test.Assert(t, create.Arguments[0].Type.Name == "Pet", "a plain JSON body uses the schema directly")
+	test.Assert(t, create.Void)
+
+	show := svc.Functions[2]
+	test.Assert(t, show.Annotations.Get("api.get")[0] == "/pets/:petId")
+	test.Assert(t, len(show.Throws) == 1 && show.Throws[0].Type.Name == "Error")
+	test.Assert(t, show.Throws[0].Annotations.Get("api.http_code")[0] == "404")
+
+	update := svc.Functions[3]
+	test.Assert(t, update.Name == "patchPetsPetId", update.Name)
+	req, ok = ast.GetStruct(update.Arguments[0].Type.Name)
+	test.Assert(t, ok)
+	test.Assert(t, req.Fields[0].Annotations.Get("api.path")[0] == "petId")
+	test.Assert(t, req.Fields[1].Annotations.Get("api.form")[0] == "name")
+
+	health, ok := ast.GetService("PetstoreService")
+	test.Assert(t, ok)
+	test.Assert(t, health.Functions[0].Name == "getHealth" && len(health.Functions[0].Arguments) == 0)
+}
+
+func TestConvertJSON(t *testing.T) {
+	data, err := os.ReadFile(filepath.Join("testdata", "petstore.yaml"))
+	test.Assert(t, err == nil, err)
+	var doc interface{}
+	test.Assert(t, yaml.Unmarshal(data, &doc) == nil)
+	js, err := json.Marshal(doc)
+	test.Assert(t, err == nil, err)
+
+	ast := roundTrip(t, js)
+	_, ok := ast.GetStruct("Pet")
+	test.Assert(t, ok)
+	test.Assert(t, len(ast.Services) == 2)
+}
+
+func TestConvertUnsupportedVersion(t *testing.T) {
+	_, err := Convert("x.thrift", []byte("swagger: '2.0'\n"), Options{})
+	test.Assert(t, err != nil)
+}
+
+func TestConvertUndefinedRef(t *testing.T) {
+	const doc = `openapi: 3.0.0
+info:
+  title: Refs
+paths:
+  /items:
+    get:
+      parameters:
+        - $ref: '%s'
+      responses:
+        '200':
+          description: ok
+          content:
+            application/json:
+              schema:
+                $ref: '%s'
+components:
+  parameters:
+    Limit:
+      name: limit
+      in: query
+      schema:
+        type: integer
+  schemas:
+    Item:
+      type: object
+      properties:
+        id:
+          type: integer
+`
+	_, err := Convert("x.thrift", []byte(fmt.Sprintf(doc, "#/components/parameters/Limit", "#/components/schemas/Missing")), Options{})
+	test.Assert(t, err != nil && err.Error() == `x.thrift: undefined $ref "#/components/schemas/Missing"`, err)
+
+	_, err = Convert("x.thrift", []byte(fmt.Sprintf(doc, "#/components/parameters/Offset", "#/components/schemas/Item")), Options{})
+	test.Assert(t, err != nil && err.Error() == `x.thrift: undefined $ref "#/components/parameters/Offset"`, err)
+
+	_, err = Convert("x.thrift", []byte(fmt.Sprintf(doc, "#/components/parameters/Limit", "#/components/schemas/Item")), Options{})
+	test.Assert(t, err == nil, err)
+}
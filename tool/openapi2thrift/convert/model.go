@@ -0,0 +1,204 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package convert
+
+import (
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+)
+
+// document is the subset of an OpenAPI 3.x document used by the importer.
+type document struct {
+	OpenAPI    string     `yaml:"openapi"`
+	Info       info       `yaml:"info"`
+	Paths      pathMap    `yaml:"paths"`
+	Components components `yaml:"components"`
+}
+
+type info struct {
+	Title       string `yaml:"title"`
+	Description string `yaml:"description"`
+}
+
+type components struct {
+	Schemas       schemaMap               `yaml:"schemas"`
+	Parameters    map[string]*parameter   `yaml:"parameters"`
+	RequestBodies map[string]*requestBody `yaml:"requestBodies"`
+	Responses     map[string]*response    `yaml:"responses"`
+}
+
+type pathItem struct {
+	Parameters []*parameter `yaml:"parameters"`
+	Get        *operation   `yaml:"get"`
+	Put        *operation   `yaml:"put"`
+	Post       *operation   `yaml:"post"`
+	Delete     *operation   `yaml:"delete"`
+	Patch      *operation   `yaml:"patch"`
+}
+
+// operations returns the operations of the path item in a fixed method order.
+func (p *pathItem) operations() (methods []string, ops []*operation) {
+	for _, m := range []struct {
+		method string
+		op     *operation
+	}{
+		{"get", p.Get}, {"post", p.Post}, {"put", p.Put}, {"patch", p.Patch}, {"delete", p.Delete},
+	} {
+		if m.op != nil {
+			methods = append(methods, m.method)
+			ops = append(ops, m.op)
+		}
+	}
+	return
+}
+
+type operation struct {
+	OperationID string               `yaml:"operationId"`
+	Summary     string               `yaml:"summary"`
+	Description string               `yaml:"description"`
+	Tags        []string             `yaml:"tags"`
+	Parameters  []*parameter         `yaml:"parameters"`
+	RequestBody *requestBody         `yaml:"requestBody"`
+	Responses   map[string]*response `yaml:"responses"`
+}
+
+type parameter struct {
+	Ref         string  `yaml:"$ref"`
+	Name        string  `yaml:"name"`
+	In          string  `yaml:"in"`
+	Description string  `yaml:"description"`
+	Required    bool    `yaml:"required"`
+	Schema      *schema `yaml:"schema"`
+}
+
+type requestBody struct {
+	Ref         string                `yaml:"$ref"`
+	Description string                `yaml:"description"`
+	Required    bool                  `yaml:"required"`
+	Content     map[string]*mediaType `yaml:"content"`
+}
+
+type response struct {
+	Ref         string                `yaml:"$ref"`
+	Description string                `yaml:"description"`
+	Content     map[string]*mediaType `yaml:"content"`
+}
+
+type mediaType struct {
+	Schema *schema `yaml:"schema"`
+}
+
+type schema struct {
+	Ref                  string        `yaml:"$ref"`
+	Type                 schemaType    `yaml:"type"`
+	Format               string        `yaml:"format"`
+	Description          string        `yaml:"description"`
+	Nullable             bool          `yaml:"nullable"`
+	Enum                 []interface{} `yaml:"enum"`
+	Const                interface{}   `yaml:"const"`
+	Items                *schema       `yaml:"items"`
+	UniqueItems          bool          `yaml:"uniqueItems"`
+	Properties           schemaMap     `yaml:"properties"`
+	AdditionalProperties *schema       `yaml:"additionalProperties"`
+	Required             []string      `yaml:"required"`
+	AllOf                []*schema     `yaml:"allOf"`
+	OneOf                []*schema     `yaml:"oneOf"`
+	AnyOf                []*schema     `yaml:"anyOf"`
+}
+
+// schemaType holds the "type" keyword, which is a string in OpenAPI 3.0
+// and may be a list such as [string, "null"] in OpenAPI 3.1.
+type schemaType struct {
+	Name     string
+	Nullable bool
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface.
+func (t *schemaType) UnmarshalYAML(node *yaml.Node) error {
+	switch node.Kind {
+	case yaml.ScalarNode:
+		t.Name = node.Value
+	case yaml.SequenceNode:
+		for _, n := range node.Content {
+			if n.Value == "null" {
+				t.Nullable = true
+			} else if t.Name == "" {
+				t.Name = n.Value
+			}
+		}
+	default:
+		return fmt.Errorf("line %d: invalid schema type", node.Line)
+	}
+	return nil
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface. A boolean
+// additionalProperties carries no type information and decodes to nil.
+func (s *schema) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind == yaml.ScalarNode && node.Tag == "!!bool" {
+		return nil
+	}
+	type plain schema
+	return node.Decode((*plain)(s))
+}
+
+// schemaMap is a map of named schemas that keeps the definition order.
+type schemaMap struct {
+	Names   []string
+	Schemas map[string]*schema
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface.
+func (m *schemaMap) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind != yaml.MappingNode {
+		return fmt.Errorf("line %d: expect a mapping of schemas", node.Line)
+	}
+	m.Schemas = make(map[string]*schema)
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		s := new(schema)
+		if err := node.Content[i+1].Decode(s); err != nil {
+			return err
+		}
+		name := node.Content[i].Value
+		m.Names = append(m.Names, name)
+		m.Schemas[name] = s
+	}
+	return nil
+}
+
+// pathMap is a map of path items that keeps the definition order.
+type pathMap struct {
+	Paths []string
+	Items map[string]*pathItem
+}
+
+// UnmarshalYAML implements the yaml.Unmarshaler interface.
+func (m *pathMap) UnmarshalYAML(node *yaml.Node) error {
+	if node.Kind != yaml.MappingNode {
+		return fmt.Errorf("line %d: expect a mapping of paths", node.Line)
+	}
+	m.Items = make(map[string]*pathItem)
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		item := new(pathItem)
+		if err := node.Content[i+1].Decode(item); err != nil {
+			return err
+		}
+		path := node.Content[i].Value
+		m.Paths = append(m.Paths, path)
+		m.Items[path] = item
+	}
+	return nil
+}
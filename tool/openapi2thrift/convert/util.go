@@ -0,0 +1,192 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package convert
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// keywords can not be used as identifiers in Thrift IDL.
+var keywords = map[string]bool{
+	"bool": true, "byte": true, "i8": true, "i16": true, "i32": true, "i64": true,
+	"double": true, "string": true, "binary": true, "const": true, "oneway": true,
+	"typedef": true, "map": true, "set": true, "list": true, "void": true,
+	"throws": true, "exception": true, "extends": true, "service": true,
+	"struct": true, "union": true, "enum": true, "include": true, "cpp_include": true,
+	"namespace": true, "cpp_type": true, "required": true, "optional": true,
+}
+
+// identifier turns s into a valid Thrift identifier.
+func identifier(s string) string {
+	var sb strings.Builder
+	for _, r := range s {
+		if r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r) || r == '_') {
+			sb.WriteRune(r)
+		} else {
+			sb.WriteByte('_')
+		}
+	}
+	id := sb.String()
+	if id == "" || unicode.IsDigit(rune(id[0])) {
+		id = "_" + id
+	}
+	if keywords[id] {
+		id += "_"
+	}
+	return id
+}
+
+// typeName returns the Thrift type name of a component schema. Dots in
+// namespace qualified names such as "common.User" are replaced by underscores.
+func typeName(name string) string {
+	return identifier(name)
+}
+
+func upperFirst(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
+
+func lowerFirst(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToLower(s[:1]) + s[1:]
+}
+
+// comment returns a single line comment for a description.
+func comment(desc string) string {
+	desc = strings.Join(strings.Fields(desc), " ")
+	if desc == "" {
+		return ""
+	}
+	return "// " + desc
+}
+
+func firstNonEmpty(ss ...string) string {
+	for _, s := range ss {
+		if s != "" {
+			return s
+		}
+	}
+	return ""
+}
+
+// functionName returns the function name of an operation. An operationId in the
+// form "<Service>_<function>", as generated by the openapi backend, loses its prefix.
+// Operations without operationId are named after the method and the path.
+func functionName(service, method, path string, op *operation) string {
+	if op.OperationID != "" {
+		return identifier(strings.TrimPrefix(op.OperationID, service+"_"))
+	}
+	name := method
+	for _, seg := range strings.Split(path, "/") {
+		seg = strings.Trim(seg, "{}")
+		if seg != "" {
+			name += upperFirst(strings.Trim(identifier(seg), "_"))
+		}
+	}
+	return identifier(name)
+}
+
+// toRoute converts an OpenAPI path template into a route with :param variables.
+func toRoute(path string) string {
+	segs := strings.Split(path, "/")
+	for i, seg := range segs {
+		if strings.HasPrefix(seg, "{") && strings.HasSuffix(seg, "}") {
+			segs[i] = ":" + seg[1:len(seg)-1]
+		}
+	}
+	return strings.Join(segs, "/")
+}
+
+func isSuccessCode(code string) bool {
+	return strings.HasPrefix(code, "2")
+}
+
+func isErrorCode(code string) bool {
+	return strings.HasPrefix(code, "4") || strings.HasPrefix(code, "5")
+}
+
+func isEnum(s *schema) bool {
+	if s.Type.Name != "string" && s.Type.Name != "integer" {
+		return false
+	}
+	return len(s.Enum) > 0 || s.Const != nil
+}
+
+func isUnion(s *schema) bool {
+	return len(nonNullBranches(s)) > 1
+}
+
+func isObject(s *schema) bool {
+	if len(s.Properties.Names) > 0 || len(s.AllOf) > 1 {
+		return true
+	}
+	return s.Type.Name == "object" && s.AdditionalProperties == nil
+}
+
+// nonNullBranches returns the oneOf (or anyOf) branches that are not {type: "null"}.
+func nonNullBranches(s *schema) []*schema {
+	branches := s.OneOf
+	if len(branches) == 0 {
+		branches = s.AnyOf
+	}
+	var res []*schema
+	for _, b := range branches {
+		if b.Type.Name != "null" {
+			res = append(res, b)
+		}
+	}
+	return res
+}
+
+// schemaRefs returns the component schemas referenced by s directly or through oneOf.
+func schemaRefs(s *schema) (names []string) {
+	if s == nil {
+		return nil
+	}
+	if s.Ref != "" {
+		return []string{strings.TrimPrefix(s.Ref, schemaRefPrefix)}
+	}
+	for _, b := range append(append([]*schema{}, s.OneOf...), s.AnyOf...) {
+		if b.Ref != "" {
+			names = append(names, strings.TrimPrefix(b.Ref, schemaRefPrefix))
+		}
+	}
+	return names
+}
+
+func sortedCodes(m map[string]*response) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func sortedContentTypes(m map[string]*mediaType) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,81 @@
+// Copyright 2024 CloudWeGo Authors
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package main
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/cloudwego/thriftgo/parser"
+	"github.com/cloudwego/thriftgo/semantic"
+	"github.com/cloudwego/thriftgo/tool/openapi2thrift/convert"
+	"github.com/cloudwego/thriftgo/tool/trimmer/dump"
+	"github.com/cloudwego/thriftgo/version"
+)
+
+var a Arguments
+
+// check reports the error on stderr and exits. The results of the tool are
+// printed on stdout.
+func check(err error) {
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err.Error())
+		os.Exit(2)
+	}
+}
+
+func main() {
+	// you can execute "go install" to install this tool and execute "openapi2thrift" or "openapi2thrift -version"
+	err := a.Parse(os.Args)
+	if err != nil {
+		help()
+		check(err)
+	}
+	if a.AskVersion {
+		fmt.Println("openapi2thrift", version.ThriftgoVersion)
+		os.Exit(0)
+	}
+
+	data, err := os.ReadFile(a.Spec)
+	check(err)
+
+	if a.OutputFile == "" {
+		a.OutputFile = strings.TrimSuffix(a.Spec, filepath.Ext(a.Spec)) + ".thrift"
+	}
+
+	ast, err := convert.Convert(a.OutputFile, data, convert.Options{
+		Namespace:   a.Namespace,
+		ServiceName: a.ServiceName,
+	})
+	check(err)
+
+	idl, err := dump.DumpIDL(ast)
+	check(err)
+
+	// make sure the output is a valid IDL before writing it
+	out, err := parser.ParseString(a.OutputFile, idl)
+	check(err)
+	checker := semantic.NewChecker(semantic.Options{})
+	_, err = checker.CheckAll(out)
+	check(err)
+	check(semantic.ResolveSymbols(out))
+	check(os.WriteFile(a.OutputFile, []byte(idl), 0o644))
+
+	fmt.Printf("converted %d structs, %d enums and %d services\n",
+		len(ast.Structs)+len(ast.Unions)+len(ast.Exceptions), len(ast.Enums), len(ast.Services))
+	fmt.Println("success, dump to", a.OutputFile)
+}
@@ -155,13 +155,14 @@ func DumpIDL(ast *parser.Thrift) (string, error) {
 					required = "required "
 				}
 				sb.writeString(fmt.Sprintf("%d: %s%s %s", ag.ID, required, typeName(ag.Type), ag.Name))
+				printAnnotation(&sb, ag.Annotations)
 				if i != len(f.Arguments)-1 {
 					sb.writeString(", ")
 				}
 			}
 			sb.writeString(")")
 			if len(f.Throws) > 0 {
-				sb.writeString("throws ")
+				sb.writeString(" throws ")
 				sb.writeString("(")
 				for i, th := range f.Throws {
 					required := ""
@@ -171,7 +172,8 @@ func DumpIDL(ast *parser.Thrift) (string, error) {
 						required = "required "
 					}
 					sb.writeString(fmt.Sprintf("%d: %s%s %s", th.ID, required, typeName(th.Type), th.Name))
-					if i != len(f.Arguments)-1 {
+					printAnnotation(&sb, th.Annotations)
+					if i != len(f.Throws)-1 {
 						sb.writeString(", ")
 					}
 				}